@@ -3,14 +3,43 @@ package main
 import (
 	"context"
 	"log"
+	"os"
 
 	"github.com/mikhailsoldatkin/chat-server/internal/app"
+	"github.com/mikhailsoldatkin/chat-server/internal/config"
+)
+
+const (
+	healthCheckCommand = "healthcheck"
+	configCommand      = "config"
+	printSubcommand    = "print"
 )
 
 func main() {
 	ctx := context.Background()
 
-	a, err := app.NewApp(ctx)
+	if len(os.Args) > 1 && os.Args[1] == healthCheckCommand {
+		err := app.HealthCheck(ctx, os.Args[2:])
+		if err != nil {
+			log.Fatalf("health check failed: %s", err.Error())
+		}
+		return
+	}
+
+	if len(os.Args) > 2 && os.Args[1] == configCommand && os.Args[2] == printSubcommand {
+		cfg, err := config.Load(os.Args[3:])
+		if err != nil {
+			log.Fatalf("failed to load config: %s", err.Error())
+		}
+
+		err = cfg.Print(os.Stdout)
+		if err != nil {
+			log.Fatalf("failed to print config: %s", err.Error())
+		}
+		return
+	}
+
+	a, err := app.NewApp(ctx, os.Args[1:])
 	if err != nil {
 		log.Fatalf("failed to init app: %s", err.Error())
 	}
@@ -0,0 +1,30 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/apikey/converter"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+)
+
+// CreateAPIKey issues an API key for a bot sending messages as the given user, recording the caller
+// from the access token as the one who issued it. The key is only returned in this response.
+func (i *Implementation) CreateAPIKey(ctx context.Context, req *pb.CreateAPIKeyRequest) (*pb.CreateAPIKeyResponse, error) {
+	createdBy, err := moderatorFromContext(ctx)
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	err = i.authClient.CheckUsersExist(ctx, []int64{req.GetUserId()})
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	id, key, err := i.apiKeyService.CreateAPIKey(ctx, converter.FromProtobufToService(req, createdBy))
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.CreateAPIKeyResponse{Id: id, Key: key}, nil
+}
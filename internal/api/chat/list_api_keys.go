@@ -0,0 +1,19 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/apikey/converter"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+)
+
+// ListAPIKeys lists issued API keys, including revoked and expired ones, with pagination.
+func (i *Implementation) ListAPIKeys(ctx context.Context, req *pb.ListAPIKeysRequest) (*pb.ListAPIKeysResponse, error) {
+	keys, err := i.apiKeyService.ListAPIKeys(ctx, req.GetLimit(), req.GetOffset())
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.ListAPIKeysResponse{Keys: converter.FromServiceToProtobufList(keys)}, nil
+}
@@ -0,0 +1,24 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/report/converter"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+)
+
+// ListReports lists abuse reports for moderators with optional status filter and pagination.
+func (i *Implementation) ListReports(ctx context.Context, req *pb.ListReportsRequest) (*pb.ListReportsResponse, error) {
+	reports, err := i.reportService.ListReports(
+		ctx,
+		converter.FromProtobufToServiceStatus(req.GetStatus()),
+		req.GetLimit(),
+		req.GetOffset(),
+	)
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.ListReportsResponse{Reports: converter.FromServiceToProtobufList(reports)}, nil
+}
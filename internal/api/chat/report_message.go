@@ -0,0 +1,51 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/utils"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+)
+
+// ReportMessage handles a chat member's abuse report on a message. The member must be the caller.
+func (i *Implementation) ReportMessage(ctx context.Context, req *pb.ReportMessageRequest) (*pb.ReportMessageResponse, error) {
+	err := i.checkReporter(ctx, req.GetFromUser())
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	id, err := i.reportService.ReportMessage(ctx, req.GetMessageId(), req.GetFromUser(), req.GetReason())
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &pb.ReportMessageResponse{ReportId: id}, nil
+}
+
+// checkReporter checks that the reporting user is the one the API key of the calling bot is issued for,
+// or the user the access token verified by the authentication interceptor is issued to.
+func (i *Implementation) checkReporter(ctx context.Context, userID int64) error {
+	if bot, ok := utils.BotFromContext(ctx); ok {
+		if bot.UserID != userID {
+			return customerrors.NewPermissionDeniedError("API key is not allowed to report messages as another user")
+		}
+
+		return i.authClient.CheckUsersExist(ctx, []int64{userID})
+	}
+
+	user, ok := utils.UserFromContext(ctx)
+	if !ok || user.Username == "" {
+		return customerrors.NewUnauthenticatedError("request is not authenticated with an access token")
+	}
+
+	username, err := i.authClient.GetUsername(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if username != user.Username {
+		return customerrors.NewPermissionDeniedError("from_user doesn't belong to the access token")
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/utils"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ResolveReport resolves an abuse report, recording the moderator calling it as the one who acted.
+func (i *Implementation) ResolveReport(ctx context.Context, req *pb.ResolveReportRequest) (*emptypb.Empty, error) {
+	moderator, err := moderatorFromContext(ctx)
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	err = i.reportService.ResolveReport(ctx, req.GetId(), req.GetAction().String(), moderator)
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// moderatorFromContext returns the username of the caller, as verified by the authentication interceptor.
+func moderatorFromContext(ctx context.Context) (string, error) {
+	user, ok := utils.UserFromContext(ctx)
+	if !ok || user.Username == "" {
+		return "", customerrors.NewUnauthenticatedError("request is not authenticated with an access token")
+	}
+
+	return user.Username, nil
+}
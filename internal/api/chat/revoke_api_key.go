@@ -0,0 +1,19 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// RevokeAPIKey revokes an API key by its ID.
+func (i *Implementation) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyRequest) (*emptypb.Empty, error) {
+	err := i.apiKeyService.RevokeAPIKey(ctx, req.GetId())
+	if err != nil {
+		return nil, customerrors.ConvertError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
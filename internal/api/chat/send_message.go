@@ -4,20 +4,22 @@ import (
 	"context"
 
 	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/utils"
 	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 // SendMessage handles sending a message from a user to a chat.
+// Bots send messages as the user their API key is issued for, whose existence was checked when the key was issued.
 func (i *Implementation) SendMessage(ctx context.Context, req *pb.SendMessageRequest) (*emptypb.Empty, error) {
-	err := i.authClient.CheckUsersExist(ctx, []int64{req.FromUser})
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+	if _, isBot := utils.BotFromContext(ctx); !isBot {
+		err := i.authClient.CheckUsersExist(ctx, []int64{req.FromUser})
+		if err != nil {
+			return nil, customerrors.ConvertError(err)
+		}
 	}
 
-	err = i.chatService.SendMessage(ctx, req)
+	_, err := i.chatService.SendMessage(ctx, req)
 	if err != nil {
 		return nil, customerrors.ConvertError(err)
 	}
@@ -11,18 +11,25 @@ import (
 // Implementation provides methods for handling chat-related gRPC requests.
 type Implementation struct {
 	pbChat.UnimplementedChatV1Server
-	chatService service.ChatService
-	authClient  client.AuthClient
+	chatService   service.ChatService
+	reportService service.ReportService
+	apiKeyService service.APIKeyService
+	authClient    client.AuthClient
 }
 
-// NewImplementation creates a new instance of Implementation with the given chat service and authentication client.
+// NewImplementation creates a new instance of Implementation with the given chat, report and API keys services
+// and authentication client.
 func NewImplementation(
 	chatService service.ChatService,
+	reportService service.ReportService,
+	apiKeyService service.APIKeyService,
 	authClient client.AuthClient,
 ) *Implementation {
 	return &Implementation{
-		chatService: chatService,
-		authClient:  authClient,
+		chatService:   chatService,
+		reportService: reportService,
+		apiKeyService: apiKeyService,
+		authClient:    authClient,
 	}
 }
 
@@ -37,7 +44,12 @@ func (noOpClient) CheckUsersExist(_ context.Context, _ []int64) error {
 	return nil
 }
 
-// NewMockImplementation creates a new mock instance of Implementation with the given chat service and no op auth client.
+func (noOpClient) GetUsername(_ context.Context, _ int64) (string, error) {
+	return "", nil
+}
+
+// NewMockImplementation creates a new mock instance of Implementation with the given services and auth client,
+// the auth client is a no op one unless given.
 func NewMockImplementation(deps ...any) *Implementation {
 	impl := &Implementation{
 		authClient: noOpClient{},
@@ -47,6 +59,12 @@ func NewMockImplementation(deps ...any) *Implementation {
 		switch s := v.(type) {
 		case service.ChatService:
 			impl.chatService = s
+		case service.ReportService:
+			impl.reportService = s
+		case service.APIKeyService:
+			impl.apiKeyService = s
+		case client.AuthClient:
+			impl.authClient = s
 		}
 	}
 
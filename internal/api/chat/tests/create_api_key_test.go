@@ -0,0 +1,107 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	chatAPI "github.com/mikhailsoldatkin/chat-server/internal/api/chat"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/service"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/apikey/model"
+	serviceMocks "github.com/mikhailsoldatkin/chat-server/internal/service/mocks"
+	"github.com/mikhailsoldatkin/chat-server/internal/utils"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCreateAPIKey(t *testing.T) {
+	t.Parallel()
+	type apiKeyServiceMockFunc func(mc *minimock.Controller) service.APIKeyService
+
+	var (
+		mc = minimock.NewController(t)
+
+		id        = gofakeit.Int64()
+		secret    = "ck_" + gofakeit.LetterN(43)
+		admin     = gofakeit.Username()
+		expiresAt = time.Now().Add(time.Hour).UTC()
+
+		ctx     = utils.ContextWithUser(context.Background(), &utils.User{Username: admin, Role: "ADMIN"})
+		anonCtx = context.Background()
+
+		req = &pb.CreateAPIKeyRequest{
+			Name:      gofakeit.AppName(),
+			UserId:    gofakeit.Int64(),
+			ChatIds:   []int64{gofakeit.Int64()},
+			Methods:   []string{pb.ChatV1_SendMessage_FullMethodName},
+			ExpiresAt: timestamppb.New(expiresAt),
+		}
+		key = &model.APIKey{
+			Name:      req.GetName(),
+			UserID:    req.GetUserId(),
+			ChatIDs:   req.GetChatIds(),
+			Methods:   req.GetMethods(),
+			CreatedBy: admin,
+			ExpiresAt: &expiresAt,
+		}
+	)
+
+	tests := []struct {
+		name              string
+		ctx               context.Context
+		want              *pb.CreateAPIKeyResponse
+		code              codes.Code
+		apiKeyServiceMock apiKeyServiceMockFunc
+	}{
+		{
+			name: "success case",
+			ctx:  ctx,
+			want: &pb.CreateAPIKeyResponse{Id: id, Key: secret},
+			code: codes.OK,
+			apiKeyServiceMock: func(mc *minimock.Controller) service.APIKeyService {
+				mock := serviceMocks.NewAPIKeyServiceMock(mc)
+				mock.CreateAPIKeyMock.Expect(ctx, key).Return(id, secret, nil)
+				return mock
+			},
+		},
+		{
+			name: "method can't be granted",
+			ctx:  ctx,
+			want: nil,
+			code: codes.FailedPrecondition,
+			apiKeyServiceMock: func(mc *minimock.Controller) service.APIKeyService {
+				mock := serviceMocks.NewAPIKeyServiceMock(mc)
+				mock.CreateAPIKeyMock.Return(0, "", customerrors.NewFailedPreconditionError("unknown method"))
+				return mock
+			},
+		},
+		{
+			name: "no access token",
+			ctx:  anonCtx,
+			want: nil,
+			code: codes.Unauthenticated,
+			apiKeyServiceMock: func(mc *minimock.Controller) service.APIKeyService {
+				return serviceMocks.NewAPIKeyServiceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			apiKeyServiceMock := tt.apiKeyServiceMock(mc)
+			api := chatAPI.NewMockImplementation(apiKeyServiceMock)
+
+			resp, grpcErr := api.CreateAPIKey(tt.ctx, req)
+			require.Equal(t, tt.code, status.Code(grpcErr))
+			require.Equal(t, tt.want, resp)
+		})
+	}
+}
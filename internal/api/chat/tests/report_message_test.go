@@ -0,0 +1,109 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	chatAPI "github.com/mikhailsoldatkin/chat-server/internal/api/chat"
+	"github.com/mikhailsoldatkin/chat-server/internal/client"
+	clientMocks "github.com/mikhailsoldatkin/chat-server/internal/client/mocks"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/service"
+	serviceMocks "github.com/mikhailsoldatkin/chat-server/internal/service/mocks"
+	"github.com/mikhailsoldatkin/chat-server/internal/utils"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportMessage(t *testing.T) {
+	t.Parallel()
+	type reportServiceMockFunc func(mc *minimock.Controller) service.ReportService
+	type authClientMockFunc func(mc *minimock.Controller) client.AuthClient
+
+	var (
+		username = gofakeit.Username()
+		ctx      = utils.ContextWithUser(context.Background(), &utils.User{Username: username})
+		mc       = minimock.NewController(t)
+
+		reportID  = gofakeit.Int64()
+		messageID = gofakeit.Int64()
+		userID    = gofakeit.Int64()
+		reason    = gofakeit.Sentence(5)
+
+		req = &pb.ReportMessageRequest{
+			MessageId: messageID,
+			FromUser:  userID,
+			Reason:    reason,
+		}
+
+		wantErr = fmt.Errorf("service error")
+	)
+
+	callerMock := func(mc *minimock.Controller) client.AuthClient {
+		return clientMocks.NewAuthClientMock(mc).GetUsernameMock.Expect(ctx, userID).Return(username, nil)
+	}
+
+	tests := []struct {
+		name              string
+		req               *pb.ReportMessageRequest
+		want              *pb.ReportMessageResponse
+		err               error
+		reportServiceMock reportServiceMockFunc
+		authClientMock    authClientMockFunc
+	}{
+		{
+			name: "success case",
+			req:  req,
+			want: &pb.ReportMessageResponse{ReportId: reportID},
+			err:  nil,
+			reportServiceMock: func(mc *minimock.Controller) service.ReportService {
+				mock := serviceMocks.NewReportServiceMock(mc)
+				mock.ReportMessageMock.Expect(ctx, messageID, userID, reason).Return(reportID, nil)
+				return mock
+			},
+			authClientMock: callerMock,
+		},
+		{
+			name: "service error",
+			req:  req,
+			want: nil,
+			err:  customerrors.ConvertError(wantErr),
+			reportServiceMock: func(mc *minimock.Controller) service.ReportService {
+				mock := serviceMocks.NewReportServiceMock(mc)
+				mock.ReportMessageMock.Expect(ctx, messageID, userID, reason).Return(0, wantErr)
+				return mock
+			},
+			authClientMock: callerMock,
+		},
+		{
+			name: "reporter is not the caller",
+			req:  req,
+			want: nil,
+			err: customerrors.ConvertError(
+				customerrors.NewPermissionDeniedError("from_user doesn't belong to the access token"),
+			),
+			reportServiceMock: func(mc *minimock.Controller) service.ReportService {
+				return serviceMocks.NewReportServiceMock(mc)
+			},
+			authClientMock: func(mc *minimock.Controller) client.AuthClient {
+				return clientMocks.NewAuthClientMock(mc).
+					GetUsernameMock.Expect(ctx, userID).Return(gofakeit.Username()+"-other", nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := chatAPI.NewMockImplementation(tt.reportServiceMock(mc), tt.authClientMock(mc))
+
+			resp, grpcErr := api.ReportMessage(ctx, tt.req)
+			require.Equal(t, tt.err, grpcErr)
+			require.Equal(t, tt.want, resp)
+		})
+	}
+}
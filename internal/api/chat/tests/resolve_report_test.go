@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	chatAPI "github.com/mikhailsoldatkin/chat-server/internal/api/chat"
+	"github.com/mikhailsoldatkin/chat-server/internal/service"
+	serviceMocks "github.com/mikhailsoldatkin/chat-server/internal/service/mocks"
+	"github.com/mikhailsoldatkin/chat-server/internal/utils"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// accessToken builds an unsigned access token carrying the given username.
+func accessToken(username string) string {
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString([]byte(`{"alg":"none"}`)) + "." +
+		enc.EncodeToString([]byte(fmt.Sprintf(`{"username":%q,"role":"ADMIN"}`, username))) + "." +
+		enc.EncodeToString([]byte("signature"))
+}
+
+func TestResolveReport(t *testing.T) {
+	t.Parallel()
+	type reportServiceMockFunc func(mc *minimock.Controller) service.ReportService
+
+	var (
+		mc = minimock.NewController(t)
+
+		reportID  = gofakeit.Int64()
+		moderator = gofakeit.Username()
+
+		ctx     = utils.ContextWithUser(context.Background(), &utils.User{Username: moderator, Role: "ADMIN"})
+		anonCtx = context.Background()
+		// the access token is not verified until the authentication interceptor has checked it
+		unverifiedCtx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+accessToken(moderator)))
+
+		req = &pb.ResolveReportRequest{
+			Id:     reportID,
+			Action: pb.ReportAction_DELETE_MESSAGE,
+		}
+	)
+
+	tests := []struct {
+		name              string
+		ctx               context.Context
+		req               *pb.ResolveReportRequest
+		want              *emptypb.Empty
+		code              codes.Code
+		reportServiceMock reportServiceMockFunc
+	}{
+		{
+			name: "success case",
+			ctx:  ctx,
+			req:  req,
+			want: &emptypb.Empty{},
+			code: codes.OK,
+			reportServiceMock: func(mc *minimock.Controller) service.ReportService {
+				mock := serviceMocks.NewReportServiceMock(mc)
+				mock.ResolveReportMock.Expect(ctx, reportID, "DELETE_MESSAGE", moderator).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "no access token",
+			ctx:  anonCtx,
+			req:  req,
+			want: nil,
+			code: codes.Unauthenticated,
+			reportServiceMock: func(mc *minimock.Controller) service.ReportService {
+				return serviceMocks.NewReportServiceMock(mc)
+			},
+		},
+		{
+			name: "unverified access token",
+			ctx:  unverifiedCtx,
+			req:  req,
+			want: nil,
+			code: codes.Unauthenticated,
+			reportServiceMock: func(mc *minimock.Controller) service.ReportService {
+				return serviceMocks.NewReportServiceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			reportServiceMock := tt.reportServiceMock(mc)
+			api := chatAPI.NewMockImplementation(reportServiceMock)
+
+			resp, grpcErr := api.ResolveReport(tt.ctx, tt.req)
+			require.Equal(t, tt.code, status.Code(grpcErr))
+			require.Equal(t, tt.want, resp)
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
 	"github.com/mikhailsoldatkin/chat-server/internal/service"
 	serviceMocks "github.com/mikhailsoldatkin/chat-server/internal/service/mocks"
+	"github.com/mikhailsoldatkin/chat-server/internal/utils"
 	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -29,6 +30,7 @@ func TestSendMessage(t *testing.T) {
 		ctx = context.Background()
 		mc  = minimock.NewController(t)
 
+		id     = gofakeit.Int64()
 		chatID = gofakeit.Int64()
 		userID = gofakeit.Int64()
 		msg    = gofakeit.BeerName()
@@ -41,6 +43,8 @@ func TestSendMessage(t *testing.T) {
 
 		wantResp = &emptypb.Empty{}
 		wantErr  = fmt.Errorf("service error")
+
+		botCtx = utils.ContextWithBot(ctx, &utils.Bot{KeyID: gofakeit.Int64(), UserID: userID})
 	)
 
 	tests := []struct {
@@ -60,7 +64,7 @@ func TestSendMessage(t *testing.T) {
 			err:  nil,
 			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
 				mock := serviceMocks.NewChatServiceMock(mc)
-				mock.SendMessageMock.Expect(ctx, req).Return(nil)
+				mock.SendMessageMock.Expect(ctx, req).Return(id, nil)
 				return mock
 			},
 		},
@@ -74,7 +78,21 @@ func TestSendMessage(t *testing.T) {
 			err:  customerrors.ConvertError(wantErr),
 			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
 				mock := serviceMocks.NewChatServiceMock(mc)
-				mock.SendMessageMock.Expect(ctx, req).Return(wantErr)
+				mock.SendMessageMock.Expect(ctx, req).Return(0, wantErr)
+				return mock
+			},
+		},
+		{
+			name: "bot case",
+			args: args{
+				ctx: botCtx,
+				req: req,
+			},
+			want: wantResp,
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.SendMessageMock.Expect(botCtx, req).Return(id, nil)
 				return mock
 			},
 		},
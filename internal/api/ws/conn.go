@@ -0,0 +1,279 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/mikhailsoldatkin/chat-server/internal/broker"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/logger"
+	"github.com/mikhailsoldatkin/chat-server/internal/metric"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/chat/model"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// conn represents a single client WebSocket connection. Frames are read by the goroutine serving
+// the connection and written by a dedicated writer goroutine.
+type conn struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	ws        *websocket.Conn
+	h         *Handler
+	userID    int64
+	expiresAt time.Time
+	sub       broker.Subscription
+	replies   chan *outFrame
+}
+
+func newConn(ctx context.Context, ws *websocket.Conn, h *Handler, userID int64, expiresAt time.Time) *conn {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &conn{
+		ctx:       ctx,
+		cancel:    cancel,
+		ws:        ws,
+		h:         h,
+		userID:    userID,
+		expiresAt: expiresAt,
+		sub:       h.broker.NewSubscription(userID, h.cfg.SendBuffer),
+		replies:   make(chan *outFrame, h.cfg.SendBuffer),
+	}
+}
+
+// run serves the connection until the client disconnects or the connection is closed by the server.
+func (c *conn) run() {
+	metric.StreamOpened(metric.TransportWebSocket)
+	defer metric.StreamClosed(metric.TransportWebSocket)
+	defer c.sub.Close()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.writeLoop()
+	}()
+
+	c.readLoop()
+	c.cancel()
+	<-done
+}
+
+// readLoop reads and handles client frames until the connection fails.
+func (c *conn) readLoop() {
+	c.ws.SetReadLimit(c.h.cfg.MaxFrameSize)
+	_ = c.ws.SetReadDeadline(time.Now().Add(c.h.cfg.PongTimeout))
+	c.ws.SetPongHandler(func(string) error {
+		return c.ws.SetReadDeadline(time.Now().Add(c.h.cfg.PongTimeout))
+	})
+
+	for {
+		_, data, err := c.ws.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				logger.Debug("websocket connection closed", zap.Int64("user_id", c.userID), zap.Error(err))
+			}
+			return
+		}
+
+		var frame inFrame
+		if err = json.Unmarshal(data, &frame); err != nil {
+			c.reply(errorFrame("", status.Error(codes.InvalidArgument, "frame is not a valid JSON object")))
+			continue
+		}
+
+		c.handle(&frame)
+	}
+}
+
+// handle executes a client request, replying with the result or an error frame.
+func (c *conn) handle(frame *inFrame) {
+	var err error
+
+	switch frame.Type {
+	case frameSubscribe:
+		err = c.subscribe(frame)
+	case frameSend:
+		err = c.send(frame)
+	case frameTyping:
+		err = c.h.chatService.PublishEvent(c.ctx, &model.Event{
+			Type:   model.EventTyping,
+			ChatID: frame.ChatID,
+			UserID: c.userID,
+		})
+	case frameRead:
+		if frame.MessageID <= 0 {
+			err = status.Error(codes.InvalidArgument, "message_id must be greater than 0")
+			break
+		}
+		err = c.h.chatService.PublishEvent(c.ctx, &model.Event{
+			Type:      model.EventRead,
+			ChatID:    frame.ChatID,
+			UserID:    c.userID,
+			MessageID: frame.MessageID,
+		})
+	default:
+		err = status.Errorf(codes.InvalidArgument, "unknown frame type %q", frame.Type)
+	}
+
+	if err != nil {
+		c.reply(errorFrame(frame.RequestID, customerrors.ConvertError(err)))
+	}
+}
+
+// subscribe subscribes the connection to the requested chats.
+func (c *conn) subscribe(frame *inFrame) error {
+	if len(frame.ChatIDs) == 0 {
+		return status.Error(codes.InvalidArgument, "chat_ids must not be empty")
+	}
+
+	err := c.h.chatService.Subscribe(c.ctx, c.sub, c.userID, frame.ChatIDs)
+	if err != nil {
+		return err
+	}
+
+	c.reply(&outFrame{
+		Type:      frameSubscribed,
+		RequestID: frame.RequestID,
+		ChatIDs:   frame.ChatIDs,
+	})
+
+	return nil
+}
+
+// send sends a message to a chat on behalf of the connected user through the interceptor of the handler,
+// as if the message was sent with the SendMessage call.
+func (c *conn) send(frame *inFrame) error {
+	req := &pb.SendMessageRequest{
+		ChatId:   frame.ChatID,
+		FromUser: c.userID,
+		Text:     frame.Text,
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: pb.ChatV1_SendMessage_FullMethodName}
+	ctx := grpc.NewContextWithServerTransportStream(c.ctx, headerlessStream{method: info.FullMethod})
+
+	var id int64
+	_, err := c.h.interceptor(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+		var errSend error
+		id, errSend = c.h.chatService.SendMessage(ctx, req.(*pb.SendMessageRequest))
+		if errSend != nil {
+			return nil, customerrors.ConvertError(errSend)
+		}
+
+		return &emptypb.Empty{}, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	c.reply(&outFrame{
+		Type:      frameSent,
+		RequestID: frame.RequestID,
+		ChatID:    frame.ChatID,
+		MessageID: id,
+	})
+
+	return nil
+}
+
+// reply queues a frame for the writer. A client that doesn't read its replies fast enough is disconnected.
+func (c *conn) reply(frame *outFrame) {
+	select {
+	case c.replies <- frame:
+	default:
+		c.close(websocket.CloseTryAgainLater, "too slow to receive replies")
+	}
+}
+
+// writeLoop writes queued replies, chat events and keepalive pings until the connection is done.
+func (c *conn) writeLoop() {
+	ticker := time.NewTicker(c.h.cfg.PingInterval)
+	defer ticker.Stop()
+
+	var expired <-chan time.Time
+	if !c.expiresAt.IsZero() {
+		timer := time.NewTimer(time.Until(c.expiresAt))
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			c.close(websocket.CloseNormalClosure, "")
+			return
+		case <-expired:
+			c.close(websocket.ClosePolicyViolation, "access token expired")
+			return
+		case event, ok := <-c.sub.Events():
+			if !ok {
+				if errors.Is(c.sub.Err(), broker.ErrSlowConsumer) {
+					c.close(websocket.CloseTryAgainLater, "too slow to receive events")
+				}
+				return
+			}
+			if event.Type == model.EventTyping && event.UserID == c.userID {
+				continue
+			}
+			if err := c.write(fromEvent(event)); err != nil {
+				c.close(websocket.CloseInternalServerErr, "")
+				return
+			}
+		case frame := <-c.replies:
+			if err := c.write(frame); err != nil {
+				c.close(websocket.CloseInternalServerErr, "")
+				return
+			}
+		case <-ticker.C:
+			_ = c.ws.SetWriteDeadline(time.Now().Add(c.h.cfg.WriteTimeout))
+			if err := c.ws.WriteMessage(websocket.PingMessage, nil); err != nil {
+				c.close(websocket.CloseGoingAway, "")
+				return
+			}
+		}
+	}
+}
+
+// write writes a frame within the write timeout.
+func (c *conn) write(frame *outFrame) error {
+	_ = c.ws.SetWriteDeadline(time.Now().Add(c.h.cfg.WriteTimeout))
+
+	return c.ws.WriteJSON(frame)
+}
+
+// close sends the close frame and closes the connection, which stops the read loop.
+// It is safe to call concurrently with the writer.
+func (c *conn) close(code int, reason string) {
+	msg := websocket.FormatCloseMessage(code, reason)
+	_ = c.ws.WriteControl(websocket.CloseMessage, msg, time.Now().Add(c.h.cfg.WriteTimeout))
+	_ = c.ws.Close()
+}
+
+// headerlessStream stands for the transport stream of the calls made by the connection. The WebSocket protocol
+// has no headers to send the response headers set by the interceptors in, they are dropped.
+type headerlessStream struct {
+	method string
+}
+
+func (s headerlessStream) Method() string {
+	return s.method
+}
+
+func (headerlessStream) SetHeader(metadata.MD) error {
+	return nil
+}
+
+func (headerlessStream) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (headerlessStream) SetTrailer(metadata.MD) error {
+	return nil
+}
@@ -0,0 +1,95 @@
+package ws
+
+import (
+	"time"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/service/chat/model"
+	"google.golang.org/grpc/status"
+)
+
+// Client frame types.
+const (
+	frameSubscribe = "subscribe"
+	frameSend      = "send"
+	frameTyping    = "typing"
+	frameRead      = "read"
+)
+
+// Server frame types, chat events are sent with the event type.
+const (
+	frameSubscribed = "subscribed"
+	frameSent       = "sent"
+	frameError      = "error"
+)
+
+// inFrame represents a JSON frame sent by a client.
+type inFrame struct {
+	Type      string  `json:"type"`
+	RequestID string  `json:"request_id,omitempty"`
+	ChatIDs   []int64 `json:"chat_ids,omitempty"`
+	ChatID    int64   `json:"chat_id,omitempty"`
+	MessageID int64   `json:"message_id,omitempty"`
+	Text      string  `json:"text,omitempty"`
+}
+
+// outFrame represents a JSON frame sent to a client.
+type outFrame struct {
+	Type      string        `json:"type"`
+	RequestID string        `json:"request_id,omitempty"`
+	ChatIDs   []int64       `json:"chat_ids,omitempty"`
+	ChatID    int64         `json:"chat_id,omitempty"`
+	UserID    int64         `json:"user_id,omitempty"`
+	MessageID int64         `json:"message_id,omitempty"`
+	Message   *messageFrame `json:"message,omitempty"`
+	Code      string        `json:"code,omitempty"`
+	Error     string        `json:"error,omitempty"`
+	Timestamp *time.Time    `json:"timestamp,omitempty"`
+}
+
+// messageFrame represents a chat message in a server frame.
+type messageFrame struct {
+	ID         int64     `json:"id"`
+	ChatID     int64     `json:"chat_id"`
+	FromUser   int64     `json:"from_user"`
+	Text       string    `json:"text"`
+	SenderType string    `json:"sender_type,omitempty"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+// fromEvent converts a chat event to a server frame.
+func fromEvent(event *model.Event) *outFrame {
+	ts := event.Timestamp
+	frame := &outFrame{
+		Type:      event.Type,
+		ChatID:    event.ChatID,
+		UserID:    event.UserID,
+		MessageID: event.MessageID,
+		Timestamp: &ts,
+	}
+
+	if event.Message != nil {
+		frame.MessageID = event.Message.ID
+		frame.Message = &messageFrame{
+			ID:         event.Message.ID,
+			ChatID:     event.Message.ChatID,
+			FromUser:   event.Message.FromUser,
+			Text:       event.Message.Text,
+			SenderType: event.Message.SenderType,
+			Timestamp:  event.Message.Timestamp,
+		}
+	}
+
+	return frame
+}
+
+// errorFrame converts a gRPC error to an error frame answering the request.
+func errorFrame(requestID string, err error) *outFrame {
+	st := status.Convert(err)
+
+	return &outFrame{
+		Type:      frameError,
+		RequestID: requestID,
+		Code:      st.Code().String(),
+		Error:     st.Message(),
+	}
+}
@@ -0,0 +1,230 @@
+package ws
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/mikhailsoldatkin/chat-server/internal/broker"
+	"github.com/mikhailsoldatkin/chat-server/internal/client"
+	"github.com/mikhailsoldatkin/chat-server/internal/config"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/logger"
+	"github.com/mikhailsoldatkin/chat-server/internal/service"
+	"github.com/mikhailsoldatkin/chat-server/internal/utils"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	headerAuth      = "authorization"
+	prefixAuth      = "Bearer "
+	paramToken      = "access_token"
+	paramUserID     = "user_id"
+	shutdownReason  = "server is shutting down, reconnect"
+	accessEndpoint  = pb.ChatV1_SendMessage_FullMethodName
+	readBufferSize  = 1024
+	writeBufferSize = 1024
+)
+
+// Handler serves WebSocket connections delivering chat events live and accepting messages from chat members.
+//
+// A client connects with the access token in the Authorization header or the access_token query parameter
+// and its user ID in the user_id query parameter. The caller must be allowed to send messages and the user
+// ID must belong to the caller, i.e. the user must have the username of the access token.
+//
+// Messages are sent through the unary interceptor of the GRPC server, so that they pass the same access checks,
+// rate limits, validation, access logging and metrics as the messages sent over gRPC.
+type Handler struct {
+	chatService service.ChatService
+	authClient  client.AuthClient
+	broker      broker.Broker
+	interceptor grpc.UnaryServerInterceptor
+	cfg         config.WebSocket
+	upgrader    websocket.Upgrader
+
+	mu       sync.Mutex
+	conns    map[*conn]struct{}
+	wg       sync.WaitGroup
+	shutdown bool
+}
+
+// NewHandler creates a new WebSocket Handler.
+func NewHandler(
+	chatService service.ChatService,
+	authClient client.AuthClient,
+	broker broker.Broker,
+	interceptor grpc.UnaryServerInterceptor,
+	cfg config.WebSocket,
+) *Handler {
+	h := &Handler{
+		chatService: chatService,
+		authClient:  authClient,
+		broker:      broker,
+		interceptor: interceptor,
+		cfg:         cfg,
+		conns:       make(map[*conn]struct{}),
+	}
+
+	h.upgrader = websocket.Upgrader{
+		ReadBufferSize:  readBufferSize,
+		WriteBufferSize: writeBufferSize,
+		CheckOrigin:     h.checkOrigin,
+	}
+
+	return h
+}
+
+// ServeHTTP authenticates the client and upgrades the connection to the WebSocket protocol.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, userID, expiresAt, err := h.authenticate(r)
+	if err != nil {
+		st := status.Convert(customerrors.ConvertError(err))
+		http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+		return
+	}
+
+	ws, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader has already replied to the client
+		logger.Debug("failed to upgrade websocket connection", zap.Error(err))
+		return
+	}
+
+	c := newConn(ctx, ws, h, userID, expiresAt)
+	if !h.track(c) {
+		c.close(websocket.CloseServiceRestart, shutdownReason)
+		c.sub.Close()
+		c.cancel()
+		return
+	}
+	defer h.untrack(c)
+
+	c.run()
+}
+
+// Shutdown stops accepting connections and closes the open ones with the service restart code,
+// so that clients reconnect to another server. It waits for the connections to be closed until
+// the context is done.
+func (h *Handler) Shutdown(ctx context.Context) error {
+	h.mu.Lock()
+	h.shutdown = true
+	for c := range h.conns {
+		c.close(websocket.CloseServiceRestart, shutdownReason)
+	}
+	h.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		h.wg.Wait()
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// track registers an open connection, it returns false if the handler is shut down.
+func (h *Handler) track(c *conn) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.shutdown {
+		return false
+	}
+
+	h.conns[c] = struct{}{}
+	h.wg.Add(1)
+
+	return true
+}
+
+// untrack removes a closed connection.
+func (h *Handler) untrack(c *conn) {
+	h.mu.Lock()
+	delete(h.conns, c)
+	h.mu.Unlock()
+
+	h.wg.Done()
+}
+
+// authenticate checks the access token and that the user is the one it is issued to, returning the context
+// to call services with.
+func (h *Handler) authenticate(r *http.Request) (context.Context, int64, time.Time, error) {
+	token := r.URL.Query().Get(paramToken)
+	if authHeader := r.Header.Get(headerAuth); authHeader != "" {
+		if !strings.HasPrefix(authHeader, prefixAuth) {
+			return nil, 0, time.Time{}, customerrors.NewUnauthenticatedError("invalid authorization header format")
+		}
+		token = strings.TrimPrefix(authHeader, prefixAuth)
+	}
+	if token == "" {
+		return nil, 0, time.Time{}, customerrors.NewUnauthenticatedError("access token is not provided")
+	}
+
+	userID, err := strconv.ParseInt(r.URL.Query().Get(paramUserID), 10, 64)
+	if err != nil || userID <= 0 {
+		return nil, 0, time.Time{}, status.Error(codes.InvalidArgument, "user_id must be a positive integer")
+	}
+
+	md := metadata.Pairs(headerAuth, prefixAuth+token)
+	ctx := metadata.NewIncomingContext(r.Context(), md)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	err = h.authClient.CheckAccess(ctx, accessEndpoint)
+	if err != nil {
+		return nil, 0, time.Time{}, err
+	}
+
+	// the token has just passed the access check, so its claims can be trusted
+	claims, err := utils.ParseUnverifiedClaims(token)
+	if err != nil || claims.Username == "" {
+		return nil, 0, time.Time{}, customerrors.NewUnauthenticatedError("access token has no username")
+	}
+
+	username, err := h.authClient.GetUsername(ctx, userID)
+	if err != nil {
+		return nil, 0, time.Time{}, err
+	}
+	if username != claims.Username {
+		return nil, 0, time.Time{}, customerrors.NewPermissionDeniedError("user_id doesn't belong to the access token")
+	}
+
+	var expiresAt time.Time
+	if claims.ExpiresAt > 0 {
+		expiresAt = time.Unix(claims.ExpiresAt, 0)
+	}
+
+	ctx = utils.ContextWithUser(ctx, &utils.User{Username: claims.Username, Role: claims.Role})
+
+	return ctx, userID, expiresAt, nil
+}
+
+// checkOrigin allows browser connections from the configured origins only.
+func (h *Handler) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+
+	for _, allowed := range h.cfg.AllowedOrigins {
+		if strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,296 @@
+package tests
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/gorilla/websocket"
+	"github.com/mikhailsoldatkin/chat-server/internal/api/ws"
+	"github.com/mikhailsoldatkin/chat-server/internal/broker"
+	"github.com/mikhailsoldatkin/chat-server/internal/config"
+	"github.com/mikhailsoldatkin/chat-server/internal/interceptor"
+	repoMocks "github.com/mikhailsoldatkin/chat-server/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/chat"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var wsConfig = config.WebSocket{
+	PingInterval:   time.Second,
+	PongTimeout:    2 * time.Second,
+	WriteTimeout:   time.Second,
+	SendBuffer:     8,
+	MaxFrameSize:   4096,
+	AllowedOrigins: []string{"https://chat.example"},
+}
+
+// username is the username of the access tokens of the tests.
+const username = "alice"
+
+// accessToken is an unsigned access token issued to the username.
+var accessToken = func() string {
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString([]byte(`{"alg":"none"}`)) + "." +
+		enc.EncodeToString([]byte(fmt.Sprintf(`{"username":%q,"role":"USER"}`, username))) + "." +
+		enc.EncodeToString([]byte("signature"))
+}()
+
+// authClient is a fake authentication client failing access checks with err. Every user has the username
+// of the access tokens unless another one is set.
+type authClient struct {
+	err      error
+	username string
+}
+
+func (c authClient) CheckAccess(_ context.Context, _ string) error {
+	return c.err
+}
+
+func (c authClient) CheckUsersExist(_ context.Context, _ []int64) error {
+	return nil
+}
+
+func (c authClient) GetUsername(_ context.Context, _ int64) (string, error) {
+	if c.username != "" {
+		return c.username, nil
+	}
+
+	return username, nil
+}
+
+// frame is a JSON frame exchanged with the server.
+type frame map[string]any
+
+func dial(t *testing.T, server *httptest.Server, query string, header http.Header) (*websocket.Conn, *http.Response, error) {
+	t.Helper()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/?" + query
+	return websocket.DefaultDialer.Dial(url, header)
+}
+
+func TestHandlerAuthentication(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		query  string
+		header http.Header
+		auth   authClient
+		status int
+	}{
+		{
+			name:   "no access token",
+			query:  "user_id=1",
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "invalid user id",
+			query:  "user_id=abc&access_token=" + accessToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "access token without username",
+			query:  "user_id=1&access_token=token",
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "user of another caller",
+			query:  "user_id=1&access_token=" + accessToken,
+			auth:   authClient{username: "bob"},
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "access denied",
+			query:  "user_id=1",
+			header: http.Header{"Authorization": []string{"Bearer " + accessToken}},
+			auth:   authClient{err: status.Error(codes.PermissionDenied, "access denied")},
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "origin not allowed",
+			query:  "user_id=1&access_token=" + accessToken,
+			header: http.Header{"Origin": []string{"https://evil.example"}},
+			status: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			b := broker.NewBroker()
+			handler := ws.NewHandler(chat.NewMockService(repoMocks.NewChatRepositoryMock(mc), b), tt.auth, b, interceptor.ValidateInterceptor, wsConfig)
+
+			server := httptest.NewServer(handler)
+			defer server.Close()
+
+			_, resp, err := dial(t, server, tt.query, tt.header)
+			require.ErrorIs(t, err, websocket.ErrBadHandshake)
+			require.Equal(t, tt.status, resp.StatusCode)
+			_ = resp.Body.Close()
+		})
+	}
+}
+
+func TestHandlerMessages(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		messageID = int64(gofakeit.Number(1, 1000000))
+		chatID    = int64(gofakeit.Number(1, 1000000))
+		userID    = int64(gofakeit.Number(1, 1000000))
+		text      = gofakeit.Sentence(5)
+	)
+
+	chatRepoMock := repoMocks.NewChatRepositoryMock(mc)
+	chatRepoMock.IsUserInChatMock.Return(nil)
+	chatRepoMock.SendMessageMock.Set(func(_ context.Context, req *pb.SendMessageRequest, _ string) (int64, error) {
+		require.Equal(t, chatID, req.GetChatId())
+		require.Equal(t, userID, req.GetFromUser())
+		require.Equal(t, text, req.GetText())
+		return messageID, nil
+	})
+
+	b := broker.NewBroker()
+	handler := ws.NewHandler(chat.NewMockService(chatRepoMock, b), authClient{}, b, interceptor.ValidateInterceptor, wsConfig)
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	conn, resp, err := dial(t, server, fmt.Sprintf("user_id=%d&access_token=%s", userID, accessToken), nil)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	defer func() { _ = conn.Close() }()
+
+	read := func() frame {
+		var f frame
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+		require.NoError(t, conn.ReadJSON(&f))
+		return f
+	}
+
+	require.NoError(t, conn.WriteJSON(frame{"type": "subscribe", "request_id": "1", "chat_ids": []int64{chatID}}))
+	subscribed := read()
+	require.Equal(t, "subscribed", subscribed["type"])
+	require.Equal(t, "1", subscribed["request_id"])
+
+	require.NoError(t, conn.WriteJSON(frame{"type": "send", "request_id": "2", "chat_id": chatID, "text": text}))
+
+	// the reply and the delivered message may arrive in any order
+	got := map[string]frame{}
+	for i := 0; i < 2; i++ {
+		f := read()
+		got[f["type"].(string)] = f
+	}
+	require.Equal(t, "2", got["sent"]["request_id"])
+	require.EqualValues(t, messageID, got["sent"]["message_id"])
+	message := got["message"]["message"].(map[string]any)
+	require.Equal(t, text, message["text"])
+	require.EqualValues(t, userID, message["from_user"])
+
+	require.NoError(t, conn.WriteJSON(frame{"type": "send", "request_id": "3", "chat_id": chatID}))
+	invalid := read()
+	require.Equal(t, "error", invalid["type"])
+	require.Equal(t, "3", invalid["request_id"])
+	require.Equal(t, codes.InvalidArgument.String(), invalid["code"])
+
+	require.NoError(t, conn.WriteJSON(frame{"type": "unknown", "request_id": "4"}))
+	unknown := read()
+	require.Equal(t, "error", unknown["type"])
+	require.Equal(t, "4", unknown["request_id"])
+}
+
+func TestHandlerSendInterceptor(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc     = minimock.NewController(t)
+		chatID = int64(gofakeit.Number(1, 1000000))
+		userID = int64(gofakeit.Number(1, 1000000))
+	)
+
+	// the message doesn't reach the repository if the interceptor rejects it
+	limit := func(_ context.Context, _ any, info *grpc.UnaryServerInfo, _ grpc.UnaryHandler) (any, error) {
+		require.Equal(t, pb.ChatV1_SendMessage_FullMethodName, info.FullMethod)
+		return nil, status.Error(codes.ResourceExhausted, "rate limit exceeded")
+	}
+
+	b := broker.NewBroker()
+	handler := ws.NewHandler(chat.NewMockService(repoMocks.NewChatRepositoryMock(mc), b), authClient{}, b, limit, wsConfig)
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	conn, resp, err := dial(t, server, fmt.Sprintf("user_id=%d&access_token=%s", userID, accessToken), nil)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	defer func() { _ = conn.Close() }()
+
+	require.NoError(t, conn.WriteJSON(frame{"type": "send", "request_id": "1", "chat_id": chatID, "text": gofakeit.Sentence(5)}))
+
+	var limited frame
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+	require.NoError(t, conn.ReadJSON(&limited))
+	require.Equal(t, "error", limited["type"])
+	require.Equal(t, "1", limited["request_id"])
+	require.Equal(t, codes.ResourceExhausted.String(), limited["code"])
+}
+
+func TestHandlerShutdown(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc     = minimock.NewController(t)
+		ctx    = context.Background()
+		userID = int64(gofakeit.Number(1, 1000000))
+		query  = fmt.Sprintf("user_id=%d&access_token=%s", userID, accessToken)
+	)
+
+	b := broker.NewBroker()
+	handler := ws.NewHandler(chat.NewMockService(repoMocks.NewChatRepositoryMock(mc), b), authClient{}, b, interceptor.ValidateInterceptor, wsConfig)
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	conn, resp, err := dial(t, server, query, nil)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	defer func() { _ = conn.Close() }()
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- handler.Shutdown(shutdownCtx)
+	}()
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+	_, _, err = conn.ReadMessage()
+	require.True(t, websocket.IsCloseError(err, websocket.CloseServiceRestart), err)
+	require.NoError(t, <-errCh)
+
+	// connections accepted after the shutdown are closed right away
+	conn, resp, err = dial(t, server, query, nil)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	defer func() { _ = conn.Close() }()
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+	_, _, err = conn.ReadMessage()
+	require.True(t, websocket.IsCloseError(err, websocket.CloseServiceRestart), err)
+}
@@ -4,33 +4,92 @@ import (
 	"context"
 	"log"
 	"net"
+	"net/http"
 	"os"
-
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"connectrpc.com/vanguard/vanguardgrpc"
 	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/mikhailsoldatkin/chat-server/internal/health"
 	"github.com/mikhailsoldatkin/chat-server/internal/interceptor"
 	"github.com/mikhailsoldatkin/chat-server/internal/logger"
+	"github.com/mikhailsoldatkin/chat-server/internal/metric"
+	"github.com/mikhailsoldatkin/chat-server/internal/tlsconfig"
 	"github.com/mikhailsoldatkin/chat-server/internal/tracing"
 	"github.com/natefinch/lumberjack"
+	"github.com/pkg/errors"
+	"github.com/rs/cors"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
+	grpcHealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/mikhailsoldatkin/chat-server/internal/config"
 	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
-	"github.com/mikhailsoldatkin/platform_common/pkg/closer"
+	"github.com/mikhailsoldatkin/chat-server/pkg/swagger"
+)
+
+const (
+	traceIDHeader     = "x-trace-id"
+	retryAfterHeader  = "retry-after"
+	traceParentHeader = "traceparent"
+	traceStateHeader  = "tracestate"
+	baggageHeader     = "baggage"
+	apiKeyHeader      = "x-api-key"
+	swaggerPath       = "/api.swagger.json"
+	webSocketPath     = "/chat/v1/ws"
+	metricsPath       = "/metrics"
+	healthPostgres    = "postgres"
+	healthAuth        = "auth"
 )
 
-// App represents the application with its dependencies and GRPC server.
+// corsHeaders are the request headers browsers may send to the HTTP/JSON gateway and, along with
+// the protocol headers, to the GRPC port serving Connect and gRPC-Web.
+var corsHeaders = []string{
+	"Accept", "Content-Type", "Content-Length", "Authorization",
+	traceParentHeader, traceStateHeader, baggageHeader, apiKeyHeader,
+}
+
+// App represents the application with its dependencies, GRPC server, HTTP/JSON gateway server
+// and metrics server.
 type App struct {
+	args            []string
+	options         options
+	config          *config.Config
 	serviceProvider *serviceProvider
 	grpcServer      *grpc.Server
+	grpcWebServer   *http.Server
+	httpServer      *http.Server
+	metricsServer   *http.Server
+	healthChecker   *health.Checker
+	tracerProvider  *sdktrace.TracerProvider
+	tlsReloader     *tlsconfig.Reloader
+	workers         sync.WaitGroup
+
+	// unaryInterceptor chains the unary interceptors of the GRPC server.
+	unaryInterceptor grpc.UnaryServerInterceptor
 }
 
 // NewApp initializes a new App instance with the given context and sets up the necessary dependencies.
-func NewApp(ctx context.Context) (*App, error) {
-	a := &App{}
+// The configuration is loaded with the command-line arguments args, see config.Load. The options inject
+// the configuration and dependencies instead.
+func NewApp(ctx context.Context, args []string, opts ...Option) (*App, error) {
+	a := &App{args: args}
+	for _, opt := range opts {
+		opt(&a.options)
+	}
 
 	err := a.initDeps(ctx)
 	if err != nil {
@@ -40,14 +99,192 @@ func NewApp(ctx context.Context) (*App, error) {
 	return a, nil
 }
 
-// Run starts the GRPC server and handles graceful shutdown.
+// Run starts the GRPC server, the HTTP/JSON gateway server, the metrics server and the background workers.
+// It blocks until SIGINT or SIGTERM is received or a server fails and then shuts the App down gracefully.
 func (a *App) Run() error {
-	defer func() {
-		closer.CloseAll()
-		closer.Wait()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	workersCtx, cancelWorkers := context.WithCancel(context.Background())
+	defer cancelWorkers()
+
+	a.runWorker(workersCtx, a.healthChecker.Run)
+	if interval := a.serviceProvider.Config().TLS.ReloadInterval; a.tlsReloader != nil && interval > 0 {
+		a.runWorker(workersCtx, func(ctx context.Context) {
+			a.tlsReloader.Run(ctx, interval)
+		})
+	}
+
+	errCh := make(chan error, 3)
+
+	go func() {
+		lis, err := net.Listen("tcp", a.serviceProvider.config.GRPC.Address)
+		if err != nil {
+			errCh <- errors.WithMessage(err, "failed to run GRPC server")
+			return
+		}
+
+		errCh <- errors.WithMessage(a.runGRPCServer(lis), "failed to run GRPC server")
+	}()
+
+	if a.httpServer != nil {
+		go func() {
+			errCh <- errors.WithMessage(a.runHTTPServer(), "failed to run HTTP server")
+		}()
+	}
+
+	go func() {
+		errCh <- errors.WithMessage(a.runMetricsServer(), "failed to run metrics server")
+	}()
+
+	var err error
+	select {
+	case <-ctx.Done():
+		log.Print("shutting down")
+	case err = <-errCh:
+	}
+	stop()
+
+	a.shutdown(cancelWorkers)
+
+	return err
+}
+
+// Serve serves the GRPC server with all its interceptors on the listener until the App is stopped, without
+// the HTTP/JSON gateway, the metrics server and the background workers. It is meant for end-to-end tests
+// serving the App on an in-memory listener.
+func (a *App) Serve(lis net.Listener) error {
+	return a.runGRPCServer(lis)
+}
+
+// Stop shuts the App served with Serve down gracefully, see Run.
+func (a *App) Stop() {
+	a.shutdown(func() {})
+}
+
+// runWorker runs a background worker until the context is done, the worker is expected to return
+// after finishing its current batch.
+func (a *App) runWorker(ctx context.Context, worker func(ctx context.Context)) {
+	a.workers.Add(1)
+
+	go func() {
+		defer a.workers.Done()
+		worker(ctx)
 	}()
+}
+
+// shutdown stops the App within the configured timeout. The health status is switched to NOT_SERVING first,
+// streaming clients are asked to reconnect (WebSocket clients with the service restart close code, GRPC clients
+// with GOAWAY) and the servers stop accepting requests, waiting for the running ones to complete.
+// Then the background workers are stopped and the dependencies are closed.
+func (a *App) shutdown(cancelWorkers context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(context.Background(), a.serviceProvider.Config().Shutdown.Timeout)
+	defer cancel()
+
+	a.healthChecker.Shutdown()
+
+	if a.serviceProvider.webSocketHandler != nil {
+		err := a.serviceProvider.webSocketHandler.Shutdown(ctx)
+		if err != nil {
+			log.Printf("failed to close websocket connections: %v", err)
+		}
+	}
+
+	a.stopServers(ctx)
 
-	return a.runGRPCServer()
+	cancelWorkers()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		a.workers.Wait()
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		log.Print("background workers did not finish in time")
+	}
+
+	a.closeDeps(ctx)
+}
+
+// stopServers gracefully stops all servers, the GRPC server is stopped forcibly if the running requests
+// don't complete before the context is done.
+func (a *App) stopServers(ctx context.Context) {
+	wg := &sync.WaitGroup{}
+	wg.Add(3)
+
+	go func() {
+		defer wg.Done()
+
+		if a.grpcWebServer != nil {
+			err := a.grpcWebServer.Shutdown(ctx)
+			if err != nil {
+				log.Printf("failed to stop GRPC server: %v", err)
+			}
+		}
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			a.grpcServer.GracefulStop()
+		}()
+
+		select {
+		case <-done:
+		case <-ctx.Done():
+			log.Print("GRPC server did not stop in time, cancelling running requests")
+			a.grpcServer.Stop()
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		if a.httpServer == nil {
+			return
+		}
+
+		err := a.httpServer.Shutdown(ctx)
+		if err != nil {
+			log.Printf("failed to stop HTTP server: %v", err)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		err := a.metricsServer.Shutdown(ctx)
+		if err != nil {
+			log.Printf("failed to stop metrics server: %v", err)
+		}
+	}()
+
+	wg.Wait()
+}
+
+// closeDeps closes the database, the tracer and the authentication service connection in this order,
+// once the servers and the workers don't use them anymore.
+func (a *App) closeDeps(ctx context.Context) {
+	if a.serviceProvider.dbClient != nil {
+		err := a.serviceProvider.dbClient.Close()
+		if err != nil {
+			log.Printf("failed to close db client: %v", err)
+		}
+	}
+
+	if a.tracerProvider != nil {
+		err := a.tracerProvider.Shutdown(ctx)
+		if err != nil {
+			log.Printf("failed to close tracer: %v", err)
+		}
+	}
+
+	if a.serviceProvider.authConn != nil {
+		err := a.serviceProvider.authConn.Close()
+		if err != nil {
+			log.Printf("failed to close authentication server connection: %v", err)
+		}
+	}
 }
 
 // initDeps initializes the dependencies required by the App.
@@ -55,9 +292,14 @@ func (a *App) initDeps(ctx context.Context) error {
 	inits := []func(context.Context) error{
 		a.initConfig,
 		a.initServiceProvider,
-		a.initGRPCServer,
 		a.initLogger,
 		a.initTracing,
+		a.initTLS,
+		a.initGRPCServer,
+		a.initHealthServer,
+		a.initGRPCWebServer,
+		a.initHTTPServer,
+		a.initMetricsServer,
 	}
 
 	for _, f := range inits {
@@ -71,49 +313,309 @@ func (a *App) initDeps(ctx context.Context) error {
 }
 
 func (a *App) initConfig(_ context.Context) error {
-	_, err := config.Load()
+	if a.options.config != nil {
+		err := a.options.config.Validate()
+		if err != nil {
+			return err
+		}
+
+		a.config = a.options.config
+
+		return nil
+	}
+
+	cfg, err := config.Load(a.args)
 	if err != nil {
 		return err
 	}
 
+	a.config = cfg
+
 	return nil
 }
 
 func (a *App) initServiceProvider(_ context.Context) error {
-	a.serviceProvider = newServiceProvider()
+	a.serviceProvider = newServiceProvider(a.config, a.options)
 
 	return nil
 }
 
 func (a *App) initGRPCServer(ctx context.Context) error {
-	creds, err := credentials.NewServerTLSFromFile("cert/service.pem", "cert/service.key")
+	clientCertInterceptor, clientCertStreamInterceptor := a.clientCertInterceptors()
+
+	interceptors := []grpc.UnaryServerInterceptor{
+		interceptor.MetricsInterceptor,
+		interceptor.TraceIDInterceptor,
+		interceptor.LoggingInterceptor(a.serviceProvider.Config().Logger.AccessSampleRatio),
+		interceptor.RecoveryInterceptor,
+		interceptor.DeadlineInterceptor(
+			a.serviceProvider.Config().Deadline.Default,
+			a.serviceProvider.Config().Deadline.Methods,
+		),
+	}
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		interceptor.MetricsStreamInterceptor,
+		interceptor.TraceIDStreamInterceptor,
+		interceptor.LoggingStreamInterceptor(a.serviceProvider.Config().Logger.AccessSampleRatio),
+		interceptor.RecoveryStreamInterceptor,
+	}
+	if clientCertInterceptor != nil {
+		interceptors = append(interceptors, clientCertInterceptor)
+		streamInterceptors = append(streamInterceptors, clientCertStreamInterceptor)
+	}
+
+	authClient, err := a.serviceProvider.AuthClient()
+	if err != nil {
+		return err
+	}
+
+	keys, err := a.serviceProvider.APIKeyService(ctx)
 	if err != nil {
-		log.Fatalf("failed to load TLS credentials from files: %v", err)
+		return err
 	}
 
+	interceptors = append(interceptors, interceptor.AuthInterceptor(authClient, keys))
+	streamInterceptors = append(streamInterceptors, interceptor.AuthStreamInterceptor(authClient, keys))
+	if a.serviceProvider.Config().RateLimit.Enabled {
+		limiter, err := a.serviceProvider.RateLimiter(ctx)
+		if err != nil {
+			return err
+		}
+
+		interceptors = append(interceptors, interceptor.RateLimitInterceptor(limiter))
+	}
+	interceptors = append(interceptors, interceptor.ValidateInterceptor)
+	a.unaryInterceptor = grpcMiddleware.ChainUnaryServer(interceptors...)
+
 	a.grpcServer = grpc.NewServer(
-		grpc.Creds(creds),
-		grpc.UnaryInterceptor(
-			grpcMiddleware.ChainUnaryServer(
-				interceptor.ServerTracingInterceptor,
-				interceptor.AuthInterceptor(a.serviceProvider.AuthClient()),
-			),
-		),
+		grpc.Creds(a.serverCredentials()),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.UnaryInterceptor(a.unaryInterceptor),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
 	)
 
 	reflection.Register(a.grpcServer)
 
-	pb.RegisterChatV1Server(a.grpcServer, a.serviceProvider.ChatImplementation(ctx))
+	impl, err := a.serviceProvider.ChatImplementation(ctx)
+	if err != nil {
+		return err
+	}
+
+	pb.RegisterChatV1Server(a.grpcServer, impl)
 
 	return nil
 }
 
-func (a *App) runGRPCServer() error {
-	lis, err := net.Listen("tcp", a.serviceProvider.config.GRPC.Address)
+// initHealthServer registers the standard gRPC health service on the GRPC server. The statuses are driven
+// by periodic checks of the database and the authentication service connection.
+func (a *App) initHealthServer(ctx context.Context) error {
+	healthServer := grpcHealth.NewServer()
+	healthpb.RegisterHealthServer(a.grpcServer, healthServer)
+
+	// there are no dependencies to check in dev mode
+	checks := map[string]health.Check{}
+	if !a.serviceProvider.Config().Dev.Enabled {
+		dbClient, err := a.serviceProvider.DBClient(ctx)
+		if err != nil {
+			return err
+		}
+
+		authConn, err := a.serviceProvider.AuthConn()
+		if err != nil {
+			return err
+		}
+
+		checks[healthPostgres] = health.DBCheck(dbClient)
+		checks[healthAuth] = health.ConnCheck(authConn)
+	}
+
+	cfg := a.serviceProvider.Config().Health
+	a.healthChecker = health.NewChecker(
+		healthServer,
+		[]string{pb.ChatV1_ServiceDesc.ServiceName},
+		checks,
+		cfg.CheckInterval,
+		cfg.CheckTimeout,
+	)
+
+	return nil
+}
+
+// initGRPCWebServer initializes the HTTP server serving the GRPC server on the GRPC port, so that besides
+// native gRPC it accepts Connect and gRPC-Web requests from browsers and curl. All requests are translated
+// into gRPC and handled by the GRPC server with its interceptors.
+func (a *App) initGRPCWebServer(_ context.Context) error {
+	if !a.serviceProvider.Config().GRPC.WebEnabled {
+		return nil
+	}
+
+	transcoder, err := vanguardgrpc.NewTranscoder(a.grpcServer)
 	if err != nil {
 		return err
 	}
 
+	corsMiddleware := cors.New(cors.Options{
+		AllowedOrigins: a.serviceProvider.Config().HTTP.AllowedOrigins,
+		AllowedMethods: []string{"GET", "POST", "OPTIONS"},
+		AllowedHeaders: append(
+			[]string{"Connect-Protocol-Version", "Connect-Timeout-Ms", "Grpc-Timeout", "X-Grpc-Web", "X-User-Agent"},
+			corsHeaders...,
+		),
+		ExposedHeaders: []string{
+			"Grpc-Status", "Grpc-Message", "Grpc-Status-Details-Bin", traceIDHeader, retryAfterHeader,
+		},
+		AllowCredentials: true,
+	})
+
+	a.grpcWebServer = &http.Server{
+		Addr:              a.serviceProvider.Config().GRPC.Address,
+		Handler:           corsMiddleware.Handler(transcoder),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	if a.tlsReloader != nil {
+		a.grpcWebServer.TLSConfig = a.tlsReloader.ServerConfig(a.serviceProvider.Config().TLS.ClientAuth)
+	} else {
+		// native gRPC needs HTTP/2, served over plaintext connections with h2c
+		a.grpcWebServer.Handler = h2c.NewHandler(a.grpcWebServer.Handler, &http2.Server{})
+	}
+
+	return nil
+}
+
+// initHTTPServer initializes the HTTP server translating HTTP/JSON requests into GRPC calls to the GRPC server,
+// so the requests pass the same interceptors. The Authorization header is forwarded as GRPC metadata,
+// the trace ID and rate limit headers set by the interceptors are returned as HTTP headers.
+func (a *App) initHTTPServer(ctx context.Context) error {
+	if a.serviceProvider.Config().TLS.ClientAuth {
+		// the gateways call the GRPC server on behalf of clients they can't check the certificates of
+		logger.Warn("the HTTP/JSON and WebSocket gateways are disabled, clients must present certificates")
+		return nil
+	}
+
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+	)
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(localCredentials(a.serviceProvider.Config().TLS, a.tlsReloader)),
+	}
+
+	endpoint := net.JoinHostPort("localhost", strconv.Itoa(a.serviceProvider.Config().GRPC.Port))
+	err := pb.RegisterChatV1HandlerFromEndpoint(ctx, mux, endpoint, opts)
+	if err != nil {
+		return err
+	}
+
+	webSocketHandler, err := a.serviceProvider.WebSocketHandler(ctx, a.unaryInterceptor)
+	if err != nil {
+		return err
+	}
+
+	handler := http.NewServeMux()
+	handler.Handle("/", mux)
+	handler.HandleFunc(swaggerPath, serveSwagger)
+	handler.Handle(webSocketPath, webSocketHandler)
+
+	corsMiddleware := cors.New(cors.Options{
+		AllowedOrigins:   a.serviceProvider.Config().HTTP.AllowedOrigins,
+		AllowedMethods:   []string{"GET", "POST", "DELETE", "OPTIONS"},
+		AllowedHeaders:   corsHeaders,
+		ExposedHeaders:   []string{traceIDHeader, retryAfterHeader},
+		AllowCredentials: true,
+	})
+
+	a.httpServer = &http.Server{
+		Addr:              a.serviceProvider.Config().HTTP.Address,
+		Handler:           corsMiddleware.Handler(handler),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	return nil
+}
+
+// initMetricsServer initializes the HTTP server exposing Prometheus metrics.
+func (a *App) initMetricsServer(_ context.Context) error {
+	mux := http.NewServeMux()
+	mux.Handle(metricsPath, metric.Handler())
+
+	a.metricsServer = &http.Server{
+		Addr:              a.serviceProvider.Config().Metrics.Address,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	return nil
+}
+
+// incomingHeaderMatcher forwards the W3C trace context and baggage headers as GRPC metadata, so the GRPC
+// server continues the traces of HTTP clients, and the API key header of bots, other headers are forwarded
+// by the default rules.
+func incomingHeaderMatcher(key string) (string, bool) {
+	switch strings.ToLower(key) {
+	case traceParentHeader, traceStateHeader, baggageHeader, apiKeyHeader:
+		return strings.ToLower(key), true
+	default:
+		return runtime.DefaultHeaderMatcher(key)
+	}
+}
+
+// outgoingHeaderMatcher returns the trace ID and rate limit GRPC headers as plain HTTP headers,
+// other headers are returned with the default Grpc-Metadata- prefix.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	switch strings.ToLower(key) {
+	case traceIDHeader, retryAfterHeader:
+		return key, true
+	default:
+		return runtime.MetadataHeaderPrefix + key, true
+	}
+}
+
+// serveSwagger serves the OpenAPI specification of the HTTP API.
+func serveSwagger(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(swagger.Spec)
+}
+
+func (a *App) runHTTPServer() error {
+	log.Printf("HTTP server is running on %d", a.serviceProvider.config.HTTP.Port)
+
+	err := a.httpServer.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+func (a *App) runMetricsServer() error {
+	log.Printf("metrics server is running on %d", a.serviceProvider.config.Metrics.Port)
+
+	err := a.metricsServer.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+func (a *App) runGRPCServer(lis net.Listener) error {
+	var err error
+	if a.grpcWebServer != nil {
+		log.Printf("gRPC server with Connect and gRPC-Web support is running on %d", a.serviceProvider.config.GRPC.Port)
+
+		if a.tlsReloader != nil {
+			err = a.grpcWebServer.ServeTLS(lis, "", "")
+		} else {
+			err = a.grpcWebServer.Serve(lis)
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+
+		return nil
+	}
+
 	log.Printf("gRPC server is running on %d", a.serviceProvider.config.GRPC.Port)
 
 	err = a.grpcServer.Serve(lis)
@@ -126,18 +628,20 @@ func (a *App) runGRPCServer() error {
 
 // initLogger initializes the app logger.
 func (a *App) initLogger(_ context.Context) error {
+	cfg := a.serviceProvider.Config().Logger
+
 	var level zapcore.Level
-	if err := level.Set(a.serviceProvider.config.Logger.Level); err != nil {
+	if err := level.Set(cfg.Level); err != nil {
 		return err
 	}
 
 	stdout := zapcore.AddSync(os.Stdout)
 
 	file := zapcore.AddSync(&lumberjack.Logger{
-		Filename:   a.serviceProvider.config.Logger.Filename,
-		MaxSize:    a.serviceProvider.config.Logger.MaxSizeMB,
-		MaxBackups: a.serviceProvider.config.Logger.MaxBackups,
-		MaxAge:     a.serviceProvider.config.Logger.MaxAgeDays,
+		Filename:   cfg.Filename,
+		MaxSize:    cfg.MaxSizeMB,
+		MaxBackups: cfg.MaxBackups,
+		MaxAge:     cfg.MaxAgeDays,
 	})
 
 	productionCfg := zap.NewProductionEncoderConfig()
@@ -159,8 +663,21 @@ func (a *App) initLogger(_ context.Context) error {
 	return nil
 }
 
-// initTracing initializes the tracing for the application by setting up the Jaeger tracer.
-func (a *App) initTracing(_ context.Context) error {
-	tracing.Init(logger.Logger(), "chat-server", a.serviceProvider.config.Jaeger.Address)
+// initTracing initializes the OpenTelemetry tracing exporting spans to the OTLP collector.
+func (a *App) initTracing(ctx context.Context) error {
+	if a.serviceProvider.Config().Dev.Enabled {
+		logger.Warn("dev mode: data is kept in memory, authentication is faked, spans aren't exported")
+		// traces of clients are continued all the same, so responses carry their trace IDs
+		tracing.InitPropagation()
+		return nil
+	}
+
+	provider, err := tracing.Init(ctx, a.serviceProvider.Config().Tracing)
+	if err != nil {
+		return err
+	}
+
+	a.tracerProvider = provider
+
 	return nil
 }
@@ -0,0 +1,34 @@
+package app
+
+import (
+	"context"
+	"net"
+	"strconv"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/config"
+	"github.com/mikhailsoldatkin/chat-server/internal/health"
+	"github.com/mikhailsoldatkin/chat-server/internal/tlsconfig"
+)
+
+// HealthCheck asks the locally running server for its health status and returns an error unless the server
+// is serving. It is meant to be run as the container health check, with the same command-line arguments
+// as the server.
+func HealthCheck(ctx context.Context, args []string) error {
+	cfg, err := config.Load(args)
+	if err != nil {
+		return err
+	}
+
+	var r *tlsconfig.Reloader
+	if !cfg.TLS.Insecure {
+		r, err = tlsconfig.NewReloader(cfg.TLS.CertFile, cfg.TLS.KeyFile, cfg.TLS.CAFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, cfg.Health.CheckTimeout)
+	defer cancel()
+
+	return health.Probe(ctx, net.JoinHostPort("localhost", strconv.Itoa(cfg.GRPC.Port)), localCredentials(cfg.TLS, r))
+}
@@ -0,0 +1,66 @@
+package app
+
+import (
+	"github.com/mikhailsoldatkin/chat-server/internal/client"
+	"github.com/mikhailsoldatkin/chat-server/internal/config"
+	"github.com/mikhailsoldatkin/chat-server/internal/repository"
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+)
+
+// Option injects a dependency into the App instead of creating it from the configuration, e.g. to run the App
+// in tests without the database and the authentication service.
+type Option func(o *options)
+
+// options are the dependencies injected into the App, nil ones are created from the configuration.
+type options struct {
+	config           *config.Config
+	txManager        db.TxManager
+	chatRepository   repository.ChatRepository
+	reportRepository repository.ReportRepository
+	apiKeyRepository repository.APIKeyRepository
+	authClient       client.AuthClient
+}
+
+// WithConfig makes the App use the configuration instead of loading it, the command-line arguments are ignored.
+// The configuration is validated all the same.
+func WithConfig(cfg *config.Config) Option {
+	return func(o *options) {
+		o.config = cfg
+	}
+}
+
+// WithTxManager makes the App run transactions with the manager.
+func WithTxManager(m db.TxManager) Option {
+	return func(o *options) {
+		o.txManager = m
+	}
+}
+
+// WithChatRepository makes the App store chats in the repository.
+func WithChatRepository(r repository.ChatRepository) Option {
+	return func(o *options) {
+		o.chatRepository = r
+	}
+}
+
+// WithReportRepository makes the App store reports in the repository.
+func WithReportRepository(r repository.ReportRepository) Option {
+	return func(o *options) {
+		o.reportRepository = r
+	}
+}
+
+// WithAPIKeyRepository makes the App store API keys in the repository.
+func WithAPIKeyRepository(r repository.APIKeyRepository) Option {
+	return func(o *options) {
+		o.apiKeyRepository = r
+	}
+}
+
+// WithAuthClient makes the App call the client instead of the authentication service. The access mode still
+// applies, so in local mode the client is only asked whether users exist.
+func WithAuthClient(cl client.AuthClient) Option {
+	return func(o *options) {
+		o.authClient = cl
+	}
+}
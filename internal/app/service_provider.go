@@ -3,25 +3,43 @@ package app
 import (
 	"context"
 	"log"
+	"time"
 
-	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
 	pbAccess "github.com/mikhailsoldatkin/auth/pkg/access_v1"
 	pbUser "github.com/mikhailsoldatkin/auth/pkg/user_v1"
 	"github.com/mikhailsoldatkin/chat-server/internal/api/chat"
+	"github.com/mikhailsoldatkin/chat-server/internal/api/ws"
+	"github.com/mikhailsoldatkin/chat-server/internal/broker"
 	"github.com/mikhailsoldatkin/chat-server/internal/client"
 	"github.com/mikhailsoldatkin/chat-server/internal/client/auth"
 	"github.com/mikhailsoldatkin/chat-server/internal/config"
+	"github.com/mikhailsoldatkin/chat-server/internal/metric"
+	"github.com/mikhailsoldatkin/chat-server/internal/moderation"
+	"github.com/mikhailsoldatkin/chat-server/internal/ratelimit"
+	"github.com/mikhailsoldatkin/chat-server/internal/rbac"
+	"github.com/mikhailsoldatkin/chat-server/internal/replica"
 	"github.com/mikhailsoldatkin/chat-server/internal/repository"
+	apiKeyRepository "github.com/mikhailsoldatkin/chat-server/internal/repository/apikey"
 	chatRepository "github.com/mikhailsoldatkin/chat-server/internal/repository/chat"
+	reportRepository "github.com/mikhailsoldatkin/chat-server/internal/repository/report"
 	"github.com/mikhailsoldatkin/chat-server/internal/service"
+	apiKeyService "github.com/mikhailsoldatkin/chat-server/internal/service/apikey"
 	chatService "github.com/mikhailsoldatkin/chat-server/internal/service/chat"
-	"github.com/mikhailsoldatkin/platform_common/pkg/closer"
+	reportService "github.com/mikhailsoldatkin/chat-server/internal/service/report"
+	"github.com/mikhailsoldatkin/chat-server/internal/tracing"
 	"github.com/mikhailsoldatkin/platform_common/pkg/db"
 	"github.com/mikhailsoldatkin/platform_common/pkg/db/pg"
 	"github.com/mikhailsoldatkin/platform_common/pkg/db/transaction"
-	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	rateLimitStoreMemory   = "memory"
+	rateLimitStorePostgres = "postgres"
 )
 
 type serviceProvider struct {
@@ -29,106 +47,435 @@ type serviceProvider struct {
 	dbClient           db.Client
 	txManager          db.TxManager
 	chatRepository     repository.ChatRepository
+	reportRepository   repository.ReportRepository
+	apiKeyRepository   repository.APIKeyRepository
 	chatService        service.ChatService
+	reportService      service.ReportService
+	apiKeyService      service.APIKeyService
+	authConn           *grpc.ClientConn
+	remoteAuth         client.AuthClient
 	authClient         client.AuthClient
+	accessPolicy       *rbac.Policy
+	tokenVerifier      *rbac.Verifier
 	chatImplementation *chat.Implementation
+	rateLimiter        *ratelimit.Limiter
+	moderator          moderation.Moderator
+	broker             broker.Broker
+	webSocketHandler   *ws.Handler
 }
 
-func newServiceProvider() *serviceProvider {
-	return &serviceProvider{}
+// newServiceProvider creates the provider of the dependencies, the injected ones are used as is and the others
+// are created from the configuration on first use.
+func newServiceProvider(cfg *config.Config, injected options) *serviceProvider {
+	return &serviceProvider{
+		config:           cfg,
+		txManager:        injected.txManager,
+		chatRepository:   injected.chatRepository,
+		reportRepository: injected.reportRepository,
+		apiKeyRepository: injected.apiKeyRepository,
+		remoteAuth:       injected.authClient,
+	}
 }
 
 func (s *serviceProvider) Config() *config.Config {
-	if s.config == nil {
-		cfg, err := config.Load()
+	return s.config
+}
+
+func (s *serviceProvider) DBClient(ctx context.Context) (db.Client, error) {
+	if s.dbClient == nil {
+		replicas := make([]db.Client, 0, len(s.Config().DB.ReplicaDSNs))
+		for i, dsn := range s.Config().DB.ReplicaDSNs {
+			cl, err := newDBClient(ctx, dsn, "replica "+s.Config().DB.ReplicaHosts[i])
+			if err != nil {
+				closeDBClients(replicas)
+				return nil, err
+			}
+
+			replicas = append(replicas, cl)
+		}
+
+		primary, err := newDBClient(ctx, s.Config().DB.PostgresDSN, "primary")
 		if err != nil {
-			log.Fatal(err)
+			closeDBClients(replicas)
+			return nil, err
 		}
-		s.config = cfg
+
+		s.dbClient = replica.NewClient(primary, replicas...)
 	}
 
-	return s.config
+	return s.dbClient, nil
 }
 
-func (s *serviceProvider) DBClient(ctx context.Context) db.Client {
-	if s.dbClient == nil {
-		cl, err := pg.New(ctx, s.Config().DB.PostgresDSN)
+// newDBClient connects to the database server and checks the connection, the name identifies the server
+// in errors.
+func newDBClient(ctx context.Context, dsn, name string) (db.Client, error) {
+	cl, err := pg.New(ctx, dsn)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to create db client of the %s", name)
+	}
+
+	err = cl.DB().Ping(ctx)
+	if err != nil {
+		closeDBClients([]db.Client{cl})
+		return nil, errors.WithMessagef(err, "db ping error of the %s", name)
+	}
+
+	return metric.NewDBClient(tracing.NewDBClient(cl)), nil
+}
+
+// closeDBClients closes the clients connected before connecting to another database server failed.
+func closeDBClients(clients []db.Client) {
+	for _, cl := range clients {
+		if err := cl.Close(); err != nil {
+			log.Printf("failed to close db client: %v", err)
+		}
+	}
+}
+
+func (s *serviceProvider) TxManager(ctx context.Context) (db.TxManager, error) {
+	if s.txManager == nil && s.Config().Dev.Enabled {
+		s.txManager = repository.NewNoOpTxManager()
+	}
+	if s.txManager == nil {
+		cl, err := s.DBClient(ctx)
 		if err != nil {
-			log.Fatalf("failed to create db client: %v", err)
+			return nil, err
 		}
 
-		err = cl.DB().Ping(ctx)
+		s.txManager = replica.NewTxManager(transaction.NewTransactionManager(cl.DB()))
+	}
+
+	return s.txManager, nil
+}
+
+func (s *serviceProvider) ChatRepository(ctx context.Context) (repository.ChatRepository, error) {
+	if s.chatRepository == nil && s.Config().Dev.Enabled {
+		s.chatRepository = chatRepository.NewMemoryRepository()
+	}
+	if s.chatRepository == nil {
+		cl, err := s.DBClient(ctx)
 		if err != nil {
-			log.Fatalf("db ping error: %s", err.Error())
+			return nil, err
 		}
-		closer.Add(cl.Close)
 
-		s.dbClient = cl
+		s.chatRepository = chatRepository.NewRepository(cl)
 	}
 
-	return s.dbClient
+	return s.chatRepository, nil
 }
 
-func (s *serviceProvider) TxManager(ctx context.Context) db.TxManager {
-	if s.txManager == nil {
-		s.txManager = transaction.NewTransactionManager(s.DBClient(ctx).DB())
+func (s *serviceProvider) ReportRepository(ctx context.Context) (repository.ReportRepository, error) {
+	if s.reportRepository == nil && s.Config().Dev.Enabled {
+		s.reportRepository = reportRepository.NewMemoryRepository()
 	}
+	if s.reportRepository == nil {
+		cl, err := s.DBClient(ctx)
+		if err != nil {
+			return nil, err
+		}
 
-	return s.txManager
+		s.reportRepository = reportRepository.NewRepository(cl)
+	}
+
+	return s.reportRepository, nil
 }
 
-func (s *serviceProvider) ChatRepository(ctx context.Context) repository.ChatRepository {
-	if s.chatRepository == nil {
-		s.chatRepository = chatRepository.NewRepository(s.DBClient(ctx))
+func (s *serviceProvider) APIKeyRepository(ctx context.Context) (repository.APIKeyRepository, error) {
+	if s.apiKeyRepository == nil && s.Config().Dev.Enabled {
+		s.apiKeyRepository = apiKeyRepository.NewMemoryRepository()
+	}
+	if s.apiKeyRepository == nil {
+		cl, err := s.DBClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		s.apiKeyRepository = apiKeyRepository.NewRepository(cl)
 	}
 
-	return s.chatRepository
+	return s.apiKeyRepository, nil
 }
 
-func (s *serviceProvider) ChatService(ctx context.Context) service.ChatService {
+func (s *serviceProvider) ChatService(ctx context.Context) (service.ChatService, error) {
 	if s.chatService == nil {
-		s.chatService = chatService.NewService(
-			s.ChatRepository(ctx),
-			s.TxManager(ctx),
-		)
+		repo, err := s.ChatRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		txManager, err := s.TxManager(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		moderator, err := s.Moderator()
+		if err != nil {
+			return nil, err
+		}
+
+		s.chatService = chatService.NewService(repo, txManager, moderator, s.Broker())
 	}
 
-	return s.chatService
+	return s.chatService, nil
 }
 
-func (s *serviceProvider) AuthClient() client.AuthClient {
-	if s.authClient == nil {
-		creds, err := credentials.NewClientTLSFromFile("cert/ca.cert", "")
+func (s *serviceProvider) ReportService(ctx context.Context) (service.ReportService, error) {
+	if s.reportService == nil {
+		chatRepo, err := s.ChatRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		reportRepo, err := s.ReportRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		txManager, err := s.TxManager(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		s.reportService = reportService.NewService(chatRepo, reportRepo, txManager, s.Broker())
+	}
+
+	return s.reportService, nil
+}
+
+func (s *serviceProvider) APIKeyService(ctx context.Context) (service.APIKeyService, error) {
+	if s.apiKeyService == nil {
+		repo, err := s.APIKeyRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		s.apiKeyService = apiKeyService.NewService(repo)
+	}
+
+	return s.apiKeyService, nil
+}
+
+func (s *serviceProvider) Broker() broker.Broker {
+	if s.broker == nil {
+		s.broker = broker.NewBroker()
+	}
+
+	return s.broker
+}
+
+func (s *serviceProvider) Moderator() (moderation.Moderator, error) {
+	if s.moderator == nil {
+		rulesFile := s.Config().Moderation.RulesFile
+		if rulesFile == "" {
+			s.moderator = moderation.NewModerator()
+			return s.moderator, nil
+		}
+
+		m, err := moderation.NewModeratorFromFile(rulesFile)
 		if err != nil {
-			log.Fatalf("failed to load TLS credentials from file: %v", err)
+			return nil, errors.WithMessage(err, "failed to create moderator")
+		}
+
+		s.moderator = m
+	}
+
+	return s.moderator, nil
+}
+
+func (s *serviceProvider) AuthConn() (*grpc.ClientConn, error) {
+	if s.authConn == nil {
+		cfg := s.Config().Auth
+
+		creds := insecure.NewCredentials()
+		if !cfg.Insecure {
+			var err error
+			creds, err = credentials.NewClientTLSFromFile(cfg.CAFile, "")
+			if err != nil {
+				return nil, errors.WithMessagef(err, "failed to load authentication server CA bundle %s", cfg.CAFile)
+			}
 		}
 
 		conn, err := grpc.NewClient(
-			s.Config().Auth.Address,
+			cfg.Address,
 			grpc.WithTransportCredentials(creds),
-			grpc.WithUnaryInterceptor(otgrpc.OpenTracingClientInterceptor(opentracing.GlobalTracer())),
+			grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
 		)
 		if err != nil {
-			log.Fatalf("failed to create connection to authentication server: %v", err)
+			return nil, errors.WithMessage(err, "failed to create connection to authentication server")
+		}
+
+		s.authConn = conn
+	}
+
+	return s.authConn, nil
+}
+
+func (s *serviceProvider) AuthClient() (client.AuthClient, error) {
+	if s.authClient == nil {
+		cl, err := s.remoteAuthClient()
+		if err != nil {
+			return nil, err
 		}
-		closer.Add(conn.Close)
 
-		s.authClient = auth.NewAuthClient(
-			pbAccess.NewAccessV1Client(conn),
-			pbUser.NewUserV1Client(conn),
+		mode := s.Config().Access.Mode
+		var (
+			policy   *rbac.Policy
+			verifier *rbac.Verifier
 		)
+		if mode != auth.ModeRemote {
+			policy, err = s.AccessPolicy()
+			if err != nil {
+				return nil, err
+			}
+
+			verifier, err = s.TokenVerifier()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		cl, err = auth.NewAccessClient(mode, cl, policy, verifier)
+		if err != nil {
+			return nil, errors.WithMessage(err, "failed to create access client")
+		}
+
+		s.authClient = cl
+	}
+
+	return s.authClient, nil
+}
+
+// remoteAuthClient returns the client of the authentication service: the injected one if any, a fake one
+// allowing everything in dev mode.
+func (s *serviceProvider) remoteAuthClient() (client.AuthClient, error) {
+	if s.remoteAuth != nil {
+		return s.remoteAuth, nil
+	}
+
+	if s.Config().Dev.Enabled {
+		return auth.NewFakeClient(), nil
+	}
+
+	conn, err := s.AuthConn()
+	if err != nil {
+		return nil, err
+	}
+
+	cl := auth.NewAuthClient(pbAccess.NewAccessV1Client(conn), pbUser.NewUserV1Client(conn))
+
+	cfg := s.Config().Auth
+	cl = auth.NewResilientClient(cl, cfg)
+	if cfg.CacheEnabled {
+		// the shared checks are limited by the time all attempts of the resilient client may take
+		timeout := time.Duration(max(cfg.RetryAttempts, 1)) * (cfg.Timeout + cfg.RetryMaxBackoff)
+		cl = auth.NewCachingClient(cl, cfg.CacheTTL, cfg.CacheNegativeTTL, timeout, cfg.CacheMaxEntries)
+	}
+
+	return cl, nil
+}
+
+func (s *serviceProvider) AccessPolicy() (*rbac.Policy, error) {
+	if s.accessPolicy == nil {
+		p, err := rbac.NewPolicyFromFile(s.Config().Access.PolicyFile)
+		if err != nil {
+			return nil, errors.WithMessage(err, "failed to load access policy")
+		}
+
+		s.accessPolicy = p
 	}
 
-	return s.authClient
+	return s.accessPolicy, nil
 }
 
-func (s *serviceProvider) ChatImplementation(ctx context.Context) *chat.Implementation {
+func (s *serviceProvider) TokenVerifier() (*rbac.Verifier, error) {
+	if s.tokenVerifier == nil {
+		cfg := s.Config().Access
+		v, err := rbac.NewVerifierFromFiles(cfg.PublicKeyFile, cfg.JWKSFile)
+		if err != nil {
+			return nil, errors.WithMessage(err, "failed to create access token verifier")
+		}
+
+		s.tokenVerifier = v
+	}
+
+	return s.tokenVerifier, nil
+}
+
+func (s *serviceProvider) ChatImplementation(ctx context.Context) (*chat.Implementation, error) {
 	if s.chatImplementation == nil {
-		s.chatImplementation = chat.NewImplementation(
-			s.ChatService(ctx),
-			s.AuthClient(),
+		chats, err := s.ChatService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		reports, err := s.ReportService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		keys, err := s.APIKeyService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		authClient, err := s.AuthClient()
+		if err != nil {
+			return nil, err
+		}
+
+		s.chatImplementation = chat.NewImplementation(chats, reports, keys, authClient)
+	}
+
+	return s.chatImplementation, nil
+}
+
+// WebSocketHandler returns the WebSocket handler sending messages through the unary interceptor of the GRPC server.
+func (s *serviceProvider) WebSocketHandler(ctx context.Context, interceptor grpc.UnaryServerInterceptor) (*ws.Handler, error) {
+	if s.webSocketHandler == nil {
+		chats, err := s.ChatService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		authClient, err := s.AuthClient()
+		if err != nil {
+			return nil, err
+		}
+
+		s.webSocketHandler = ws.NewHandler(chats, authClient, s.Broker(), interceptor, s.Config().WebSocket)
+	}
+
+	return s.webSocketHandler, nil
+}
+
+func (s *serviceProvider) RateLimiter(ctx context.Context) (*ratelimit.Limiter, error) {
+	if s.rateLimiter == nil {
+		var store ratelimit.Store
+		switch s.Config().RateLimit.Store {
+		case rateLimitStoreMemory:
+			store = ratelimit.NewMemoryStore()
+		case rateLimitStorePostgres:
+			cl, err := s.DBClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			store = ratelimit.NewPGStore(cl)
+		default:
+			return nil, errors.Errorf("unknown rate limit store: %s", s.Config().RateLimit.Store)
+		}
+
+		limiter, err := ratelimit.NewLimiter(
+			store,
+			s.Config().RateLimit.UserLimits,
+			s.Config().RateLimit.ChatLimits,
 		)
+		if err != nil {
+			return nil, errors.WithMessage(err, "failed to create rate limiter")
+		}
+
+		s.rateLimiter = limiter
 	}
 
-	return s.chatImplementation
+	return s.rateLimiter, nil
 }
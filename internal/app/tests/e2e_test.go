@@ -0,0 +1,232 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/chat-server/internal/app"
+	"github.com/mikhailsoldatkin/chat-server/internal/client/mocks"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	chatRepository "github.com/mikhailsoldatkin/chat-server/internal/repository/chat"
+	repoMocks "github.com/mikhailsoldatkin/chat-server/internal/repository/mocks"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// allowAll returns an authentication client allowing every call and knowing every user.
+func allowAll(mc *minimock.Controller) *mocks.AuthClientMock {
+	return mocks.NewAuthClientMock(mc).
+		CheckAccessMock.Return(nil).
+		CheckUsersExistMock.Return(nil)
+}
+
+// errorReason returns the reason of the google.rpc.ErrorInfo detail of the status error, empty if there is none.
+func errorReason(err error) string {
+	for _, d := range status.Convert(err).Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			return info.GetReason()
+		}
+	}
+
+	return ""
+}
+
+func TestChat(t *testing.T) {
+	mc := minimock.NewController(t)
+	chats := chatRepository.NewMemoryRepository()
+	client := pb.NewChatV1Client(serve(t, app.WithAuthClient(allowAll(mc)), app.WithChatRepository(chats)))
+
+	ctx := context.Background()
+	users := []int64{int64(gofakeit.Uint32()) + 1, int64(gofakeit.Uint32()) + 1}
+
+	created, err := client.Create(ctx, &pb.CreateRequest{UsersIds: users})
+	require.NoError(t, err)
+	require.NoError(t, chats.IsUserInChat(ctx, users[1], created.GetId()), "the chat is stored in the injected repository")
+
+	_, err = client.SendMessage(ctx, &pb.SendMessageRequest{
+		ChatId:   created.GetId(),
+		FromUser: users[0],
+		Text:     gofakeit.Sentence(5),
+	})
+	require.NoError(t, err)
+}
+
+func TestAuth(t *testing.T) {
+	tests := []struct {
+		name      string
+		accessErr error
+		code      codes.Code
+		reason    string
+	}{
+		{
+			name:      "no token",
+			accessErr: customerrors.NewUnauthenticatedError("access token is missing"),
+			code:      codes.Unauthenticated,
+			reason:    customerrors.ReasonUnauthenticated,
+		},
+		{
+			name:      "access denied",
+			accessErr: customerrors.NewPermissionDeniedError("access denied"),
+			code:      codes.PermissionDenied,
+			reason:    customerrors.ReasonPermissionDenied,
+		},
+		{
+			name:      "authentication service unavailable",
+			accessErr: customerrors.NewUnavailableError("auth", errors.New("connection refused")),
+			code:      codes.Unavailable,
+			reason:    customerrors.ReasonUnavailable,
+		},
+		{
+			name:      "authentication service status",
+			accessErr: status.Error(codes.PermissionDenied, "role is not allowed"),
+			code:      codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			authClient := mocks.NewAuthClientMock(mc).CheckAccessMock.Return(tt.accessErr)
+			conn := serve(t, app.WithAuthClient(authClient))
+
+			_, err := pb.NewChatV1Client(conn).Delete(context.Background(), &pb.DeleteRequest{Id: gofakeit.Int64()})
+			require.Equal(t, tt.code, status.Code(err))
+			require.Equal(t, tt.reason, errorReason(err))
+		})
+	}
+}
+
+func TestAuthPublicMethods(t *testing.T) {
+	mc := minimock.NewController(t)
+	// the mock fails the test if the authentication service is called
+	conn := serve(t, app.WithAuthClient(mocks.NewAuthClientMock(mc)))
+
+	_, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+}
+
+func TestAuthInvalidAPIKey(t *testing.T) {
+	mc := minimock.NewController(t)
+	conn := serve(t, app.WithAuthClient(mocks.NewAuthClientMock(mc)))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-api-key", "ck_"+gofakeit.LetterN(43))
+	_, err := pb.NewChatV1Client(conn).SendMessage(ctx, &pb.SendMessageRequest{
+		ChatId:   gofakeit.Int64(),
+		FromUser: gofakeit.Int64(),
+		Text:     gofakeit.Sentence(5),
+	})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestTraceID(t *testing.T) {
+	mc := minimock.NewController(t)
+	client := pb.NewChatV1Client(serve(t, app.WithAuthClient(allowAll(mc))))
+
+	traceID := trace.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	ctx := metadata.AppendToOutgoingContext(
+		context.Background(),
+		"traceparent", "00-"+traceID.String()+"-0102030405060708-01",
+	)
+
+	var header metadata.MD
+	_, err := client.Create(ctx, &pb.CreateRequest{UsersIds: []int64{1, 2}}, grpc.Header(&header))
+	require.NoError(t, err)
+	require.Equal(t, []string{traceID.String()}, header.Get("x-trace-id"), "the trace of the client is continued")
+
+	// failed requests are traced as well
+	header = nil
+	_, err = client.Delete(ctx, &pb.DeleteRequest{}, grpc.Header(&header))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Equal(t, []string{traceID.String()}, header.Get("x-trace-id"))
+}
+
+func TestRateLimit(t *testing.T) {
+	cfg := testConfig()
+	cfg.RateLimit.UserLimits = map[string]string{pb.ChatV1_Create_FullMethodName: "0.01/1"}
+
+	mc := minimock.NewController(t)
+	client := pb.NewChatV1Client(serve(t, app.WithConfig(cfg), app.WithAuthClient(allowAll(mc))))
+
+	// the trace ID header is set first in the chain, the retry-after header must be added to it
+	traceID := trace.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	ctx := metadata.AppendToOutgoingContext(
+		context.Background(),
+		"traceparent", "00-"+traceID.String()+"-0102030405060708-01",
+	)
+	req := &pb.CreateRequest{UsersIds: []int64{1, 2}}
+
+	_, err := client.Create(ctx, req)
+	require.NoError(t, err)
+
+	var header metadata.MD
+	_, err = client.Create(ctx, req, grpc.Header(&header))
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Equal(t, []string{"100"}, header.Get("retry-after"), "the header reaches the client through the whole chain")
+	require.Equal(t, []string{traceID.String()}, header.Get("x-trace-id"))
+}
+
+func TestErrorConversion(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("domain errors", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		client := pb.NewChatV1Client(serve(t, app.WithAuthClient(allowAll(mc))))
+
+		created, err := client.Create(ctx, &pb.CreateRequest{UsersIds: []int64{1, 2}})
+		require.NoError(t, err)
+
+		tests := []struct {
+			name   string
+			req    *pb.SendMessageRequest
+			code   codes.Code
+			reason string
+		}{
+			{
+				name:   "chat not found",
+				req:    &pb.SendMessageRequest{ChatId: created.GetId() + 1, FromUser: 1, Text: gofakeit.Sentence(5)},
+				code:   codes.NotFound,
+				reason: customerrors.ReasonNotFound,
+			},
+			{
+				name:   "user not in chat",
+				req:    &pb.SendMessageRequest{ChatId: created.GetId(), FromUser: 3, Text: gofakeit.Sentence(5)},
+				code:   codes.NotFound,
+				reason: customerrors.ReasonUserNotInChat,
+			},
+			{
+				name: "invalid request",
+				req:  &pb.SendMessageRequest{ChatId: created.GetId(), FromUser: 1},
+				code: codes.InvalidArgument,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := client.SendMessage(ctx, tt.req)
+				require.Equal(t, tt.code, status.Code(err))
+				require.Equal(t, tt.reason, errorReason(err))
+			})
+		}
+	})
+
+	t.Run("unknown error", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		secret := gofakeit.Password(true, true, true, false, false, 16)
+		chats := repoMocks.NewChatRepositoryMock(mc).CreateMock.Return(0, errors.New("connection to "+secret+" lost"))
+		client := pb.NewChatV1Client(serve(t, app.WithAuthClient(allowAll(mc)), app.WithChatRepository(chats)))
+
+		_, err := client.Create(ctx, &pb.CreateRequest{UsersIds: []int64{1, 2}})
+		require.Equal(t, codes.Internal, status.Code(err))
+		require.NotContains(t, status.Convert(err).Message(), secret, "the cause is not returned to the client")
+	})
+}
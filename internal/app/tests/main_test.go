@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"context"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/app"
+	"github.com/mikhailsoldatkin/chat-server/internal/config"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// baseConfig is the dev mode configuration the Apps under test are started with, so the dependencies
+// not injected by a test are kept in memory.
+var baseConfig *config.Config
+
+func TestMain(m *testing.M) {
+	os.Exit(run(m))
+}
+
+func run(m *testing.M) int {
+	dir, err := os.MkdirTemp("", "chat-server-e2e")
+	if err != nil {
+		log.Fatalf("failed to create logs directory: %v", err)
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	for k, v := range map[string]string{
+		"DEV_MODE":     "true",
+		"TLS_INSECURE": "true",
+		"LOG_LEVEL":    "error",
+		"LOG_FILENAME": filepath.Join(dir, "app.log"),
+	} {
+		if err = os.Setenv(k, v); err != nil {
+			log.Fatalf("failed to set %s: %v", k, err)
+		}
+	}
+
+	baseConfig, err = config.Load(nil)
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
+	// the suite runs against the native GRPC server and against the server also accepting Connect and gRPC-Web,
+	// which serves GRPC through a different HTTP handler
+	for _, webEnabled := range []bool{false, true} {
+		baseConfig.GRPC.WebEnabled = webEnabled
+		if code := m.Run(); code != 0 {
+			log.Printf("tests failed with GRPC_WEB_ENABLED=%t", webEnabled)
+			return code
+		}
+	}
+
+	return 0
+}
+
+// testConfig returns a copy of the configuration the Apps under test are started with, to be changed
+// by a test and injected with app.WithConfig.
+func testConfig() *config.Config {
+	cfg := *baseConfig
+	return &cfg
+}
+
+// serve starts the App with the injected dependencies on an in-memory listener and returns a client
+// connection to its GRPC server, the options override the test configuration. The App is stopped at the end
+// of the test.
+// The App sets up the global logger and propagator, so tests serving it can't run in parallel.
+func serve(t *testing.T, opts ...app.Option) *grpc.ClientConn {
+	t.Helper()
+
+	a, err := app.NewApp(context.Background(), nil, append([]app.Option{app.WithConfig(testConfig())}, opts...)...)
+	require.NoError(t, err)
+
+	lis := bufconn.Listen(1024 * 1024)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- a.Serve(lis)
+	}()
+	t.Cleanup(func() {
+		a.Stop()
+		require.NoError(t, <-errCh)
+	})
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return conn
+}
@@ -0,0 +1,63 @@
+package app
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/config"
+	"github.com/mikhailsoldatkin/chat-server/internal/interceptor"
+	"github.com/mikhailsoldatkin/chat-server/internal/logger"
+	"github.com/mikhailsoldatkin/chat-server/internal/tlsconfig"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// initTLS loads the certificates of the GRPC server unless TLS is disabled.
+func (a *App) initTLS(_ context.Context) error {
+	cfg := a.serviceProvider.Config().TLS
+	if cfg.Insecure {
+		logger.Warn("TLS is disabled, the GRPC server accepts plaintext connections")
+		return nil
+	}
+
+	r, err := tlsconfig.NewReloader(cfg.CertFile, cfg.KeyFile, cfg.CAFile)
+	if err != nil {
+		return errors.WithMessage(err, "failed to load TLS certificates")
+	}
+
+	a.tlsReloader = r
+
+	return nil
+}
+
+// serverCredentials returns the transport credentials of the GRPC server.
+func (a *App) serverCredentials() credentials.TransportCredentials {
+	if a.tlsReloader == nil {
+		return insecure.NewCredentials()
+	}
+
+	return credentials.NewTLS(a.tlsReloader.ServerConfig(a.serviceProvider.Config().TLS.ClientAuth))
+}
+
+// clientCertInterceptors returns the interceptors checking the SANs of client certificates, none unless
+// mutual TLS with allowed SANs is configured. The server certificate is not trusted for its own sake:
+// the HTTP gateway connecting with it is disabled with mutual TLS and the health check only calls public methods.
+func (a *App) clientCertInterceptors() (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	cfg := a.serviceProvider.Config().TLS
+	if a.tlsReloader == nil || !cfg.ClientAuth || len(cfg.AllowedSANs) == 0 {
+		return nil, nil
+	}
+
+	return interceptor.ClientCertInterceptor(cfg.AllowedSANs), interceptor.ClientCertStreamInterceptor(cfg.AllowedSANs)
+}
+
+// localCredentials returns the transport credentials of connections to the local GRPC server, verifying it
+// with the CA bundle and presenting the server certificate for mutual TLS.
+func localCredentials(cfg config.TLS, r *tlsconfig.Reloader) credentials.TransportCredentials {
+	if cfg.Insecure {
+		return insecure.NewCredentials()
+	}
+
+	return credentials.NewTLS(r.ClientConfig())
+}
@@ -0,0 +1,201 @@
+package broker
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/service/chat/model"
+)
+
+// ErrSlowConsumer is reported by a subscription closed because its subscriber did not keep up with events.
+var ErrSlowConsumer = errors.New("subscriber is too slow to receive events")
+
+// Broker delivers chat events to the subscribers of the chat within the process.
+type Broker interface {
+	// Publish delivers the event to all subscribers of the event chat without blocking.
+	Publish(event *model.Event)
+	// NewSubscription creates a subscription of the user buffering at most buffer undelivered events.
+	NewSubscription(userID int64, buffer int) Subscription
+	// Unsubscribe removes the subscriptions of the users from the chat, or all subscriptions of the chat
+	// if no users are given, e.g. once the users are removed from the chat or the chat is deleted.
+	// The removed subscriptions receive an unsubscribed event.
+	Unsubscribe(chatID int64, userIDs ...int64)
+}
+
+// Subscription receives events of the chats it is subscribed to.
+type Subscription interface {
+	// Subscribe adds chats to the subscription.
+	Subscribe(chatIDs ...int64)
+	// Events returns the channel of events, closed when the subscription is closed.
+	Events() <-chan *model.Event
+	// Err returns ErrSlowConsumer if the subscription was closed because its buffer overflowed.
+	Err() error
+	// Close unsubscribes from all chats and closes the events channel.
+	Close()
+}
+
+type broker struct {
+	mu   sync.RWMutex
+	subs map[int64]map[*subscription]struct{}
+}
+
+// NewBroker creates a new in-memory Broker.
+func NewBroker() Broker {
+	return &broker{
+		subs: make(map[int64]map[*subscription]struct{}),
+	}
+}
+
+// Publish delivers the event to the chat subscribers. A subscriber whose buffer is full is closed
+// with ErrSlowConsumer instead of slowing down the publisher and other subscribers.
+func (b *broker) Publish(event *model.Event) {
+	b.mu.RLock()
+	subs := make([]*subscription, 0, len(b.subs[event.ChatID]))
+	for s := range b.subs[event.ChatID] {
+		subs = append(subs, s)
+	}
+	b.mu.RUnlock()
+
+	for _, s := range subs {
+		if !s.deliver(event) {
+			s.closeWithErr(ErrSlowConsumer)
+		}
+	}
+}
+
+func (b *broker) NewSubscription(userID int64, buffer int) Subscription {
+	return &subscription{
+		broker: b,
+		userID: userID,
+		events: make(chan *model.Event, buffer),
+		chats:  make(map[int64]struct{}),
+	}
+}
+
+func (b *broker) Unsubscribe(chatID int64, userIDs ...int64) {
+	b.mu.Lock()
+	var removed []*subscription
+	for s := range b.subs[chatID] {
+		if len(userIDs) == 0 || slices.Contains(userIDs, s.userID) {
+			delete(b.subs[chatID], s)
+			removed = append(removed, s)
+		}
+	}
+	if len(b.subs[chatID]) == 0 {
+		delete(b.subs, chatID)
+	}
+	b.mu.Unlock()
+
+	event := &model.Event{Type: model.EventUnsubscribed, ChatID: chatID, Timestamp: time.Now()}
+	for _, s := range removed {
+		s.forget(chatID)
+		if !s.deliver(event) {
+			s.closeWithErr(ErrSlowConsumer)
+		}
+	}
+}
+
+// unsubscribe removes the subscription from the chats.
+func (b *broker) unsubscribe(s *subscription, chatIDs []int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, id := range chatIDs {
+		delete(b.subs[id], s)
+		if len(b.subs[id]) == 0 {
+			delete(b.subs, id)
+		}
+	}
+}
+
+type subscription struct {
+	broker *broker
+	userID int64
+	events chan *model.Event
+
+	mu     sync.Mutex
+	chats  map[int64]struct{}
+	closed bool
+	err    error
+}
+
+func (s *subscription) Subscribe(chatIDs ...int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+
+	for _, id := range chatIDs {
+		s.chats[id] = struct{}{}
+		if s.broker.subs[id] == nil {
+			s.broker.subs[id] = make(map[*subscription]struct{})
+		}
+		s.broker.subs[id][s] = struct{}{}
+	}
+}
+
+func (s *subscription) Events() <-chan *model.Event {
+	return s.events
+}
+
+func (s *subscription) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.err
+}
+
+func (s *subscription) Close() {
+	s.closeWithErr(nil)
+}
+
+// forget removes the chat the subscription has been unsubscribed from by the broker.
+func (s *subscription) forget(chatID int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.chats, chatID)
+}
+
+// deliver puts the event into the buffer, reporting false if the buffer is full.
+func (s *subscription) deliver(event *model.Event) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return true
+	}
+
+	select {
+	case s.events <- event:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *subscription) closeWithErr(err error) {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return
+	}
+	s.closed = true
+	s.err = err
+
+	chatIDs := make([]int64, 0, len(s.chats))
+	for id := range s.chats {
+		chatIDs = append(chatIDs, id)
+	}
+	close(s.events)
+	s.mu.Unlock()
+
+	s.broker.unsubscribe(s, chatIDs)
+}
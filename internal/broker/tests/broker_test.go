@@ -0,0 +1,123 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/mikhailsoldatkin/chat-server/internal/broker"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/chat/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBrokerPublish(t *testing.T) {
+	t.Parallel()
+
+	var (
+		b         = broker.NewBroker()
+		chatID    = gofakeit.Int64()
+		otherID   = chatID + 1
+		event     = &model.Event{Type: model.EventTyping, ChatID: chatID, UserID: gofakeit.Int64()}
+		unrelated = &model.Event{Type: model.EventTyping, ChatID: otherID, UserID: gofakeit.Int64()}
+	)
+
+	subscribed := b.NewSubscription(gofakeit.Int64(), 1)
+	defer subscribed.Close()
+	subscribed.Subscribe(chatID)
+
+	other := b.NewSubscription(gofakeit.Int64(), 1)
+	defer other.Close()
+	other.Subscribe(otherID)
+
+	b.Publish(event)
+
+	require.Equal(t, event, <-subscribed.Events())
+	require.Empty(t, other.Events())
+
+	b.Publish(unrelated)
+
+	require.Equal(t, unrelated, <-other.Events())
+	require.Empty(t, subscribed.Events())
+}
+
+func TestBrokerSlowConsumer(t *testing.T) {
+	t.Parallel()
+
+	var (
+		b      = broker.NewBroker()
+		chatID = gofakeit.Int64()
+	)
+
+	slow := b.NewSubscription(gofakeit.Int64(), 1)
+	slow.Subscribe(chatID)
+
+	fast := b.NewSubscription(gofakeit.Int64(), 2)
+	defer fast.Close()
+	fast.Subscribe(chatID)
+
+	b.Publish(&model.Event{Type: model.EventTyping, ChatID: chatID})
+	b.Publish(&model.Event{Type: model.EventTyping, ChatID: chatID})
+
+	_, ok := <-slow.Events()
+	require.True(t, ok)
+	_, ok = <-slow.Events()
+	require.False(t, ok)
+	require.ErrorIs(t, slow.Err(), broker.ErrSlowConsumer)
+
+	require.Len(t, fast.Events(), 2)
+	require.NoError(t, fast.Err())
+}
+
+func TestSubscriptionClose(t *testing.T) {
+	t.Parallel()
+
+	var (
+		b      = broker.NewBroker()
+		chatID = gofakeit.Int64()
+	)
+
+	sub := b.NewSubscription(gofakeit.Int64(), 1)
+	sub.Subscribe(chatID)
+	sub.Close()
+	sub.Close()
+
+	b.Publish(&model.Event{Type: model.EventTyping, ChatID: chatID})
+
+	_, ok := <-sub.Events()
+	require.False(t, ok)
+	require.NoError(t, sub.Err())
+}
+
+func TestBrokerUnsubscribe(t *testing.T) {
+	t.Parallel()
+
+	var (
+		b       = broker.NewBroker()
+		chatID  = gofakeit.Int64()
+		removed = gofakeit.Int64()
+		member  = removed + 1
+	)
+
+	removedSub := b.NewSubscription(removed, 2)
+	defer removedSub.Close()
+	removedSub.Subscribe(chatID)
+
+	memberSub := b.NewSubscription(member, 2)
+	defer memberSub.Close()
+	memberSub.Subscribe(chatID)
+
+	b.Unsubscribe(chatID, removed)
+	unsubscribed := <-removedSub.Events()
+	require.Equal(t, model.EventUnsubscribed, unsubscribed.Type)
+	require.Equal(t, chatID, unsubscribed.ChatID)
+
+	event := &model.Event{Type: model.EventTyping, ChatID: chatID, UserID: member}
+	b.Publish(event)
+	require.Equal(t, event, <-memberSub.Events())
+	require.Empty(t, removedSub.Events(), "the removed user no longer receives events of the chat")
+
+	// all subscriptions are cancelled if no users are given, e.g. once the chat is deleted
+	b.Unsubscribe(chatID)
+	require.Equal(t, model.EventUnsubscribed, (<-memberSub.Events()).Type)
+	b.Publish(event)
+	require.Empty(t, memberSub.Events())
+}
@@ -0,0 +1,93 @@
+package auth
+
+import (
+	"sync"
+	"time"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/metric"
+)
+
+// outcome is the result of a call guarded by the circuit breaker.
+type outcome int
+
+const (
+	// outcomeSuccess means the service answered, whatever the answer was.
+	outcomeSuccess outcome = iota
+	// outcomeFailure means the service failed to answer.
+	outcomeFailure
+	// outcomeAbandoned means the caller gave up on the call, which says nothing about the service.
+	outcomeAbandoned
+)
+
+// breaker is a circuit breaker opening after threshold consecutive failures. While open calls are rejected,
+// after cooldown a single trial call is let through and closes the breaker on success or reopens it on failure.
+// A breaker with a non-positive threshold never opens.
+type breaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	failures int
+	open     bool
+	openedAt time.Time
+	probing  bool
+	now      func() time.Time
+}
+
+func newBreaker(threshold int, cooldown time.Duration) *breaker {
+	return &breaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+// allow reports whether a call may be made.
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.open {
+		return true
+	}
+	if b.probing || b.now().Sub(b.openedAt) < b.cooldown {
+		return false
+	}
+
+	b.probing = true
+
+	return true
+}
+
+// record updates the breaker state with the outcome of an allowed call.
+func (b *breaker) record(o outcome) {
+	if b.threshold <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	probe := b.probing
+	b.probing = false
+
+	switch o {
+	case outcomeSuccess:
+		b.failures = 0
+		b.setOpen(false)
+	case outcomeFailure:
+		b.failures++
+		if probe || b.failures >= b.threshold {
+			b.openedAt = b.now()
+			b.setOpen(true)
+		}
+	case outcomeAbandoned:
+	}
+}
+
+func (b *breaker) setOpen(open bool) {
+	if b.open != open {
+		b.open = open
+		metric.SetAuthCircuitOpen(open)
+	}
+}
@@ -0,0 +1,235 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pbAccess "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+	pbUser "github.com/mikhailsoldatkin/auth/pkg/user_v1"
+	"github.com/mikhailsoldatkin/chat-server/internal/client"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/metric"
+	"github.com/mikhailsoldatkin/chat-server/internal/utils"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// cacheSweepInterval defines how often expired results are evicted from the cache.
+const cacheSweepInterval = time.Minute
+
+var _ client.AuthClient = (*cachingClient)(nil)
+
+type cacheEntry struct {
+	err       error
+	expiresAt time.Time
+}
+
+type cachingClient struct {
+	client      client.AuthClient
+	ttl         time.Duration
+	negativeTTL time.Duration
+	maxEntries  int
+	timeout     time.Duration
+	group       singleflight.Group
+
+	mu        sync.Mutex
+	entries   map[string]cacheEntry
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewCachingClient wraps the authentication client with a cache of check results. Successful checks are cached
+// for ttl, denials and missing users for negativeTTL, other errors and users assumed to exist during outages
+// of the authentication service are not cached. Results are cached per access token and never outlive it,
+// identical concurrent checks are coalesced into one call. The shared call is not cancelled with the caller
+// that started it, it is limited by timeout instead. Checks made without an access token are passed through as is.
+func NewCachingClient(cl client.AuthClient, ttl, negativeTTL, timeout time.Duration, maxEntries int) client.AuthClient {
+	return &cachingClient{
+		client:      cl,
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		maxEntries:  maxEntries,
+		timeout:     timeout,
+		entries:     make(map[string]cacheEntry),
+		lastSweep:   time.Now(),
+		now:         time.Now,
+	}
+}
+
+func (c *cachingClient) CheckAccess(ctx context.Context, endpoint string) error {
+	token, err := utils.AccessTokenFromContext(ctx)
+	if err != nil {
+		return c.client.CheckAccess(ctx, endpoint)
+	}
+
+	key := "access:" + tokenKey(token) + ":" + endpoint
+
+	return c.check(ctx, pbAccess.AccessV1_Check_FullMethodName, key, tokenExpiry(token), func(ctx context.Context) error {
+		return c.client.CheckAccess(ctx, endpoint)
+	})
+}
+
+func (c *cachingClient) CheckUsersExist(ctx context.Context, ids []int64) error {
+	token, err := utils.AccessTokenFromContext(ctx)
+	if err != nil {
+		return c.client.CheckUsersExist(ctx, ids)
+	}
+
+	key := "users:" + tokenKey(token) + ":" + idsKey(ids)
+
+	return c.check(ctx, pbUser.UserV1_CheckUsersExist_FullMethodName, key, tokenExpiry(token), func(ctx context.Context) error {
+		return c.client.CheckUsersExist(ctx, ids)
+	})
+}
+
+// GetUsername is not cached, users are only looked up when WebSocket connections are opened.
+func (c *cachingClient) GetUsername(ctx context.Context, id int64) (string, error) {
+	return c.client.GetUsername(ctx, id)
+}
+
+// check returns the cached result of the check identified by key or makes the check, sharing the call with
+// concurrent identical checks, and caches its result until notAfter at the latest. A caller whose context is done
+// stops waiting for the shared call, which goes on for the other callers.
+func (c *cachingClient) check(ctx context.Context, method, key string, notAfter time.Time, call func(context.Context) error) error {
+	if entry, ok := c.get(key); ok {
+		metric.ObserveAuthCache(method, true)
+		return entry.err
+	}
+	metric.ObserveAuthCache(method, false)
+
+	res := c.group.DoChan(key, func() (any, error) {
+		callCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.timeout)
+		defer cancel()
+
+		var failedOpen bool
+		err := call(contextWithFailedOpenFlag(callCtx, &failedOpen))
+		if !failedOpen {
+			c.set(key, err, notAfter)
+		}
+		return nil, err
+	})
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case r := <-res:
+		return r.Err
+	}
+}
+
+func (c *cachingClient) get(key string) (cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || !c.now().Before(entry.expiresAt) {
+		return cacheEntry{}, false
+	}
+
+	return entry, true
+}
+
+func (c *cachingClient) set(key string, err error, notAfter time.Time) {
+	var ttl time.Duration
+	switch {
+	case err == nil:
+		ttl = c.ttl
+	case isDefinite(err):
+		ttl = c.negativeTTL
+	default:
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	expiresAt := now.Add(ttl)
+	if !notAfter.IsZero() && notAfter.Before(expiresAt) {
+		expiresAt = notAfter
+	}
+	if !now.Before(expiresAt) {
+		return
+	}
+
+	c.sweep(now, len(c.entries) >= c.maxEntries)
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.maxEntries {
+		for k := range c.entries {
+			delete(c.entries, k)
+			break
+		}
+	}
+
+	c.entries[key] = cacheEntry{err: err, expiresAt: expiresAt}
+}
+
+// sweep evicts expired results, periodically or when forced.
+func (c *cachingClient) sweep(now time.Time, force bool) {
+	if !force && now.Sub(c.lastSweep) < cacheSweepInterval {
+		return
+	}
+	c.lastSweep = now
+
+	for key, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// isDefinite reports whether the error is an answer of the authentication service rather than a failure
+// to get one, so that it may be cached.
+func isDefinite(err error) bool {
+	var (
+		unauthenticatedErr  *customerrors.UnauthenticatedError
+		permissionDeniedErr *customerrors.PermissionDeniedError
+	)
+	if errors.As(err, &unauthenticatedErr) || errors.As(err, &permissionDeniedErr) {
+		return true
+	}
+
+	switch status.Code(err) {
+	case codes.NotFound, codes.InvalidArgument:
+		return true
+	default:
+		return false
+	}
+}
+
+// tokenKey identifies the access token in cache keys without keeping the token itself in memory.
+func tokenKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// tokenExpiry returns the expiration time of the access token, zero if it is unknown.
+func tokenExpiry(token string) time.Time {
+	claims, err := utils.ParseUnverifiedClaims(token)
+	if err != nil || claims.ExpiresAt == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(claims.ExpiresAt, 0)
+}
+
+// idsKey identifies the set of user IDs regardless of their order and duplicates.
+func idsKey(ids []int64) string {
+	sorted := slices.Clone(ids)
+	slices.Sort(sorted)
+	sorted = slices.Compact(sorted)
+
+	parts := make([]string, len(sorted))
+	for i, id := range sorted {
+		parts[i] = strconv.FormatInt(id, 10)
+	}
+
+	return strings.Join(parts, ",")
+}
@@ -2,14 +2,23 @@ package auth
 
 import (
 	"context"
+	"time"
 
 	pbAccess "github.com/mikhailsoldatkin/auth/pkg/access_v1"
 	pbUser "github.com/mikhailsoldatkin/auth/pkg/user_v1"
 	"github.com/mikhailsoldatkin/chat-server/internal/client"
-	"github.com/opentracing/opentracing-go"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/metric"
+	"github.com/mikhailsoldatkin/chat-server/internal/tracing"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+const authServiceName = "auth"
+
 var _ client.AuthClient = (*authClient)(nil)
 
 type authClient struct {
@@ -29,29 +38,66 @@ func NewAuthClient(
 }
 
 func (cl *authClient) CheckAccess(ctx context.Context, endpoint string) error {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "auth-check-endpoint-access")
-	defer span.Finish()
-
-	span.SetTag("endpoint", endpoint)
+	ctx, span := tracing.StartSpan(ctx, "auth-check-endpoint-access", trace.WithAttributes(
+		attribute.String("endpoint", endpoint),
+	))
 
+	start := time.Now()
 	_, err := cl.accessClient.Check(ctx, &pbAccess.CheckRequest{Endpoint: endpoint})
+	metric.ObserveAuthRequest(pbAccess.AccessV1_Check_FullMethodName, err, time.Since(start))
+	tracing.End(span, err)
 	if err != nil {
-		span.SetTag("error", true)
-		return errors.WithMessage(err, "checking endpoint access")
+		return convertError(errors.WithMessage(err, "checking endpoint access"))
 	}
 	return nil
 }
 
 func (cl *authClient) CheckUsersExist(ctx context.Context, ids []int64) error {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "auth-check-users-exist")
-	defer span.Finish()
-
-	span.SetTag("user-ids", ids)
+	ctx, span := tracing.StartSpan(ctx, "auth-check-users-exist", trace.WithAttributes(
+		attribute.Int64Slice("user-ids", ids),
+	))
 
+	start := time.Now()
 	_, err := cl.userClient.CheckUsersExist(ctx, &pbUser.CheckUsersExistRequest{Ids: ids})
+	metric.ObserveAuthRequest(pbUser.UserV1_CheckUsersExist_FullMethodName, err, time.Since(start))
+	tracing.End(span, err)
 	if err != nil {
-		span.SetTag("error", true)
-		return errors.WithMessage(err, "checking users existence")
+		return convertError(errors.WithMessage(err, "checking users existence"))
 	}
 	return nil
 }
+
+func (cl *authClient) GetUsername(ctx context.Context, id int64) (string, error) {
+	ctx, span := tracing.StartSpan(ctx, "auth-get-user", trace.WithAttributes(
+		attribute.Int64("user-id", id),
+	))
+
+	start := time.Now()
+	resp, err := cl.userClient.Get(ctx, &pbUser.GetRequest{Id: id})
+	metric.ObserveAuthRequest(pbUser.UserV1_Get_FullMethodName, err, time.Since(start))
+	tracing.End(span, err)
+	if err != nil {
+		return "", convertError(errors.WithMessage(err, "getting user"))
+	}
+	return resp.GetUser().GetUsername(), nil
+}
+
+// convertError turns authentication service errors the chat server reacts to into custom errors,
+// leaving other errors with their original status.
+func convertError(err error) error {
+	st, ok := status.FromError(errors.Cause(err))
+	if !ok {
+		return err
+	}
+
+	switch st.Code() {
+	case codes.Unauthenticated:
+		return customerrors.NewUnauthenticatedError(st.Message())
+	case codes.PermissionDenied:
+		return customerrors.NewPermissionDeniedError(st.Message())
+	case codes.Unavailable:
+		return customerrors.NewUnavailableError(authServiceName, err)
+	default:
+		return err
+	}
+}
@@ -0,0 +1,133 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/client"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/rbac"
+	"github.com/mikhailsoldatkin/chat-server/internal/utils"
+)
+
+// Access check modes.
+const (
+	// ModeRemote asks the authentication service.
+	ModeRemote = "remote"
+	// ModeLocal evaluates the access policy against the verified token claims.
+	ModeLocal = "local"
+	// ModeBoth requires both the authentication service and the access policy to allow.
+	ModeBoth = "both"
+)
+
+var (
+	_ client.AuthClient        = (*localClient)(nil)
+	_ client.ChatAccessChecker = (*localClient)(nil)
+	_ client.AuthClient        = (*agreeingClient)(nil)
+	_ client.ChatAccessChecker = (*agreeingClient)(nil)
+)
+
+// NewAccessClient creates the authentication client checking access in the mode. Users existence is always
+// checked by the remote client.
+func NewAccessClient(
+	mode string,
+	remote client.AuthClient,
+	policy *rbac.Policy,
+	verifier *rbac.Verifier,
+) (client.AuthClient, error) {
+	switch mode {
+	case ModeRemote:
+		return remote, nil
+	case ModeLocal:
+		return newLocalClient(policy, verifier, remote), nil
+	case ModeBoth:
+		return &agreeingClient{
+			local:  newLocalClient(policy, verifier, remote),
+			remote: remote,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown access check mode %q", mode)
+	}
+}
+
+type localClient struct {
+	policy   *rbac.Policy
+	verifier *rbac.Verifier
+	users    client.AuthClient
+}
+
+// NewLocalClient creates an authentication client checking access without a network round-trip, by evaluating
+// the policy against the claims of the access token verified with the trusted keys. Users existence is
+// checked by the users client.
+func NewLocalClient(policy *rbac.Policy, verifier *rbac.Verifier, users client.AuthClient) client.AuthClient {
+	return newLocalClient(policy, verifier, users)
+}
+
+func newLocalClient(policy *rbac.Policy, verifier *rbac.Verifier, users client.AuthClient) *localClient {
+	return &localClient{
+		policy:   policy,
+		verifier: verifier,
+		users:    users,
+	}
+}
+
+func (cl *localClient) CheckAccess(ctx context.Context, endpoint string) error {
+	return cl.CheckChatAccess(ctx, endpoint, 0)
+}
+
+func (cl *localClient) CheckChatAccess(ctx context.Context, endpoint string, chatID int64) error {
+	token, err := utils.AccessTokenFromContext(ctx)
+	if err != nil {
+		return customerrors.NewUnauthenticatedError(err.Error())
+	}
+
+	claims, err := cl.verifier.Verify(token)
+	if err != nil {
+		return customerrors.NewUnauthenticatedError(err.Error())
+	}
+
+	if !cl.policy.Allowed(claims.Role, endpoint, chatID) {
+		return customerrors.NewPermissionDeniedError(fmt.Sprintf("role %q may not call %s", claims.Role, endpoint))
+	}
+
+	return nil
+}
+
+func (cl *localClient) CheckUsersExist(ctx context.Context, ids []int64) error {
+	return cl.users.CheckUsersExist(ctx, ids)
+}
+
+func (cl *localClient) GetUsername(ctx context.Context, id int64) (string, error) {
+	return cl.users.GetUsername(ctx, id)
+}
+
+// agreeingClient allows access only if both the local policy and the authentication service allow it.
+// The local check goes first, so that requests it denies don't reach the service.
+type agreeingClient struct {
+	local  *localClient
+	remote client.AuthClient
+}
+
+func (cl *agreeingClient) CheckAccess(ctx context.Context, endpoint string) error {
+	if err := cl.local.CheckAccess(ctx, endpoint); err != nil {
+		return err
+	}
+
+	return cl.remote.CheckAccess(ctx, endpoint)
+}
+
+func (cl *agreeingClient) CheckChatAccess(ctx context.Context, endpoint string, chatID int64) error {
+	if err := cl.local.CheckChatAccess(ctx, endpoint, chatID); err != nil {
+		return err
+	}
+
+	return cl.remote.CheckAccess(ctx, endpoint)
+}
+
+func (cl *agreeingClient) CheckUsersExist(ctx context.Context, ids []int64) error {
+	return cl.remote.CheckUsersExist(ctx, ids)
+}
+
+func (cl *agreeingClient) GetUsername(ctx context.Context, id int64) (string, error) {
+	return cl.remote.GetUsername(ctx, id)
+}
@@ -0,0 +1,188 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	pbAccess "github.com/mikhailsoldatkin/auth/pkg/access_v1"
+	pbUser "github.com/mikhailsoldatkin/auth/pkg/user_v1"
+	"github.com/mikhailsoldatkin/chat-server/internal/client"
+	"github.com/mikhailsoldatkin/chat-server/internal/config"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/logger"
+	"github.com/mikhailsoldatkin/chat-server/internal/metric"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errCircuitOpen is reported while the circuit breaker rejects calls to the authentication service.
+var errCircuitOpen = errors.New("circuit breaker is open")
+
+var _ client.AuthClient = (*resilientClient)(nil)
+
+type failedOpenKey struct{}
+
+type resilientClient struct {
+	client             client.AuthClient
+	timeout            time.Duration
+	attempts           int
+	backoff            time.Duration
+	maxBackoff         time.Duration
+	usersCheckFailOpen bool
+	breaker            *breaker
+}
+
+// NewResilientClient wraps the authentication client so that every call is limited by the configured timeout,
+// calls failed because the service is unreachable are retried with jittered exponential backoff, and a circuit
+// breaker fails calls fast with Unavailable while the service is down. During outages users are assumed
+// to exist if the users check is configured to fail open, such checks are never cached by the caching client.
+func NewResilientClient(cl client.AuthClient, cfg config.Auth) client.AuthClient {
+	return &resilientClient{
+		client:             cl,
+		timeout:            cfg.Timeout,
+		attempts:           max(cfg.RetryAttempts, 1),
+		backoff:            cfg.RetryBackoff,
+		maxBackoff:         cfg.RetryMaxBackoff,
+		usersCheckFailOpen: cfg.UsersCheckFailOpen,
+		breaker:            newBreaker(cfg.BreakerThreshold, cfg.BreakerCooldown),
+	}
+}
+
+func (c *resilientClient) CheckAccess(ctx context.Context, endpoint string) error {
+	return c.call(ctx, pbAccess.AccessV1_Check_FullMethodName, func(ctx context.Context) error {
+		return c.client.CheckAccess(ctx, endpoint)
+	})
+}
+
+func (c *resilientClient) CheckUsersExist(ctx context.Context, ids []int64) error {
+	err := c.call(ctx, pbUser.UserV1_CheckUsersExist_FullMethodName, func(ctx context.Context) error {
+		return c.client.CheckUsersExist(ctx, ids)
+	})
+
+	var unavailableErr *customerrors.UnavailableError
+	if c.usersCheckFailOpen && errors.As(err, &unavailableErr) {
+		logger.Warn("assuming users exist while the authentication service is unavailable",
+			zap.Int64s("user_ids", ids), zap.Error(err))
+		markFailedOpen(ctx)
+		return nil
+	}
+
+	return err
+}
+
+func (c *resilientClient) GetUsername(ctx context.Context, id int64) (string, error) {
+	var username string
+	err := c.call(ctx, pbUser.UserV1_Get_FullMethodName, func(ctx context.Context) error {
+		var err error
+		username, err = c.client.GetUsername(ctx, id)
+		return err
+	})
+
+	return username, err
+}
+
+// contextWithFailedOpenFlag returns a copy of the context in which the resilient client sets the flag
+// if it passes a check without an answer of the authentication service.
+func contextWithFailedOpenFlag(ctx context.Context, failedOpen *bool) context.Context {
+	return context.WithValue(ctx, failedOpenKey{}, failedOpen)
+}
+
+// markFailedOpen sets the flag of the context, if any, to report a check passed without an answer.
+func markFailedOpen(ctx context.Context) {
+	if failedOpen, ok := ctx.Value(failedOpenKey{}).(*bool); ok {
+		*failedOpen = true
+	}
+}
+
+// call makes the check, all checks being read-only they are safe to retry.
+func (c *resilientClient) call(ctx context.Context, method string, check func(context.Context) error) error {
+	var err error
+	for attempt := 0; attempt < c.attempts; attempt++ {
+		if attempt > 0 {
+			metric.IncAuthRetries(method)
+			if waitErr := wait(ctx, c.backoffFor(attempt)); waitErr != nil {
+				return waitErr
+			}
+		}
+
+		if !c.breaker.allow() {
+			return customerrors.NewUnavailableError(authServiceName, errCircuitOpen)
+		}
+
+		err = c.attempt(ctx, check)
+		switch {
+		case ctx.Err() != nil:
+			c.breaker.record(outcomeAbandoned)
+			return err
+		case isTransient(err):
+			c.breaker.record(outcomeFailure)
+		default:
+			c.breaker.record(outcomeSuccess)
+			return err
+		}
+	}
+
+	var unavailableErr *customerrors.UnavailableError
+	if !errors.As(err, &unavailableErr) {
+		err = customerrors.NewUnavailableError(authServiceName, err)
+	}
+
+	return err
+}
+
+// attempt makes the check once, limited by the timeout.
+func (c *resilientClient) attempt(ctx context.Context, check func(context.Context) error) error {
+	if c.timeout <= 0 {
+		return check(ctx)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return check(ctx)
+}
+
+// backoffFor returns a random delay before the retry attempt, up to the exponentially growing backoff.
+func (c *resilientClient) backoffFor(attempt int) time.Duration {
+	backoff := c.backoff << (attempt - 1)
+	if backoff <= 0 || (c.maxBackoff > 0 && backoff > c.maxBackoff) {
+		backoff = c.maxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Int63n(int64(backoff))) //nolint:gosec // jitter doesn't need a secure source
+}
+
+// wait blocks for the delay or until the context is done.
+func wait(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// isTransient reports whether the error means the authentication service failed to answer in time,
+// so that the call may succeed if retried.
+func isTransient(err error) bool {
+	var unavailableErr *customerrors.UnavailableError
+	if errors.As(err, &unavailableErr) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Aborted, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
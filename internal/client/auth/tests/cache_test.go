@@ -0,0 +1,231 @@
+package tests
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/chat-server/internal/client/auth"
+	"github.com/mikhailsoldatkin/chat-server/internal/client/mocks"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	ttl         = time.Minute
+	negativeTTL = time.Minute
+	timeout     = time.Second
+	maxEntries  = 100
+)
+
+// newToken creates an access token expiring at exp, the signature is not checked by the cache.
+func newToken(exp time.Time) string {
+	payload := fmt.Sprintf(`{"username":%q,"exp":%d}`, gofakeit.Username(), exp.Unix())
+	return "header." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".signature"
+}
+
+// withToken returns the context of a request authorized with the token.
+func withToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestCachingClientCheckAccess(t *testing.T) {
+	t.Parallel()
+
+	var (
+		endpoint = "/chat_v1.ChatV1/SendMessage"
+		token    = newToken(time.Now().Add(time.Hour))
+		other    = newToken(time.Now().Add(time.Hour))
+		expired  = newToken(time.Now().Add(-time.Minute))
+		denied   = customerrors.NewPermissionDeniedError(gofakeit.Sentence(3))
+		failed   = customerrors.NewUnavailableError("auth", fmt.Errorf("%s", gofakeit.Sentence(3)))
+	)
+
+	tests := []struct {
+		name   string
+		ctxs   []context.Context
+		err    error
+		checks uint64
+	}{
+		{
+			name:   "access cached",
+			ctxs:   []context.Context{withToken(token), withToken(token)},
+			checks: 1,
+		},
+		{
+			name:   "cached per token",
+			ctxs:   []context.Context{withToken(token), withToken(other), withToken(other)},
+			checks: 2,
+		},
+		{
+			name:   "denial cached",
+			ctxs:   []context.Context{withToken(token), withToken(token)},
+			err:    denied,
+			checks: 1,
+		},
+		{
+			name:   "failure not cached",
+			ctxs:   []context.Context{withToken(token), withToken(token)},
+			err:    failed,
+			checks: 2,
+		},
+		{
+			name:   "expired token not cached",
+			ctxs:   []context.Context{withToken(expired), withToken(expired)},
+			checks: 2,
+		},
+		{
+			name:   "without token not cached",
+			ctxs:   []context.Context{context.Background(), context.Background()},
+			checks: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			authClient := mocks.NewAuthClientMock(mc).CheckAccessMock.Return(tt.err)
+			cl := auth.NewCachingClient(authClient, ttl, negativeTTL, timeout, maxEntries)
+
+			for _, ctx := range tt.ctxs {
+				require.Equal(t, tt.err, cl.CheckAccess(ctx, endpoint))
+			}
+			require.Equal(t, tt.checks, authClient.CheckAccessAfterCounter())
+		})
+	}
+}
+
+func TestCachingClientCheckUsersExist(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	authClient := mocks.NewAuthClientMock(mc).CheckUsersExistMock.Return(nil)
+	cl := auth.NewCachingClient(authClient, ttl, negativeTTL, timeout, maxEntries)
+	ctx := withToken(newToken(time.Now().Add(time.Hour)))
+
+	require.NoError(t, cl.CheckUsersExist(ctx, []int64{1, 2, 3}))
+	require.NoError(t, cl.CheckUsersExist(ctx, []int64{3, 1, 2, 1}))
+	require.NoError(t, cl.CheckUsersExist(ctx, []int64{1, 2}))
+	require.Equal(t, uint64(2), authClient.CheckUsersExistAfterCounter())
+}
+
+func TestCachingClientExpiration(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	authClient := mocks.NewAuthClientMock(mc).CheckAccessMock.Return(nil)
+	cl := auth.NewCachingClient(authClient, 50*time.Millisecond, negativeTTL, timeout, maxEntries)
+	ctx := withToken(newToken(time.Now().Add(time.Hour)))
+	endpoint := gofakeit.URL()
+
+	require.NoError(t, cl.CheckAccess(ctx, endpoint))
+	require.NoError(t, cl.CheckAccess(ctx, endpoint))
+	require.Equal(t, uint64(1), authClient.CheckAccessAfterCounter(), "the result is cached until it expires")
+	time.Sleep(100 * time.Millisecond)
+	require.NoError(t, cl.CheckAccess(ctx, endpoint))
+	require.Equal(t, uint64(2), authClient.CheckAccessAfterCounter())
+}
+
+func TestCachingClientCoalescing(t *testing.T) {
+	t.Parallel()
+
+	const callers = 10
+
+	var (
+		mc       = minimock.NewController(t)
+		endpoint = "/chat_v1.ChatV1/Create"
+		ctx      = withToken(newToken(time.Now().Add(time.Hour)))
+		release  = make(chan struct{})
+	)
+
+	authClient := mocks.NewAuthClientMock(mc).CheckAccessMock.Set(func(_ context.Context, _ string) error {
+		<-release
+		return nil
+	})
+	cl := auth.NewCachingClient(authClient, ttl, negativeTTL, timeout, maxEntries)
+
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, cl.CheckAccess(ctx, endpoint))
+		}()
+	}
+
+	// let the callers pile up on the first check before it completes
+	require.Eventually(t, func() bool {
+		return authClient.CheckAccessBeforeCounter() == 1
+	}, time.Second, time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, uint64(1), authClient.CheckAccessAfterCounter())
+}
+
+func TestCachingClientCancelledCaller(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc       = minimock.NewController(t)
+		endpoint = "/chat_v1.ChatV1/Create"
+		token    = newToken(time.Now().Add(time.Hour))
+		release  = make(chan struct{})
+	)
+
+	authClient := mocks.NewAuthClientMock(mc).CheckAccessMock.Set(func(ctx context.Context, _ string) error {
+		<-release
+		return ctx.Err()
+	})
+	cl := auth.NewCachingClient(authClient, ttl, negativeTTL, timeout, maxEntries)
+
+	// the first caller starts the shared check and gives up waiting for it
+	ctx, cancel := context.WithCancel(withToken(token))
+	first := make(chan error, 1)
+	go func() {
+		first <- cl.CheckAccess(ctx, endpoint)
+	}()
+	require.Eventually(t, func() bool {
+		return authClient.CheckAccessBeforeCounter() == 1
+	}, time.Second, time.Millisecond)
+
+	second := make(chan error, 1)
+	go func() {
+		second <- cl.CheckAccess(withToken(token), endpoint)
+	}()
+
+	cancel()
+	require.ErrorIs(t, <-first, context.Canceled)
+
+	close(release)
+	require.NoError(t, <-second, "the shared check is not cancelled with the caller that started it")
+	require.Equal(t, uint64(1), authClient.CheckAccessAfterCounter())
+}
+
+func TestCachingClientUsersCheckFailOpen(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	authClient := mocks.NewAuthClientMock(mc).
+		CheckUsersExistMock.Return(customerrors.NewUnavailableError("auth", errors.New(gofakeit.Sentence(3))))
+
+	cfg := resilienceConfig
+	cfg.RetryAttempts = 1
+	cfg.UsersCheckFailOpen = true
+	cl := auth.NewCachingClient(auth.NewResilientClient(authClient, cfg), ttl, negativeTTL, timeout, maxEntries)
+	ctx := withToken(newToken(time.Now().Add(time.Hour)))
+
+	require.NoError(t, cl.CheckUsersExist(ctx, []int64{1, 2}))
+	require.NoError(t, cl.CheckUsersExist(ctx, []int64{1, 2}))
+	require.Equal(t, uint64(2), authClient.CheckUsersExistAfterCounter(), "users assumed to exist are not cached")
+}
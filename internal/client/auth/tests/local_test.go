@@ -0,0 +1,209 @@
+package tests
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/mikhailsoldatkin/chat-server/internal/client"
+	"github.com/mikhailsoldatkin/chat-server/internal/client/auth"
+	"github.com/mikhailsoldatkin/chat-server/internal/client/mocks"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/rbac"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	sendMessage   = "/chat_v1.ChatV1/SendMessage"
+	listReports   = "/chat_v1.ChatV1/ListReports"
+	announcements = int64(1)
+)
+
+const policyYAML = `
+methods:
+  /chat_v1.ChatV1/SendMessage: [admin, user]
+  /chat_v1.ChatV1/ListReports: [admin]
+chats:
+  - id: 1
+    methods:
+      /chat_v1.ChatV1/SendMessage: [admin]
+`
+
+// newPolicy creates the access policy and a verifier trusting the returned signing key.
+func newPolicy(t *testing.T) (*rbac.Policy, *rbac.Verifier, ed25519.PrivateKey) {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	policyFile := filepath.Join(dir, "policy.yaml")
+	require.NoError(t, os.WriteFile(policyFile, []byte(policyYAML), 0o600))
+	policy, err := rbac.NewPolicyFromFile(policyFile)
+	require.NoError(t, err)
+
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(public)
+	require.NoError(t, err)
+
+	keyFile := filepath.Join(dir, "public.pem")
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+	verifier, err := rbac.NewVerifierFromFiles(keyFile, "")
+	require.NoError(t, err)
+
+	return policy, verifier, private
+}
+
+// signedToken signs an access token of the role valid for an hour.
+func signedToken(t *testing.T, key ed25519.PrivateKey, role string) string {
+	t.Helper()
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodEdDSA, rbac.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour))},
+		Username:         gofakeit.Username(),
+		Role:             role,
+	}).SignedString(key)
+	require.NoError(t, err)
+
+	return token
+}
+
+func TestAccessClient(t *testing.T) {
+	t.Parallel()
+
+	policy, verifier, key := newPolicy(t)
+
+	var (
+		admin  = withToken(signedToken(t, key, "admin"))
+		user   = withToken(signedToken(t, key, "user"))
+		forged = withToken(newToken(time.Now().Add(time.Hour)))
+		denied = customerrors.NewPermissionDeniedError(gofakeit.Sentence(3))
+	)
+
+	tests := []struct {
+		name         string
+		mode         string
+		ctx          context.Context
+		endpoint     string
+		chatID       int64
+		remoteErr    error
+		code         codes.Code
+		remoteChecks uint64
+	}{
+		{
+			name:     "local allows",
+			mode:     auth.ModeLocal,
+			ctx:      user,
+			endpoint: sendMessage,
+			code:     codes.OK,
+		},
+		{
+			name:     "local denies role",
+			mode:     auth.ModeLocal,
+			ctx:      user,
+			endpoint: listReports,
+			code:     codes.PermissionDenied,
+		},
+		{
+			name:     "local denies chat",
+			mode:     auth.ModeLocal,
+			ctx:      user,
+			endpoint: sendMessage,
+			chatID:   announcements,
+			code:     codes.PermissionDenied,
+		},
+		{
+			name:     "local allows chat",
+			mode:     auth.ModeLocal,
+			ctx:      admin,
+			endpoint: sendMessage,
+			chatID:   announcements,
+			code:     codes.OK,
+		},
+		{
+			name:     "local rejects unverified token",
+			mode:     auth.ModeLocal,
+			ctx:      forged,
+			endpoint: sendMessage,
+			code:     codes.Unauthenticated,
+		},
+		{
+			name:     "local rejects missing token",
+			mode:     auth.ModeLocal,
+			ctx:      context.Background(),
+			endpoint: sendMessage,
+			code:     codes.Unauthenticated,
+		},
+		{
+			name:         "remote",
+			mode:         auth.ModeRemote,
+			ctx:          user,
+			endpoint:     listReports,
+			code:         codes.OK,
+			remoteChecks: 1,
+		},
+		{
+			name:         "both allow",
+			mode:         auth.ModeBoth,
+			ctx:          admin,
+			endpoint:     listReports,
+			code:         codes.OK,
+			remoteChecks: 1,
+		},
+		{
+			name:         "both remote denies",
+			mode:         auth.ModeBoth,
+			ctx:          admin,
+			endpoint:     listReports,
+			remoteErr:    denied,
+			code:         codes.PermissionDenied,
+			remoteChecks: 1,
+		},
+		{
+			name:     "both local denies",
+			mode:     auth.ModeBoth,
+			ctx:      user,
+			endpoint: listReports,
+			code:     codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			remote := mocks.NewAuthClientMock(mc)
+			if tt.remoteChecks > 0 {
+				remote.CheckAccessMock.Return(tt.remoteErr)
+			}
+
+			cl, err := auth.NewAccessClient(tt.mode, remote, policy, verifier)
+			require.NoError(t, err)
+
+			if checker, ok := cl.(client.ChatAccessChecker); ok {
+				err = checker.CheckChatAccess(tt.ctx, tt.endpoint, tt.chatID)
+			} else {
+				err = cl.CheckAccess(tt.ctx, tt.endpoint)
+			}
+			require.Equal(t, tt.code, code(err))
+			require.Equal(t, tt.remoteChecks, remote.CheckAccessAfterCounter())
+		})
+	}
+}
+
+func TestAccessClientUnknownMode(t *testing.T) {
+	t.Parallel()
+
+	_, err := auth.NewAccessClient("unknown", mocks.NewAuthClientMock(minimock.NewController(t)), nil, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,214 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/chat-server/internal/client/auth"
+	"github.com/mikhailsoldatkin/chat-server/internal/client/mocks"
+	"github.com/mikhailsoldatkin/chat-server/internal/config"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var resilienceConfig = config.Auth{
+	Timeout:          time.Second,
+	RetryAttempts:    3,
+	RetryBackoff:     time.Millisecond,
+	RetryMaxBackoff:  10 * time.Millisecond,
+	BreakerThreshold: 100,
+	BreakerCooldown:  time.Minute,
+}
+
+// code returns the gRPC status code the error is returned to clients with.
+func code(err error) codes.Code {
+	return status.Code(customerrors.ConvertError(err))
+}
+
+func TestResilientClientRetries(t *testing.T) {
+	t.Parallel()
+
+	var (
+		endpoint    = gofakeit.URL()
+		unavailable = customerrors.NewUnavailableError("auth", errors.New(gofakeit.Sentence(3)))
+		denied      = customerrors.NewPermissionDeniedError(gofakeit.Sentence(3))
+	)
+
+	tests := []struct {
+		name   string
+		errs   []error
+		code   codes.Code
+		checks uint64
+	}{
+		{
+			name:   "success",
+			errs:   []error{nil},
+			code:   codes.OK,
+			checks: 1,
+		},
+		{
+			name:   "success after retries",
+			errs:   []error{unavailable, status.Error(codes.DeadlineExceeded, gofakeit.Sentence(3)), nil},
+			code:   codes.OK,
+			checks: 3,
+		},
+		{
+			name:   "denial not retried",
+			errs:   []error{denied},
+			code:   codes.PermissionDenied,
+			checks: 1,
+		},
+		{
+			name:   "retries exhausted",
+			errs:   []error{unavailable, unavailable, unavailable},
+			code:   codes.Unavailable,
+			checks: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			authClient := mocks.NewAuthClientMock(mc)
+			authClient.CheckAccessMock.Set(func(_ context.Context, _ string) error {
+				return tt.errs[authClient.CheckAccessBeforeCounter()-1]
+			})
+			cl := auth.NewResilientClient(authClient, resilienceConfig)
+
+			err := cl.CheckAccess(context.Background(), endpoint)
+			require.Equal(t, tt.code, code(err))
+			require.Equal(t, tt.checks, authClient.CheckAccessAfterCounter())
+		})
+	}
+}
+
+func TestResilientClientTimeout(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	authClient := mocks.NewAuthClientMock(mc).CheckAccessMock.Set(func(ctx context.Context, _ string) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	cfg := resilienceConfig
+	cfg.Timeout = 10 * time.Millisecond
+	cfg.RetryAttempts = 2
+	cl := auth.NewResilientClient(authClient, cfg)
+
+	err := cl.CheckAccess(context.Background(), gofakeit.URL())
+	require.Equal(t, codes.Unavailable, code(err))
+	require.Equal(t, uint64(2), authClient.CheckAccessAfterCounter())
+}
+
+func TestResilientClientCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	authClient := mocks.NewAuthClientMock(mc)
+	authClient.CheckAccessMock.Set(func(_ context.Context, _ string) error {
+		if authClient.CheckAccessBeforeCounter() <= 2 {
+			return customerrors.NewUnavailableError("auth", errors.New(gofakeit.Sentence(3)))
+		}
+		return nil
+	})
+
+	cfg := resilienceConfig
+	cfg.RetryAttempts = 1
+	cfg.BreakerThreshold = 2
+	cfg.BreakerCooldown = 50 * time.Millisecond
+	cl := auth.NewResilientClient(authClient, cfg)
+	ctx := context.Background()
+
+	// the breaker opens after two failures and rejects calls without making them
+	require.Equal(t, codes.Unavailable, code(cl.CheckAccess(ctx, gofakeit.URL())))
+	require.Equal(t, codes.Unavailable, code(cl.CheckAccess(ctx, gofakeit.URL())))
+	require.Equal(t, codes.Unavailable, code(cl.CheckAccess(ctx, gofakeit.URL())))
+	require.Equal(t, uint64(2), authClient.CheckAccessAfterCounter())
+
+	// after the cooldown a trial call succeeds and closes the breaker
+	time.Sleep(2 * cfg.BreakerCooldown)
+	require.NoError(t, cl.CheckAccess(ctx, gofakeit.URL()))
+	require.NoError(t, cl.CheckAccess(ctx, gofakeit.URL()))
+	require.Equal(t, uint64(4), authClient.CheckAccessAfterCounter())
+}
+
+func TestResilientClientUsersCheckFailOpen(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		failOpen bool
+		code     codes.Code
+	}{
+		{
+			name:     "fail closed",
+			failOpen: false,
+			code:     codes.Unavailable,
+		},
+		{
+			name:     "fail open",
+			failOpen: true,
+			code:     codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			authClient := mocks.NewAuthClientMock(mc).
+				CheckUsersExistMock.Return(status.Error(codes.Unavailable, gofakeit.Sentence(3)))
+
+			cfg := resilienceConfig
+			cfg.UsersCheckFailOpen = tt.failOpen
+			cl := auth.NewResilientClient(authClient, cfg)
+
+			err := cl.CheckUsersExist(context.Background(), []int64{gofakeit.Int64()})
+			require.Equal(t, tt.code, code(err))
+		})
+	}
+}
+
+func TestResilientClientGetUsername(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc       = minimock.NewController(t)
+		username = gofakeit.Username()
+		errs     = []error{status.Error(codes.Unavailable, gofakeit.Sentence(3)), nil}
+	)
+
+	authClient := mocks.NewAuthClientMock(mc)
+	authClient.GetUsernameMock.Set(func(_ context.Context, _ int64) (string, error) {
+		err := errs[authClient.GetUsernameBeforeCounter()-1]
+		if err != nil {
+			return "", err
+		}
+		return username, nil
+	})
+
+	cfg := resilienceConfig
+	cfg.UsersCheckFailOpen = true
+	cl := auth.NewResilientClient(authClient, cfg)
+
+	got, err := cl.GetUsername(context.Background(), gofakeit.Int64())
+	require.NoError(t, err)
+	require.Equal(t, username, got, "the lookup is retried")
+
+	cl = auth.NewResilientClient(
+		mocks.NewAuthClientMock(mc).GetUsernameMock.Return("", status.Error(codes.Unavailable, gofakeit.Sentence(3))),
+		cfg,
+	)
+	_, err = cl.GetUsername(context.Background(), gofakeit.Int64())
+	require.Equal(t, codes.Unavailable, code(err), "users are never assumed during outages")
+}
@@ -6,4 +6,10 @@ import "context"
 type AuthClient interface {
 	CheckAccess(ctx context.Context, endpoint string) error
 	CheckUsersExist(ctx context.Context, ids []int64) error
+	GetUsername(ctx context.Context, id int64) (string, error)
+}
+
+// ChatAccessChecker is implemented by authentication clients able to check access to a method called on a chat.
+type ChatAccessChecker interface {
+	CheckChatAccess(ctx context.Context, endpoint string, chatID int64) error
 }
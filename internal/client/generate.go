@@ -0,0 +1,4 @@
+package client
+
+//go:generate sh -c "rm -rf mocks && mkdir -p mocks"
+//go:generate minimock -i AuthClient -o ./mocks/ -s "_minimock.go"
@@ -0,0 +1,1058 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/mikhailsoldatkin/chat-server/internal/client.AuthClient -o auth_client_minimock.go -n AuthClientMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// AuthClientMock implements client.AuthClient
+type AuthClientMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCheckAccess          func(ctx context.Context, endpoint string) (err error)
+	inspectFuncCheckAccess   func(ctx context.Context, endpoint string)
+	afterCheckAccessCounter  uint64
+	beforeCheckAccessCounter uint64
+	CheckAccessMock          mAuthClientMockCheckAccess
+
+	funcCheckUsersExist          func(ctx context.Context, ids []int64) (err error)
+	inspectFuncCheckUsersExist   func(ctx context.Context, ids []int64)
+	afterCheckUsersExistCounter  uint64
+	beforeCheckUsersExistCounter uint64
+	CheckUsersExistMock          mAuthClientMockCheckUsersExist
+
+	funcGetUsername          func(ctx context.Context, id int64) (s1 string, err error)
+	inspectFuncGetUsername   func(ctx context.Context, id int64)
+	afterGetUsernameCounter  uint64
+	beforeGetUsernameCounter uint64
+	GetUsernameMock          mAuthClientMockGetUsername
+}
+
+// NewAuthClientMock returns a mock for client.AuthClient
+func NewAuthClientMock(t minimock.Tester) *AuthClientMock {
+	m := &AuthClientMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CheckAccessMock = mAuthClientMockCheckAccess{mock: m}
+	m.CheckAccessMock.callArgs = []*AuthClientMockCheckAccessParams{}
+
+	m.CheckUsersExistMock = mAuthClientMockCheckUsersExist{mock: m}
+	m.CheckUsersExistMock.callArgs = []*AuthClientMockCheckUsersExistParams{}
+
+	m.GetUsernameMock = mAuthClientMockGetUsername{mock: m}
+	m.GetUsernameMock.callArgs = []*AuthClientMockGetUsernameParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuthClientMockCheckAccess struct {
+	optional           bool
+	mock               *AuthClientMock
+	defaultExpectation *AuthClientMockCheckAccessExpectation
+	expectations       []*AuthClientMockCheckAccessExpectation
+
+	callArgs []*AuthClientMockCheckAccessParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuthClientMockCheckAccessExpectation specifies expectation struct of the AuthClient.CheckAccess
+type AuthClientMockCheckAccessExpectation struct {
+	mock      *AuthClientMock
+	params    *AuthClientMockCheckAccessParams
+	paramPtrs *AuthClientMockCheckAccessParamPtrs
+	results   *AuthClientMockCheckAccessResults
+	Counter   uint64
+}
+
+// AuthClientMockCheckAccessParams contains parameters of the AuthClient.CheckAccess
+type AuthClientMockCheckAccessParams struct {
+	ctx      context.Context
+	endpoint string
+}
+
+// AuthClientMockCheckAccessParamPtrs contains pointers to parameters of the AuthClient.CheckAccess
+type AuthClientMockCheckAccessParamPtrs struct {
+	ctx      *context.Context
+	endpoint *string
+}
+
+// AuthClientMockCheckAccessResults contains results of the AuthClient.CheckAccess
+type AuthClientMockCheckAccessResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheckAccess *mAuthClientMockCheckAccess) Optional() *mAuthClientMockCheckAccess {
+	mmCheckAccess.optional = true
+	return mmCheckAccess
+}
+
+// Expect sets up expected params for AuthClient.CheckAccess
+func (mmCheckAccess *mAuthClientMockCheckAccess) Expect(ctx context.Context, endpoint string) *mAuthClientMockCheckAccess {
+	if mmCheckAccess.mock.funcCheckAccess != nil {
+		mmCheckAccess.mock.t.Fatalf("AuthClientMock.CheckAccess mock is already set by Set")
+	}
+
+	if mmCheckAccess.defaultExpectation == nil {
+		mmCheckAccess.defaultExpectation = &AuthClientMockCheckAccessExpectation{}
+	}
+
+	if mmCheckAccess.defaultExpectation.paramPtrs != nil {
+		mmCheckAccess.mock.t.Fatalf("AuthClientMock.CheckAccess mock is already set by ExpectParams functions")
+	}
+
+	mmCheckAccess.defaultExpectation.params = &AuthClientMockCheckAccessParams{ctx, endpoint}
+	for _, e := range mmCheckAccess.expectations {
+		if minimock.Equal(e.params, mmCheckAccess.defaultExpectation.params) {
+			mmCheckAccess.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckAccess.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckAccess
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthClient.CheckAccess
+func (mmCheckAccess *mAuthClientMockCheckAccess) ExpectCtxParam1(ctx context.Context) *mAuthClientMockCheckAccess {
+	if mmCheckAccess.mock.funcCheckAccess != nil {
+		mmCheckAccess.mock.t.Fatalf("AuthClientMock.CheckAccess mock is already set by Set")
+	}
+
+	if mmCheckAccess.defaultExpectation == nil {
+		mmCheckAccess.defaultExpectation = &AuthClientMockCheckAccessExpectation{}
+	}
+
+	if mmCheckAccess.defaultExpectation.params != nil {
+		mmCheckAccess.mock.t.Fatalf("AuthClientMock.CheckAccess mock is already set by Expect")
+	}
+
+	if mmCheckAccess.defaultExpectation.paramPtrs == nil {
+		mmCheckAccess.defaultExpectation.paramPtrs = &AuthClientMockCheckAccessParamPtrs{}
+	}
+	mmCheckAccess.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCheckAccess
+}
+
+// ExpectEndpointParam2 sets up expected param endpoint for AuthClient.CheckAccess
+func (mmCheckAccess *mAuthClientMockCheckAccess) ExpectEndpointParam2(endpoint string) *mAuthClientMockCheckAccess {
+	if mmCheckAccess.mock.funcCheckAccess != nil {
+		mmCheckAccess.mock.t.Fatalf("AuthClientMock.CheckAccess mock is already set by Set")
+	}
+
+	if mmCheckAccess.defaultExpectation == nil {
+		mmCheckAccess.defaultExpectation = &AuthClientMockCheckAccessExpectation{}
+	}
+
+	if mmCheckAccess.defaultExpectation.params != nil {
+		mmCheckAccess.mock.t.Fatalf("AuthClientMock.CheckAccess mock is already set by Expect")
+	}
+
+	if mmCheckAccess.defaultExpectation.paramPtrs == nil {
+		mmCheckAccess.defaultExpectation.paramPtrs = &AuthClientMockCheckAccessParamPtrs{}
+	}
+	mmCheckAccess.defaultExpectation.paramPtrs.endpoint = &endpoint
+
+	return mmCheckAccess
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthClient.CheckAccess
+func (mmCheckAccess *mAuthClientMockCheckAccess) Inspect(f func(ctx context.Context, endpoint string)) *mAuthClientMockCheckAccess {
+	if mmCheckAccess.mock.inspectFuncCheckAccess != nil {
+		mmCheckAccess.mock.t.Fatalf("Inspect function is already set for AuthClientMock.CheckAccess")
+	}
+
+	mmCheckAccess.mock.inspectFuncCheckAccess = f
+
+	return mmCheckAccess
+}
+
+// Return sets up results that will be returned by AuthClient.CheckAccess
+func (mmCheckAccess *mAuthClientMockCheckAccess) Return(err error) *AuthClientMock {
+	if mmCheckAccess.mock.funcCheckAccess != nil {
+		mmCheckAccess.mock.t.Fatalf("AuthClientMock.CheckAccess mock is already set by Set")
+	}
+
+	if mmCheckAccess.defaultExpectation == nil {
+		mmCheckAccess.defaultExpectation = &AuthClientMockCheckAccessExpectation{mock: mmCheckAccess.mock}
+	}
+	mmCheckAccess.defaultExpectation.results = &AuthClientMockCheckAccessResults{err}
+	return mmCheckAccess.mock
+}
+
+// Set uses given function f to mock the AuthClient.CheckAccess method
+func (mmCheckAccess *mAuthClientMockCheckAccess) Set(f func(ctx context.Context, endpoint string) (err error)) *AuthClientMock {
+	if mmCheckAccess.defaultExpectation != nil {
+		mmCheckAccess.mock.t.Fatalf("Default expectation is already set for the AuthClient.CheckAccess method")
+	}
+
+	if len(mmCheckAccess.expectations) > 0 {
+		mmCheckAccess.mock.t.Fatalf("Some expectations are already set for the AuthClient.CheckAccess method")
+	}
+
+	mmCheckAccess.mock.funcCheckAccess = f
+	return mmCheckAccess.mock
+}
+
+// When sets expectation for the AuthClient.CheckAccess which will trigger the result defined by the following
+// Then helper
+func (mmCheckAccess *mAuthClientMockCheckAccess) When(ctx context.Context, endpoint string) *AuthClientMockCheckAccessExpectation {
+	if mmCheckAccess.mock.funcCheckAccess != nil {
+		mmCheckAccess.mock.t.Fatalf("AuthClientMock.CheckAccess mock is already set by Set")
+	}
+
+	expectation := &AuthClientMockCheckAccessExpectation{
+		mock:   mmCheckAccess.mock,
+		params: &AuthClientMockCheckAccessParams{ctx, endpoint},
+	}
+	mmCheckAccess.expectations = append(mmCheckAccess.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthClient.CheckAccess return parameters for the expectation previously defined by the When method
+func (e *AuthClientMockCheckAccessExpectation) Then(err error) *AuthClientMock {
+	e.results = &AuthClientMockCheckAccessResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthClient.CheckAccess should be invoked
+func (mmCheckAccess *mAuthClientMockCheckAccess) Times(n uint64) *mAuthClientMockCheckAccess {
+	if n == 0 {
+		mmCheckAccess.mock.t.Fatalf("Times of AuthClientMock.CheckAccess mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheckAccess.expectedInvocations, n)
+	return mmCheckAccess
+}
+
+func (mmCheckAccess *mAuthClientMockCheckAccess) invocationsDone() bool {
+	if len(mmCheckAccess.expectations) == 0 && mmCheckAccess.defaultExpectation == nil && mmCheckAccess.mock.funcCheckAccess == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheckAccess.mock.afterCheckAccessCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheckAccess.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CheckAccess implements client.AuthClient
+func (mmCheckAccess *AuthClientMock) CheckAccess(ctx context.Context, endpoint string) (err error) {
+	mm_atomic.AddUint64(&mmCheckAccess.beforeCheckAccessCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckAccess.afterCheckAccessCounter, 1)
+
+	if mmCheckAccess.inspectFuncCheckAccess != nil {
+		mmCheckAccess.inspectFuncCheckAccess(ctx, endpoint)
+	}
+
+	mm_params := AuthClientMockCheckAccessParams{ctx, endpoint}
+
+	// Record call args
+	mmCheckAccess.CheckAccessMock.mutex.Lock()
+	mmCheckAccess.CheckAccessMock.callArgs = append(mmCheckAccess.CheckAccessMock.callArgs, &mm_params)
+	mmCheckAccess.CheckAccessMock.mutex.Unlock()
+
+	for _, e := range mmCheckAccess.CheckAccessMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCheckAccess.CheckAccessMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckAccess.CheckAccessMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckAccess.CheckAccessMock.defaultExpectation.params
+		mm_want_ptrs := mmCheckAccess.CheckAccessMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthClientMockCheckAccessParams{ctx, endpoint}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheckAccess.t.Errorf("AuthClientMock.CheckAccess got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.endpoint != nil && !minimock.Equal(*mm_want_ptrs.endpoint, mm_got.endpoint) {
+				mmCheckAccess.t.Errorf("AuthClientMock.CheckAccess got unexpected parameter endpoint, want: %#v, got: %#v%s\n", *mm_want_ptrs.endpoint, mm_got.endpoint, minimock.Diff(*mm_want_ptrs.endpoint, mm_got.endpoint))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckAccess.t.Errorf("AuthClientMock.CheckAccess got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckAccess.CheckAccessMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckAccess.t.Fatal("No results are set for the AuthClientMock.CheckAccess")
+		}
+		return (*mm_results).err
+	}
+	if mmCheckAccess.funcCheckAccess != nil {
+		return mmCheckAccess.funcCheckAccess(ctx, endpoint)
+	}
+	mmCheckAccess.t.Fatalf("Unexpected call to AuthClientMock.CheckAccess. %v %v", ctx, endpoint)
+	return
+}
+
+// CheckAccessAfterCounter returns a count of finished AuthClientMock.CheckAccess invocations
+func (mmCheckAccess *AuthClientMock) CheckAccessAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckAccess.afterCheckAccessCounter)
+}
+
+// CheckAccessBeforeCounter returns a count of AuthClientMock.CheckAccess invocations
+func (mmCheckAccess *AuthClientMock) CheckAccessBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckAccess.beforeCheckAccessCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthClientMock.CheckAccess.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckAccess *mAuthClientMockCheckAccess) Calls() []*AuthClientMockCheckAccessParams {
+	mmCheckAccess.mutex.RLock()
+
+	argCopy := make([]*AuthClientMockCheckAccessParams, len(mmCheckAccess.callArgs))
+	copy(argCopy, mmCheckAccess.callArgs)
+
+	mmCheckAccess.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckAccessDone returns true if the count of the CheckAccess invocations corresponds
+// the number of defined expectations
+func (m *AuthClientMock) MinimockCheckAccessDone() bool {
+	if m.CheckAccessMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckAccessMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckAccessMock.invocationsDone()
+}
+
+// MinimockCheckAccessInspect logs each unmet expectation
+func (m *AuthClientMock) MinimockCheckAccessInspect() {
+	for _, e := range m.CheckAccessMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthClientMock.CheckAccess with params: %#v", *e.params)
+		}
+	}
+
+	afterCheckAccessCounter := mm_atomic.LoadUint64(&m.afterCheckAccessCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckAccessMock.defaultExpectation != nil && afterCheckAccessCounter < 1 {
+		if m.CheckAccessMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuthClientMock.CheckAccess")
+		} else {
+			m.t.Errorf("Expected call to AuthClientMock.CheckAccess with params: %#v", *m.CheckAccessMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckAccess != nil && afterCheckAccessCounter < 1 {
+		m.t.Error("Expected call to AuthClientMock.CheckAccess")
+	}
+
+	if !m.CheckAccessMock.invocationsDone() && afterCheckAccessCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthClientMock.CheckAccess but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckAccessMock.expectedInvocations), afterCheckAccessCounter)
+	}
+}
+
+type mAuthClientMockCheckUsersExist struct {
+	optional           bool
+	mock               *AuthClientMock
+	defaultExpectation *AuthClientMockCheckUsersExistExpectation
+	expectations       []*AuthClientMockCheckUsersExistExpectation
+
+	callArgs []*AuthClientMockCheckUsersExistParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuthClientMockCheckUsersExistExpectation specifies expectation struct of the AuthClient.CheckUsersExist
+type AuthClientMockCheckUsersExistExpectation struct {
+	mock      *AuthClientMock
+	params    *AuthClientMockCheckUsersExistParams
+	paramPtrs *AuthClientMockCheckUsersExistParamPtrs
+	results   *AuthClientMockCheckUsersExistResults
+	Counter   uint64
+}
+
+// AuthClientMockCheckUsersExistParams contains parameters of the AuthClient.CheckUsersExist
+type AuthClientMockCheckUsersExistParams struct {
+	ctx context.Context
+	ids []int64
+}
+
+// AuthClientMockCheckUsersExistParamPtrs contains pointers to parameters of the AuthClient.CheckUsersExist
+type AuthClientMockCheckUsersExistParamPtrs struct {
+	ctx *context.Context
+	ids *[]int64
+}
+
+// AuthClientMockCheckUsersExistResults contains results of the AuthClient.CheckUsersExist
+type AuthClientMockCheckUsersExistResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheckUsersExist *mAuthClientMockCheckUsersExist) Optional() *mAuthClientMockCheckUsersExist {
+	mmCheckUsersExist.optional = true
+	return mmCheckUsersExist
+}
+
+// Expect sets up expected params for AuthClient.CheckUsersExist
+func (mmCheckUsersExist *mAuthClientMockCheckUsersExist) Expect(ctx context.Context, ids []int64) *mAuthClientMockCheckUsersExist {
+	if mmCheckUsersExist.mock.funcCheckUsersExist != nil {
+		mmCheckUsersExist.mock.t.Fatalf("AuthClientMock.CheckUsersExist mock is already set by Set")
+	}
+
+	if mmCheckUsersExist.defaultExpectation == nil {
+		mmCheckUsersExist.defaultExpectation = &AuthClientMockCheckUsersExistExpectation{}
+	}
+
+	if mmCheckUsersExist.defaultExpectation.paramPtrs != nil {
+		mmCheckUsersExist.mock.t.Fatalf("AuthClientMock.CheckUsersExist mock is already set by ExpectParams functions")
+	}
+
+	mmCheckUsersExist.defaultExpectation.params = &AuthClientMockCheckUsersExistParams{ctx, ids}
+	for _, e := range mmCheckUsersExist.expectations {
+		if minimock.Equal(e.params, mmCheckUsersExist.defaultExpectation.params) {
+			mmCheckUsersExist.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckUsersExist.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckUsersExist
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthClient.CheckUsersExist
+func (mmCheckUsersExist *mAuthClientMockCheckUsersExist) ExpectCtxParam1(ctx context.Context) *mAuthClientMockCheckUsersExist {
+	if mmCheckUsersExist.mock.funcCheckUsersExist != nil {
+		mmCheckUsersExist.mock.t.Fatalf("AuthClientMock.CheckUsersExist mock is already set by Set")
+	}
+
+	if mmCheckUsersExist.defaultExpectation == nil {
+		mmCheckUsersExist.defaultExpectation = &AuthClientMockCheckUsersExistExpectation{}
+	}
+
+	if mmCheckUsersExist.defaultExpectation.params != nil {
+		mmCheckUsersExist.mock.t.Fatalf("AuthClientMock.CheckUsersExist mock is already set by Expect")
+	}
+
+	if mmCheckUsersExist.defaultExpectation.paramPtrs == nil {
+		mmCheckUsersExist.defaultExpectation.paramPtrs = &AuthClientMockCheckUsersExistParamPtrs{}
+	}
+	mmCheckUsersExist.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCheckUsersExist
+}
+
+// ExpectIdsParam2 sets up expected param ids for AuthClient.CheckUsersExist
+func (mmCheckUsersExist *mAuthClientMockCheckUsersExist) ExpectIdsParam2(ids []int64) *mAuthClientMockCheckUsersExist {
+	if mmCheckUsersExist.mock.funcCheckUsersExist != nil {
+		mmCheckUsersExist.mock.t.Fatalf("AuthClientMock.CheckUsersExist mock is already set by Set")
+	}
+
+	if mmCheckUsersExist.defaultExpectation == nil {
+		mmCheckUsersExist.defaultExpectation = &AuthClientMockCheckUsersExistExpectation{}
+	}
+
+	if mmCheckUsersExist.defaultExpectation.params != nil {
+		mmCheckUsersExist.mock.t.Fatalf("AuthClientMock.CheckUsersExist mock is already set by Expect")
+	}
+
+	if mmCheckUsersExist.defaultExpectation.paramPtrs == nil {
+		mmCheckUsersExist.defaultExpectation.paramPtrs = &AuthClientMockCheckUsersExistParamPtrs{}
+	}
+	mmCheckUsersExist.defaultExpectation.paramPtrs.ids = &ids
+
+	return mmCheckUsersExist
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthClient.CheckUsersExist
+func (mmCheckUsersExist *mAuthClientMockCheckUsersExist) Inspect(f func(ctx context.Context, ids []int64)) *mAuthClientMockCheckUsersExist {
+	if mmCheckUsersExist.mock.inspectFuncCheckUsersExist != nil {
+		mmCheckUsersExist.mock.t.Fatalf("Inspect function is already set for AuthClientMock.CheckUsersExist")
+	}
+
+	mmCheckUsersExist.mock.inspectFuncCheckUsersExist = f
+
+	return mmCheckUsersExist
+}
+
+// Return sets up results that will be returned by AuthClient.CheckUsersExist
+func (mmCheckUsersExist *mAuthClientMockCheckUsersExist) Return(err error) *AuthClientMock {
+	if mmCheckUsersExist.mock.funcCheckUsersExist != nil {
+		mmCheckUsersExist.mock.t.Fatalf("AuthClientMock.CheckUsersExist mock is already set by Set")
+	}
+
+	if mmCheckUsersExist.defaultExpectation == nil {
+		mmCheckUsersExist.defaultExpectation = &AuthClientMockCheckUsersExistExpectation{mock: mmCheckUsersExist.mock}
+	}
+	mmCheckUsersExist.defaultExpectation.results = &AuthClientMockCheckUsersExistResults{err}
+	return mmCheckUsersExist.mock
+}
+
+// Set uses given function f to mock the AuthClient.CheckUsersExist method
+func (mmCheckUsersExist *mAuthClientMockCheckUsersExist) Set(f func(ctx context.Context, ids []int64) (err error)) *AuthClientMock {
+	if mmCheckUsersExist.defaultExpectation != nil {
+		mmCheckUsersExist.mock.t.Fatalf("Default expectation is already set for the AuthClient.CheckUsersExist method")
+	}
+
+	if len(mmCheckUsersExist.expectations) > 0 {
+		mmCheckUsersExist.mock.t.Fatalf("Some expectations are already set for the AuthClient.CheckUsersExist method")
+	}
+
+	mmCheckUsersExist.mock.funcCheckUsersExist = f
+	return mmCheckUsersExist.mock
+}
+
+// When sets expectation for the AuthClient.CheckUsersExist which will trigger the result defined by the following
+// Then helper
+func (mmCheckUsersExist *mAuthClientMockCheckUsersExist) When(ctx context.Context, ids []int64) *AuthClientMockCheckUsersExistExpectation {
+	if mmCheckUsersExist.mock.funcCheckUsersExist != nil {
+		mmCheckUsersExist.mock.t.Fatalf("AuthClientMock.CheckUsersExist mock is already set by Set")
+	}
+
+	expectation := &AuthClientMockCheckUsersExistExpectation{
+		mock:   mmCheckUsersExist.mock,
+		params: &AuthClientMockCheckUsersExistParams{ctx, ids},
+	}
+	mmCheckUsersExist.expectations = append(mmCheckUsersExist.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthClient.CheckUsersExist return parameters for the expectation previously defined by the When method
+func (e *AuthClientMockCheckUsersExistExpectation) Then(err error) *AuthClientMock {
+	e.results = &AuthClientMockCheckUsersExistResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthClient.CheckUsersExist should be invoked
+func (mmCheckUsersExist *mAuthClientMockCheckUsersExist) Times(n uint64) *mAuthClientMockCheckUsersExist {
+	if n == 0 {
+		mmCheckUsersExist.mock.t.Fatalf("Times of AuthClientMock.CheckUsersExist mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheckUsersExist.expectedInvocations, n)
+	return mmCheckUsersExist
+}
+
+func (mmCheckUsersExist *mAuthClientMockCheckUsersExist) invocationsDone() bool {
+	if len(mmCheckUsersExist.expectations) == 0 && mmCheckUsersExist.defaultExpectation == nil && mmCheckUsersExist.mock.funcCheckUsersExist == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheckUsersExist.mock.afterCheckUsersExistCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheckUsersExist.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CheckUsersExist implements client.AuthClient
+func (mmCheckUsersExist *AuthClientMock) CheckUsersExist(ctx context.Context, ids []int64) (err error) {
+	mm_atomic.AddUint64(&mmCheckUsersExist.beforeCheckUsersExistCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckUsersExist.afterCheckUsersExistCounter, 1)
+
+	if mmCheckUsersExist.inspectFuncCheckUsersExist != nil {
+		mmCheckUsersExist.inspectFuncCheckUsersExist(ctx, ids)
+	}
+
+	mm_params := AuthClientMockCheckUsersExistParams{ctx, ids}
+
+	// Record call args
+	mmCheckUsersExist.CheckUsersExistMock.mutex.Lock()
+	mmCheckUsersExist.CheckUsersExistMock.callArgs = append(mmCheckUsersExist.CheckUsersExistMock.callArgs, &mm_params)
+	mmCheckUsersExist.CheckUsersExistMock.mutex.Unlock()
+
+	for _, e := range mmCheckUsersExist.CheckUsersExistMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCheckUsersExist.CheckUsersExistMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckUsersExist.CheckUsersExistMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckUsersExist.CheckUsersExistMock.defaultExpectation.params
+		mm_want_ptrs := mmCheckUsersExist.CheckUsersExistMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthClientMockCheckUsersExistParams{ctx, ids}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheckUsersExist.t.Errorf("AuthClientMock.CheckUsersExist got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmCheckUsersExist.t.Errorf("AuthClientMock.CheckUsersExist got unexpected parameter ids, want: %#v, got: %#v%s\n", *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckUsersExist.t.Errorf("AuthClientMock.CheckUsersExist got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckUsersExist.CheckUsersExistMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckUsersExist.t.Fatal("No results are set for the AuthClientMock.CheckUsersExist")
+		}
+		return (*mm_results).err
+	}
+	if mmCheckUsersExist.funcCheckUsersExist != nil {
+		return mmCheckUsersExist.funcCheckUsersExist(ctx, ids)
+	}
+	mmCheckUsersExist.t.Fatalf("Unexpected call to AuthClientMock.CheckUsersExist. %v %v", ctx, ids)
+	return
+}
+
+// CheckUsersExistAfterCounter returns a count of finished AuthClientMock.CheckUsersExist invocations
+func (mmCheckUsersExist *AuthClientMock) CheckUsersExistAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckUsersExist.afterCheckUsersExistCounter)
+}
+
+// CheckUsersExistBeforeCounter returns a count of AuthClientMock.CheckUsersExist invocations
+func (mmCheckUsersExist *AuthClientMock) CheckUsersExistBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckUsersExist.beforeCheckUsersExistCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthClientMock.CheckUsersExist.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckUsersExist *mAuthClientMockCheckUsersExist) Calls() []*AuthClientMockCheckUsersExistParams {
+	mmCheckUsersExist.mutex.RLock()
+
+	argCopy := make([]*AuthClientMockCheckUsersExistParams, len(mmCheckUsersExist.callArgs))
+	copy(argCopy, mmCheckUsersExist.callArgs)
+
+	mmCheckUsersExist.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckUsersExistDone returns true if the count of the CheckUsersExist invocations corresponds
+// the number of defined expectations
+func (m *AuthClientMock) MinimockCheckUsersExistDone() bool {
+	if m.CheckUsersExistMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckUsersExistMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckUsersExistMock.invocationsDone()
+}
+
+// MinimockCheckUsersExistInspect logs each unmet expectation
+func (m *AuthClientMock) MinimockCheckUsersExistInspect() {
+	for _, e := range m.CheckUsersExistMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthClientMock.CheckUsersExist with params: %#v", *e.params)
+		}
+	}
+
+	afterCheckUsersExistCounter := mm_atomic.LoadUint64(&m.afterCheckUsersExistCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckUsersExistMock.defaultExpectation != nil && afterCheckUsersExistCounter < 1 {
+		if m.CheckUsersExistMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuthClientMock.CheckUsersExist")
+		} else {
+			m.t.Errorf("Expected call to AuthClientMock.CheckUsersExist with params: %#v", *m.CheckUsersExistMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckUsersExist != nil && afterCheckUsersExistCounter < 1 {
+		m.t.Error("Expected call to AuthClientMock.CheckUsersExist")
+	}
+
+	if !m.CheckUsersExistMock.invocationsDone() && afterCheckUsersExistCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthClientMock.CheckUsersExist but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckUsersExistMock.expectedInvocations), afterCheckUsersExistCounter)
+	}
+}
+
+type mAuthClientMockGetUsername struct {
+	optional           bool
+	mock               *AuthClientMock
+	defaultExpectation *AuthClientMockGetUsernameExpectation
+	expectations       []*AuthClientMockGetUsernameExpectation
+
+	callArgs []*AuthClientMockGetUsernameParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuthClientMockGetUsernameExpectation specifies expectation struct of the AuthClient.GetUsername
+type AuthClientMockGetUsernameExpectation struct {
+	mock      *AuthClientMock
+	params    *AuthClientMockGetUsernameParams
+	paramPtrs *AuthClientMockGetUsernameParamPtrs
+	results   *AuthClientMockGetUsernameResults
+	Counter   uint64
+}
+
+// AuthClientMockGetUsernameParams contains parameters of the AuthClient.GetUsername
+type AuthClientMockGetUsernameParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// AuthClientMockGetUsernameParamPtrs contains pointers to parameters of the AuthClient.GetUsername
+type AuthClientMockGetUsernameParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// AuthClientMockGetUsernameResults contains results of the AuthClient.GetUsername
+type AuthClientMockGetUsernameResults struct {
+	s1  string
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUsername *mAuthClientMockGetUsername) Optional() *mAuthClientMockGetUsername {
+	mmGetUsername.optional = true
+	return mmGetUsername
+}
+
+// Expect sets up expected params for AuthClient.GetUsername
+func (mmGetUsername *mAuthClientMockGetUsername) Expect(ctx context.Context, id int64) *mAuthClientMockGetUsername {
+	if mmGetUsername.mock.funcGetUsername != nil {
+		mmGetUsername.mock.t.Fatalf("AuthClientMock.GetUsername mock is already set by Set")
+	}
+
+	if mmGetUsername.defaultExpectation == nil {
+		mmGetUsername.defaultExpectation = &AuthClientMockGetUsernameExpectation{}
+	}
+
+	if mmGetUsername.defaultExpectation.paramPtrs != nil {
+		mmGetUsername.mock.t.Fatalf("AuthClientMock.GetUsername mock is already set by ExpectParams functions")
+	}
+
+	mmGetUsername.defaultExpectation.params = &AuthClientMockGetUsernameParams{ctx, id}
+	for _, e := range mmGetUsername.expectations {
+		if minimock.Equal(e.params, mmGetUsername.defaultExpectation.params) {
+			mmGetUsername.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUsername.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUsername
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthClient.GetUsername
+func (mmGetUsername *mAuthClientMockGetUsername) ExpectCtxParam1(ctx context.Context) *mAuthClientMockGetUsername {
+	if mmGetUsername.mock.funcGetUsername != nil {
+		mmGetUsername.mock.t.Fatalf("AuthClientMock.GetUsername mock is already set by Set")
+	}
+
+	if mmGetUsername.defaultExpectation == nil {
+		mmGetUsername.defaultExpectation = &AuthClientMockGetUsernameExpectation{}
+	}
+
+	if mmGetUsername.defaultExpectation.params != nil {
+		mmGetUsername.mock.t.Fatalf("AuthClientMock.GetUsername mock is already set by Expect")
+	}
+
+	if mmGetUsername.defaultExpectation.paramPtrs == nil {
+		mmGetUsername.defaultExpectation.paramPtrs = &AuthClientMockGetUsernameParamPtrs{}
+	}
+	mmGetUsername.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetUsername
+}
+
+// ExpectIdParam2 sets up expected param id for AuthClient.GetUsername
+func (mmGetUsername *mAuthClientMockGetUsername) ExpectIdParam2(id int64) *mAuthClientMockGetUsername {
+	if mmGetUsername.mock.funcGetUsername != nil {
+		mmGetUsername.mock.t.Fatalf("AuthClientMock.GetUsername mock is already set by Set")
+	}
+
+	if mmGetUsername.defaultExpectation == nil {
+		mmGetUsername.defaultExpectation = &AuthClientMockGetUsernameExpectation{}
+	}
+
+	if mmGetUsername.defaultExpectation.params != nil {
+		mmGetUsername.mock.t.Fatalf("AuthClientMock.GetUsername mock is already set by Expect")
+	}
+
+	if mmGetUsername.defaultExpectation.paramPtrs == nil {
+		mmGetUsername.defaultExpectation.paramPtrs = &AuthClientMockGetUsernameParamPtrs{}
+	}
+	mmGetUsername.defaultExpectation.paramPtrs.id = &id
+
+	return mmGetUsername
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthClient.GetUsername
+func (mmGetUsername *mAuthClientMockGetUsername) Inspect(f func(ctx context.Context, id int64)) *mAuthClientMockGetUsername {
+	if mmGetUsername.mock.inspectFuncGetUsername != nil {
+		mmGetUsername.mock.t.Fatalf("Inspect function is already set for AuthClientMock.GetUsername")
+	}
+
+	mmGetUsername.mock.inspectFuncGetUsername = f
+
+	return mmGetUsername
+}
+
+// Return sets up results that will be returned by AuthClient.GetUsername
+func (mmGetUsername *mAuthClientMockGetUsername) Return(s1 string, err error) *AuthClientMock {
+	if mmGetUsername.mock.funcGetUsername != nil {
+		mmGetUsername.mock.t.Fatalf("AuthClientMock.GetUsername mock is already set by Set")
+	}
+
+	if mmGetUsername.defaultExpectation == nil {
+		mmGetUsername.defaultExpectation = &AuthClientMockGetUsernameExpectation{mock: mmGetUsername.mock}
+	}
+	mmGetUsername.defaultExpectation.results = &AuthClientMockGetUsernameResults{s1, err}
+	return mmGetUsername.mock
+}
+
+// Set uses given function f to mock the AuthClient.GetUsername method
+func (mmGetUsername *mAuthClientMockGetUsername) Set(f func(ctx context.Context, id int64) (s1 string, err error)) *AuthClientMock {
+	if mmGetUsername.defaultExpectation != nil {
+		mmGetUsername.mock.t.Fatalf("Default expectation is already set for the AuthClient.GetUsername method")
+	}
+
+	if len(mmGetUsername.expectations) > 0 {
+		mmGetUsername.mock.t.Fatalf("Some expectations are already set for the AuthClient.GetUsername method")
+	}
+
+	mmGetUsername.mock.funcGetUsername = f
+	return mmGetUsername.mock
+}
+
+// When sets expectation for the AuthClient.GetUsername which will trigger the result defined by the following
+// Then helper
+func (mmGetUsername *mAuthClientMockGetUsername) When(ctx context.Context, id int64) *AuthClientMockGetUsernameExpectation {
+	if mmGetUsername.mock.funcGetUsername != nil {
+		mmGetUsername.mock.t.Fatalf("AuthClientMock.GetUsername mock is already set by Set")
+	}
+
+	expectation := &AuthClientMockGetUsernameExpectation{
+		mock:   mmGetUsername.mock,
+		params: &AuthClientMockGetUsernameParams{ctx, id},
+	}
+	mmGetUsername.expectations = append(mmGetUsername.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthClient.GetUsername return parameters for the expectation previously defined by the When method
+func (e *AuthClientMockGetUsernameExpectation) Then(s1 string, err error) *AuthClientMock {
+	e.results = &AuthClientMockGetUsernameResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthClient.GetUsername should be invoked
+func (mmGetUsername *mAuthClientMockGetUsername) Times(n uint64) *mAuthClientMockGetUsername {
+	if n == 0 {
+		mmGetUsername.mock.t.Fatalf("Times of AuthClientMock.GetUsername mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUsername.expectedInvocations, n)
+	return mmGetUsername
+}
+
+func (mmGetUsername *mAuthClientMockGetUsername) invocationsDone() bool {
+	if len(mmGetUsername.expectations) == 0 && mmGetUsername.defaultExpectation == nil && mmGetUsername.mock.funcGetUsername == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUsername.mock.afterGetUsernameCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUsername.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUsername implements client.AuthClient
+func (mmGetUsername *AuthClientMock) GetUsername(ctx context.Context, id int64) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmGetUsername.beforeGetUsernameCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUsername.afterGetUsernameCounter, 1)
+
+	if mmGetUsername.inspectFuncGetUsername != nil {
+		mmGetUsername.inspectFuncGetUsername(ctx, id)
+	}
+
+	mm_params := AuthClientMockGetUsernameParams{ctx, id}
+
+	// Record call args
+	mmGetUsername.GetUsernameMock.mutex.Lock()
+	mmGetUsername.GetUsernameMock.callArgs = append(mmGetUsername.GetUsernameMock.callArgs, &mm_params)
+	mmGetUsername.GetUsernameMock.mutex.Unlock()
+
+	for _, e := range mmGetUsername.GetUsernameMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetUsername.GetUsernameMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUsername.GetUsernameMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUsername.GetUsernameMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUsername.GetUsernameMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthClientMockGetUsernameParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUsername.t.Errorf("AuthClientMock.GetUsername got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetUsername.t.Errorf("AuthClientMock.GetUsername got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUsername.t.Errorf("AuthClientMock.GetUsername got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUsername.GetUsernameMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUsername.t.Fatal("No results are set for the AuthClientMock.GetUsername")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetUsername.funcGetUsername != nil {
+		return mmGetUsername.funcGetUsername(ctx, id)
+	}
+	mmGetUsername.t.Fatalf("Unexpected call to AuthClientMock.GetUsername. %v %v", ctx, id)
+	return
+}
+
+// GetUsernameAfterCounter returns a count of finished AuthClientMock.GetUsername invocations
+func (mmGetUsername *AuthClientMock) GetUsernameAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUsername.afterGetUsernameCounter)
+}
+
+// GetUsernameBeforeCounter returns a count of AuthClientMock.GetUsername invocations
+func (mmGetUsername *AuthClientMock) GetUsernameBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUsername.beforeGetUsernameCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthClientMock.GetUsername.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUsername *mAuthClientMockGetUsername) Calls() []*AuthClientMockGetUsernameParams {
+	mmGetUsername.mutex.RLock()
+
+	argCopy := make([]*AuthClientMockGetUsernameParams, len(mmGetUsername.callArgs))
+	copy(argCopy, mmGetUsername.callArgs)
+
+	mmGetUsername.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUsernameDone returns true if the count of the GetUsername invocations corresponds
+// the number of defined expectations
+func (m *AuthClientMock) MinimockGetUsernameDone() bool {
+	if m.GetUsernameMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUsernameMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUsernameMock.invocationsDone()
+}
+
+// MinimockGetUsernameInspect logs each unmet expectation
+func (m *AuthClientMock) MinimockGetUsernameInspect() {
+	for _, e := range m.GetUsernameMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthClientMock.GetUsername with params: %#v", *e.params)
+		}
+	}
+
+	afterGetUsernameCounter := mm_atomic.LoadUint64(&m.afterGetUsernameCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUsernameMock.defaultExpectation != nil && afterGetUsernameCounter < 1 {
+		if m.GetUsernameMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuthClientMock.GetUsername")
+		} else {
+			m.t.Errorf("Expected call to AuthClientMock.GetUsername with params: %#v", *m.GetUsernameMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUsername != nil && afterGetUsernameCounter < 1 {
+		m.t.Error("Expected call to AuthClientMock.GetUsername")
+	}
+
+	if !m.GetUsernameMock.invocationsDone() && afterGetUsernameCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthClientMock.GetUsername but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUsernameMock.expectedInvocations), afterGetUsernameCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuthClientMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCheckAccessInspect()
+
+			m.MinimockCheckUsersExistInspect()
+
+			m.MinimockGetUsernameInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuthClientMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuthClientMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCheckAccessDone() &&
+		m.MinimockCheckUsersExistDone() &&
+		m.MinimockGetUsernameDone()
+}
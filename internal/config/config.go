@@ -1,91 +1,301 @@
 package config
 
 import (
+	"errors"
+	"flag"
 	"fmt"
 	"os"
+	"reflect"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 	"github.com/joho/godotenv"
 )
 
-const envPath = "./.env"
+const (
+	envPath = "./.env"
+	// fileEnv is the environment variable with the config file path, used unless the file flag is given.
+	fileEnv  = "CONFIG_FILE"
+	fileFlag = "config"
+)
+
+// Dev represents the configuration of the dev mode, in which the server needs no outside services: chats,
+// reports and API keys are kept in memory, the authentication service allows everything and spans aren't exported.
+type Dev struct {
+	Enabled bool `yaml:"enabled" toml:"enabled" env:"DEV_MODE" default:"false" flag:"dev"`
+}
 
 // DB represents the configuration for the database.
+// Read-only queries are spread over ReplicaHosts, given as host or host:port, which share the credentials
+// and the pool settings of the primary. A zero StatementTimeout leaves statements unlimited.
 type DB struct {
-	PostgresDB       string `env:"POSTGRES_DB" env-required:"true"`
-	PostgresUser     string `env:"POSTGRES_USER" env-required:"true"`
-	PostgresPassword string `env:"POSTGRES_PASSWORD" env-required:"true"`
-	Host             string `env:"DB_HOST" env-required:"true"`
-	Port             int    `env:"DB_PORT" env-required:"true"`
-	PostgresDSN      string `env:"-"`
+	PostgresDB       string        `yaml:"name" toml:"name" env:"POSTGRES_DB" required:"true"`
+	PostgresUser     string        `yaml:"user" toml:"user" env:"POSTGRES_USER" required:"true"`
+	PostgresPassword string        `yaml:"password" toml:"password" env:"POSTGRES_PASSWORD" required:"true" secret:"true"`
+	Host             string        `yaml:"host" toml:"host" env:"DB_HOST" default:"localhost"`
+	Port             int           `yaml:"port" toml:"port" env:"DB_PORT" default:"5432"`
+	SSLMode          string        `yaml:"ssl_mode" toml:"ssl_mode" env:"DB_SSL_MODE" default:"disable"`
+	SSLRootCert      string        `yaml:"ssl_root_cert" toml:"ssl_root_cert" env:"DB_SSL_ROOT_CERT"`
+	MaxConns         int           `yaml:"max_conns" toml:"max_conns" env:"DB_MAX_CONNS" default:"10"`
+	MinConns         int           `yaml:"min_conns" toml:"min_conns" env:"DB_MIN_CONNS" default:"0"`
+	MaxConnLifetime  time.Duration `yaml:"max_conn_lifetime" toml:"max_conn_lifetime" env:"DB_MAX_CONN_LIFETIME" default:"1h"`
+	MaxConnIdleTime  time.Duration `yaml:"max_conn_idle_time" toml:"max_conn_idle_time" env:"DB_MAX_CONN_IDLE_TIME" default:"30m"`
+	StatementTimeout time.Duration `yaml:"statement_timeout" toml:"statement_timeout" env:"DB_STATEMENT_TIMEOUT" default:"30s"`
+	ReplicaHosts     []string      `yaml:"replica_hosts" toml:"replica_hosts" env:"DB_REPLICA_HOSTS"`
+	PostgresDSN      string        `yaml:"-" toml:"-" env:"-"`
+	ReplicaDSNs      []string      `yaml:"-" toml:"-" env:"-"`
 }
 
 // GRPC represents the configuration for the gRPC server.
+// If WebEnabled is set, the server also accepts Connect and gRPC-Web requests on the same port, native gRPC
+// requests are then served through an HTTP server as well.
 type GRPC struct {
-	Host    string `env:"GRPC_HOST" env-required:"true"`
-	Port    int    `env:"GRPC_PORT" env-required:"true"`
-	Address string `env:"-"`
+	Host       string `yaml:"host" toml:"host" env:"GRPC_HOST" default:"0.0.0.0"`
+	Port       int    `yaml:"port" toml:"port" env:"GRPC_PORT" default:"50052"`
+	WebEnabled bool   `yaml:"web_enabled" toml:"web_enabled" env:"GRPC_WEB_ENABLED" default:"false"`
+	Address    string `yaml:"-" toml:"-" env:"-"`
+}
+
+// TLS represents the transport security of the gRPC server and of the connections to it made by the HTTP gateway
+// and the health check, which present the server certificate as their client certificate. With ClientAuth,
+// clients must present a certificate signed by a CA from CAFile (mutual TLS) having, unless AllowedSANs is empty,
+// one of the subject alternative names, and the HTTP gateway is disabled. The files are reloaded when they change, checked every ReloadInterval.
+// Insecure disables TLS for local development.
+type TLS struct {
+	Insecure       bool          `yaml:"insecure" toml:"insecure" env:"TLS_INSECURE" default:"false"`
+	CertFile       string        `yaml:"cert_file" toml:"cert_file" env:"TLS_CERT_FILE" default:"cert/service.pem"`
+	KeyFile        string        `yaml:"key_file" toml:"key_file" env:"TLS_KEY_FILE" default:"cert/service.key"`
+	CAFile         string        `yaml:"ca_file" toml:"ca_file" env:"TLS_CA_FILE" default:"cert/ca.cert"`
+	ClientAuth     bool          `yaml:"client_auth" toml:"client_auth" env:"TLS_CLIENT_AUTH" default:"false"`
+	AllowedSANs    []string      `yaml:"allowed_sans" toml:"allowed_sans" env:"TLS_ALLOWED_SANS"`
+	ReloadInterval time.Duration `yaml:"reload_interval" toml:"reload_interval" env:"TLS_RELOAD_INTERVAL" default:"30s"`
+}
+
+// HTTP represents the configuration for the HTTP/JSON gateway server.
+// Browsers may call the gateway, and the GRPC port serving gRPC-Web, with credentials from AllowedOrigins only,
+// other cross-origin requests are refused.
+type HTTP struct {
+	Host           string   `yaml:"host" toml:"host" env:"HTTP_HOST" default:"0.0.0.0"`
+	Port           int      `yaml:"port" toml:"port" env:"HTTP_PORT" default:"8082"`
+	AllowedOrigins []string `yaml:"cors_allowed_origins" toml:"cors_allowed_origins" env:"HTTP_CORS_ALLOWED_ORIGINS"`
+	Address        string   `yaml:"-" toml:"-" env:"-"`
+}
+
+// Metrics represents the configuration for the HTTP server exposing Prometheus metrics.
+type Metrics struct {
+	Host    string `yaml:"host" toml:"host" env:"METRICS_HOST" default:"0.0.0.0"`
+	Port    int    `yaml:"port" toml:"port" env:"METRICS_PORT" default:"2112"`
+	Address string `yaml:"-" toml:"-" env:"-"`
+}
+
+// Health represents the configuration for the dependency checks reported by the gRPC health service.
+type Health struct {
+	CheckInterval time.Duration `yaml:"check_interval" toml:"check_interval" env:"HEALTH_CHECK_INTERVAL" default:"10s"`
+	CheckTimeout  time.Duration `yaml:"check_timeout" toml:"check_timeout" env:"HEALTH_CHECK_TIMEOUT" default:"3s"`
+}
+
+// Deadline represents the default timeouts of requests sent without a deadline.
+// Methods map gRPC full method names to their timeouts, other methods get Default.
+type Deadline struct {
+	Default time.Duration            `yaml:"default" toml:"default" env:"REQUEST_TIMEOUT" default:"10s"`
+	Methods map[string]time.Duration `yaml:"methods" toml:"methods" env:"REQUEST_TIMEOUT_METHODS"`
+}
+
+// Shutdown represents the configuration for the graceful shutdown. Requests still running when the timeout
+// expires are cancelled.
+type Shutdown struct {
+	Timeout time.Duration `yaml:"timeout" toml:"timeout" env:"SHUTDOWN_TIMEOUT" default:"30s"`
+}
+
+// WebSocket represents the configuration for the WebSocket gateway served by the HTTP server.
+// SendBuffer limits the number of frames queued for a connection, a connection that doesn't keep up is closed.
+// Browsers may connect from AllowedOrigins only, the origins allowed by the HTTP gateway unless set.
+type WebSocket struct {
+	PingInterval   time.Duration `yaml:"ping_interval" toml:"ping_interval" env:"WS_PING_INTERVAL" default:"30s"`
+	PongTimeout    time.Duration `yaml:"pong_timeout" toml:"pong_timeout" env:"WS_PONG_TIMEOUT" default:"60s"`
+	WriteTimeout   time.Duration `yaml:"write_timeout" toml:"write_timeout" env:"WS_WRITE_TIMEOUT" default:"10s"`
+	SendBuffer     int           `yaml:"send_buffer" toml:"send_buffer" env:"WS_SEND_BUFFER" default:"64"`
+	MaxFrameSize   int64         `yaml:"max_frame_size" toml:"max_frame_size" env:"WS_MAX_FRAME_SIZE" default:"8192"`
+	AllowedOrigins []string      `yaml:"allowed_origins" toml:"allowed_origins" env:"WS_ALLOWED_ORIGINS"`
 }
 
 // Auth represents the configuration for the authentication server.
+// The server certificate is verified with CAFile unless Insecure disables TLS for local development.
+// Check results are cached for CacheTTL, denials for CacheNegativeTTL. Calls are limited by Timeout and made
+// up to RetryAttempts times, BreakerThreshold consecutive failures stop the calls for BreakerCooldown.
 type Auth struct {
-	Host    string `env:"AUTH_HOST" env-required:"true"`
-	Port    int    `env:"AUTH_PORT" env-required:"true"`
-	Address string `env:"-"`
+	Host               string        `yaml:"host" toml:"host" env:"AUTH_HOST" required:"true"`
+	Port               int           `yaml:"port" toml:"port" env:"AUTH_PORT" required:"true"`
+	Insecure           bool          `yaml:"insecure" toml:"insecure" env:"AUTH_INSECURE" default:"false"`
+	CAFile             string        `yaml:"ca_file" toml:"ca_file" env:"AUTH_TLS_CA_FILE" default:"cert/ca.cert"`
+	CacheEnabled       bool          `yaml:"cache_enabled" toml:"cache_enabled" env:"AUTH_CACHE_ENABLED" default:"true"`
+	CacheTTL           time.Duration `yaml:"cache_ttl" toml:"cache_ttl" env:"AUTH_CACHE_TTL" default:"30s"`
+	CacheNegativeTTL   time.Duration `yaml:"cache_negative_ttl" toml:"cache_negative_ttl" env:"AUTH_CACHE_NEGATIVE_TTL" default:"5s"`
+	CacheMaxEntries    int           `yaml:"cache_max_entries" toml:"cache_max_entries" env:"AUTH_CACHE_MAX_ENTRIES" default:"10000"`
+	Timeout            time.Duration `yaml:"timeout" toml:"timeout" env:"AUTH_TIMEOUT" default:"2s"`
+	RetryAttempts      int           `yaml:"retry_attempts" toml:"retry_attempts" env:"AUTH_RETRY_ATTEMPTS" default:"3"`
+	RetryBackoff       time.Duration `yaml:"retry_backoff" toml:"retry_backoff" env:"AUTH_RETRY_BACKOFF" default:"100ms"`
+	RetryMaxBackoff    time.Duration `yaml:"retry_max_backoff" toml:"retry_max_backoff" env:"AUTH_RETRY_MAX_BACKOFF" default:"1s"`
+	BreakerThreshold   int           `yaml:"breaker_threshold" toml:"breaker_threshold" env:"AUTH_BREAKER_THRESHOLD" default:"5"`
+	BreakerCooldown    time.Duration `yaml:"breaker_cooldown" toml:"breaker_cooldown" env:"AUTH_BREAKER_COOLDOWN" default:"10s"`
+	UsersCheckFailOpen bool          `yaml:"users_check_fail_open" toml:"users_check_fail_open" env:"AUTH_USERS_CHECK_FAIL_OPEN" default:"false"`
+	Address            string        `yaml:"-" toml:"-" env:"-"`
+}
+
+// Access represents the configuration of endpoint access checks. Mode is remote to ask the authentication service,
+// local to evaluate the policy file against the claims of access tokens verified with the public key or
+// the JWKS file, or both to require both to allow.
+type Access struct {
+	Mode          string `yaml:"mode" toml:"mode" env:"ACCESS_MODE" default:"remote"`
+	PolicyFile    string `yaml:"policy_file" toml:"policy_file" env:"ACCESS_POLICY_FILE"`
+	PublicKeyFile string `yaml:"jwt_public_key_file" toml:"jwt_public_key_file" env:"ACCESS_JWT_PUBLIC_KEY_FILE"`
+	JWKSFile      string `yaml:"jwks_file" toml:"jwks_file" env:"ACCESS_JWKS_FILE"`
 }
 
 // Logger represents configuration for logger.
+// AccessSampleRatio is the fraction of successful requests written to the access log, failed ones are always written.
 type Logger struct {
-	Level      string `env:"LOG_LEVEL" env-required:"true"`
-	Filename   string `env:"LOG_FILENAME" env-required:"true"`
-	MaxSizeMB  int    `env:"LOG_MAX_SIZE_MB" env-required:"true"`
-	MaxBackups int    `env:"LOG_MAX_BACKUPS" env-required:"true"`
-	MaxAgeDays int    `env:"LOG_MAX_AGE_DAYS" env-required:"true"`
+	Level             string  `yaml:"level" toml:"level" env:"LOG_LEVEL" default:"info"`
+	Filename          string  `yaml:"filename" toml:"filename" env:"LOG_FILENAME" default:"logs/app.log"`
+	MaxSizeMB         int     `yaml:"max_size_mb" toml:"max_size_mb" env:"LOG_MAX_SIZE_MB" default:"10"`
+	MaxBackups        int     `yaml:"max_backups" toml:"max_backups" env:"LOG_MAX_BACKUPS" default:"3"`
+	MaxAgeDays        int     `yaml:"max_age_days" toml:"max_age_days" env:"LOG_MAX_AGE_DAYS" default:"7"`
+	AccessSampleRatio float64 `yaml:"access_sample_ratio" toml:"access_sample_ratio" env:"LOG_ACCESS_SAMPLE_RATIO" default:"1"`
+}
+
+// Tracing represents the configuration for OpenTelemetry tracing.
+// Spans are exported to the OTLP collector at Endpoint over Protocol ("grpc" or "http"). SampleRatio is the
+// fraction of new traces sampled, spans of traces started by callers follow the caller's sampling decision.
+// ResourceAttributes are added to the resource describing the service in "<key>:<value>" format.
+type Tracing struct {
+	Endpoint           string            `yaml:"otlp_endpoint" toml:"otlp_endpoint" env:"TRACING_OTLP_ENDPOINT" default:"localhost:4317"`
+	Protocol           string            `yaml:"otlp_protocol" toml:"otlp_protocol" env:"TRACING_OTLP_PROTOCOL" default:"grpc"`
+	Insecure           bool              `yaml:"otlp_insecure" toml:"otlp_insecure" env:"TRACING_OTLP_INSECURE" default:"true"`
+	SampleRatio        float64           `yaml:"sample_ratio" toml:"sample_ratio" env:"TRACING_SAMPLE_RATIO" default:"1"`
+	ServiceName        string            `yaml:"service_name" toml:"service_name" env:"TRACING_SERVICE_NAME" default:"chat-server"`
+	Environment        string            `yaml:"environment" toml:"environment" env:"TRACING_ENVIRONMENT" default:"development"`
+	ResourceAttributes map[string]string `yaml:"resource_attributes" toml:"resource_attributes" env:"TRACING_RESOURCE_ATTRIBUTES"`
 }
 
-// Jaeger represents the configuration for the Jaeger server.
-type Jaeger struct {
-	Host    string `env:"JAEGER_HOST" env-required:"true"`
-	Port    int    `env:"JAEGER_PORT" env-required:"true"`
-	Address string `env:"-"`
+// RateLimit represents the configuration for request rate limiting.
+// Limits map gRPC full method names to token buckets in "<tokens per second>/<burst>" format.
+type RateLimit struct {
+	Enabled    bool              `yaml:"enabled" toml:"enabled" env:"RATE_LIMIT_ENABLED" default:"true"`
+	Store      string            `yaml:"store" toml:"store" env:"RATE_LIMIT_STORE" default:"memory"`
+	UserLimits map[string]string `yaml:"user" toml:"user" env:"RATE_LIMIT_USER"`
+	ChatLimits map[string]string `yaml:"chat" toml:"chat" env:"RATE_LIMIT_CHAT"`
+}
+
+// Moderation represents the configuration for outgoing messages moderation.
+// Moderation is disabled if no rules file is provided.
+type Moderation struct {
+	RulesFile string `yaml:"rules_file" toml:"rules_file" env:"MODERATION_RULES_FILE"`
 }
 
 // Config represents the overall application configuration.
 type Config struct {
-	DB     DB
-	GRPC   GRPC
-	Auth   Auth
-	Logger Logger
-	Jaeger Jaeger
+	Dev        Dev        `yaml:"dev" toml:"dev"`
+	DB         DB         `yaml:"db" toml:"db"`
+	GRPC       GRPC       `yaml:"grpc" toml:"grpc"`
+	TLS        TLS        `yaml:"tls" toml:"tls"`
+	HTTP       HTTP       `yaml:"http" toml:"http"`
+	Metrics    Metrics    `yaml:"metrics" toml:"metrics"`
+	Health     Health     `yaml:"health" toml:"health"`
+	Shutdown   Shutdown   `yaml:"shutdown" toml:"shutdown"`
+	Deadline   Deadline   `yaml:"deadline" toml:"deadline"`
+	Access     Access     `yaml:"access" toml:"access"`
+	WebSocket  WebSocket  `yaml:"websocket" toml:"websocket"`
+	Auth       Auth       `yaml:"auth" toml:"auth"`
+	Logger     Logger     `yaml:"logger" toml:"logger"`
+	Tracing    Tracing    `yaml:"tracing" toml:"tracing"`
+	RateLimit  RateLimit  `yaml:"rate_limit" toml:"rate_limit"`
+	Moderation Moderation `yaml:"moderation" toml:"moderation"`
 }
 
-// Load reads configuration from .env file.
-func Load() (*Config, error) {
-	if _, err := os.Stat(envPath); os.IsNotExist(err) {
-		return nil, fmt.Errorf(".env file does not exist in project's root")
+// Load reads the configuration in layers, each overriding the previous one: defaults, the YAML or TOML file
+// given by the -config flag or CONFIG_FILE, environment variables (also read from ./.env if it exists)
+// and command-line flags named after the variables, e.g. -grpc-port for GRPC_PORT. The result is validated.
+func Load(args []string) (*Config, error) {
+	if _, err := os.Stat(envPath); err == nil {
+		if err = godotenv.Load(envPath); err != nil {
+			return nil, fmt.Errorf("error loading .env file: %w", err)
+		}
 	}
 
-	if err := godotenv.Load(envPath); err != nil {
-		return nil, fmt.Errorf("error loading .env file: %w", err)
+	var cfg Config
+	if err := setDefaults(&cfg); err != nil {
+		return nil, err
 	}
 
-	var cfg Config
-	if err := cleanenv.ReadEnv(&cfg); err != nil {
-		return nil, fmt.Errorf("cannot read config from environment variables: %w", err)
+	path, err := parseFlags(&cfg, args)
+	if err != nil {
+		return nil, err
 	}
 
-	cfg.DB.PostgresDSN = fmt.Sprintf(
-		"host=%s port=%d dbname=%s user=%s password=%s sslmode=disable",
-		cfg.DB.Host,
-		cfg.DB.Port,
-		cfg.DB.PostgresDB,
-		cfg.DB.PostgresUser,
-		cfg.DB.PostgresPassword,
-	)
+	if path != "" {
+		err = cleanenv.ReadConfig(path, &cfg)
+	} else {
+		err = cleanenv.ReadEnv(&cfg)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read config: %w", err)
+	}
+
+	cfg.DB.PostgresDSN = cfg.DB.dsn(cfg.DB.Host, cfg.DB.Port)
+	for _, replica := range cfg.DB.ReplicaHosts {
+		// malformed hosts are reported by the validation
+		if host, port, errReplica := splitHostPort(replica, cfg.DB.Port); errReplica == nil {
+			cfg.DB.ReplicaDSNs = append(cfg.DB.ReplicaDSNs, cfg.DB.dsn(host, port))
+		}
+	}
+
+	if len(cfg.WebSocket.AllowedOrigins) == 0 {
+		cfg.WebSocket.AllowedOrigins = cfg.HTTP.AllowedOrigins
+	}
 
 	cfg.GRPC.Address = fmt.Sprintf("%s:%d", cfg.GRPC.Host, cfg.GRPC.Port)
+	cfg.HTTP.Address = fmt.Sprintf("%s:%d", cfg.HTTP.Host, cfg.HTTP.Port)
+	cfg.Metrics.Address = fmt.Sprintf("%s:%d", cfg.Metrics.Host, cfg.Metrics.Port)
 	cfg.Auth.Address = fmt.Sprintf("%s:%d", cfg.Auth.Host, cfg.Auth.Port)
-	cfg.Jaeger.Address = fmt.Sprintf("%s:%d", cfg.Jaeger.Host, cfg.Jaeger.Port)
+
+	if err = cfg.Validate(); err != nil {
+		return nil, err
+	}
 
 	return &cfg, nil
 }
+
+// parseFlags parses the command-line flags and returns the config file path. Flags are applied as the environment
+// variables they are named after, so they are parsed the same way and take precedence over the config file.
+func parseFlags(cfg *Config, args []string) (string, error) {
+	fs := flag.NewFlagSet("chat_server", flag.ContinueOnError)
+	path := fs.String(fileFlag, os.Getenv(fileEnv), "YAML or TOML config file, overrides "+fileEnv)
+
+	options := fields(cfg)
+	for _, f := range options {
+		usage := fmt.Sprintf("%s, overrides %s", f.key, f.env)
+		if f.value.Kind() == reflect.Bool {
+			fs.Bool(f.flag, false, usage)
+			continue
+		}
+		fs.String(f.flag, "", usage)
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+	if fs.NArg() > 0 {
+		return "", fmt.Errorf("unexpected arguments: %v", fs.Args())
+	}
+
+	var errs []error
+	fs.Visit(func(fl *flag.Flag) {
+		for _, f := range options {
+			if f.flag == fl.Name {
+				errs = append(errs, os.Setenv(f.env, fl.Value.String()))
+			}
+		}
+	})
+
+	return *path, errors.Join(errs...)
+}
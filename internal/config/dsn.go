@@ -0,0 +1,72 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// dsnEscaper quotes values of the keyword/value connection string.
+var dsnEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+type dsnParam struct {
+	key   string
+	value string
+}
+
+// dsn returns the keyword/value connection string of the database server at host:port. The pool settings
+// are read by the pgx pool, the statement timeout is sent as a session parameter.
+func (c DB) dsn(host string, port int) string {
+	params := []dsnParam{
+		{"host", host},
+		{"port", strconv.Itoa(port)},
+		{"dbname", c.PostgresDB},
+		{"user", c.PostgresUser},
+		{"password", c.PostgresPassword},
+		{"sslmode", c.SSLMode},
+		{"sslrootcert", c.SSLRootCert},
+		{"pool_max_conns", strconv.Itoa(c.MaxConns)},
+		{"pool_min_conns", strconv.Itoa(c.MinConns)},
+		{"pool_max_conn_lifetime", c.MaxConnLifetime.String()},
+		{"pool_max_conn_idle_time", c.MaxConnIdleTime.String()},
+	}
+	if c.StatementTimeout > 0 {
+		params = append(params, dsnParam{"statement_timeout", strconv.FormatInt(c.StatementTimeout.Milliseconds(), 10)})
+	}
+
+	parts := make([]string, 0, len(params))
+	for _, p := range params {
+		if p.value != "" {
+			parts = append(parts, fmt.Sprintf("%s='%s'", p.key, dsnEscaper.Replace(p.value)))
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// splitHostPort splits host[:port], using the default port if none is given.
+func splitHostPort(address string, defaultPort int) (string, int, error) {
+	if !strings.Contains(address, ":") || strings.HasSuffix(address, "]") {
+		host := strings.Trim(address, "[]")
+		if host == "" {
+			return "", 0, fmt.Errorf("missing host in %q", address)
+		}
+		return host, defaultPort, nil
+	}
+
+	host, portStr, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", 0, err
+	}
+	if host == "" {
+		return "", 0, fmt.Errorf("missing host in %q", address)
+	}
+
+	port, err := strconv.Atoi(portStr)
+	if err != nil || port < 1 || port > 65535 {
+		return "", 0, fmt.Errorf("invalid port in %q", address)
+	}
+
+	return host, port, nil
+}
@@ -0,0 +1,115 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// field describes a configuration option, addressed by key in config files, by env in the environment
+// and by flag on the command line, named after env unless the flag tag names it. The option is set to def
+// before the sources are read.
+type field struct {
+	key      string
+	env      string
+	flag     string
+	def      string
+	required bool
+	secret   bool
+	value    reflect.Value
+}
+
+// fields returns the options of the configuration sections in declaration order. Derived values not read
+// from any source are skipped.
+func fields(cfg *Config) []field {
+	var res []field
+
+	sections := reflect.ValueOf(cfg).Elem()
+	for i := 0; i < sections.NumField(); i++ {
+		section := sections.Field(i)
+		sectionKey := sections.Type().Field(i).Tag.Get("yaml")
+
+		for j := 0; j < section.NumField(); j++ {
+			sf := section.Type().Field(j)
+			env := sf.Tag.Get("env")
+			if env == "" || env == "-" {
+				continue
+			}
+
+			flag := sf.Tag.Get("flag")
+			if flag == "" {
+				flag = strings.ToLower(strings.ReplaceAll(env, "_", "-"))
+			}
+
+			res = append(res, field{
+				key:      sectionKey + "." + sf.Tag.Get("yaml"),
+				env:      env,
+				flag:     flag,
+				def:      sf.Tag.Get("default"),
+				required: sf.Tag.Get("required") == "true",
+				secret:   sf.Tag.Get("secret") == "true",
+				value:    section.Field(j),
+			})
+		}
+	}
+
+	return res
+}
+
+// setDefaults sets the options to their defaults. The defaults are set before the config file is read, so unlike
+// the env-default of cleanenv, applied to the options still zero after it, they don't override false and zero
+// values set in the file.
+func setDefaults(cfg *Config) error {
+	for _, f := range fields(cfg) {
+		if f.def == "" {
+			continue
+		}
+
+		if err := parseDefault(f.value, f.def); err != nil {
+			return fmt.Errorf("invalid default %q of %s: %w", f.def, f.env, err)
+		}
+	}
+
+	return nil
+}
+
+// parseDefault sets the option of a scalar type to the value parsed from the default.
+func parseDefault(value reflect.Value, def string) error {
+	if value.Type() == reflect.TypeOf(time.Duration(0)) {
+		d, err := time.ParseDuration(def)
+		if err != nil {
+			return err
+		}
+		value.SetInt(int64(d))
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(def)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(def)
+		if err != nil {
+			return err
+		}
+		value.SetBool(b)
+	case reflect.Int, reflect.Int64:
+		i, err := strconv.ParseInt(def, 10, 64)
+		if err != nil {
+			return err
+		}
+		value.SetInt(i)
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(def, 64)
+		if err != nil {
+			return err
+		}
+		value.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type %s", value.Type())
+	}
+
+	return nil
+}
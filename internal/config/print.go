@@ -0,0 +1,28 @@
+package config
+
+import (
+	"io"
+	"reflect"
+
+	"gopkg.in/yaml.v3"
+)
+
+const secretMask = "******"
+
+// Print writes the configuration in the YAML config file format with the secrets masked.
+func (c *Config) Print(w io.Writer) error {
+	masked := *c
+	for _, f := range fields(&masked) {
+		if f.secret && !f.value.IsZero() {
+			f.value.Set(reflect.ValueOf(secretMask))
+		}
+	}
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(&masked); err != nil {
+		return err
+	}
+
+	return enc.Close()
+}
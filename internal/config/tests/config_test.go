@@ -0,0 +1,283 @@
+package tests
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/mikhailsoldatkin/chat-server/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+const yamlConfig = `
+db:
+  name: chat
+  user: chat
+  password: %s
+auth:
+  host: auth.local
+  port: 50051
+  timeout: 3s
+deadline:
+  methods:
+    /chat_v1.ChatV1/ListReports: 30s
+`
+
+const tomlConfig = `
+[db]
+name = "chat"
+user = "chat"
+password = "%s"
+
+[auth]
+host = "auth.local"
+port = 50051
+timeout = "3s"
+
+[deadline.methods]
+"/chat_v1.ChatV1/ListReports" = "30s"
+`
+
+// writeConfig writes a config file in the format of the extension with the given password
+// and returns its path.
+func writeConfig(t *testing.T, ext, format, password string) string {
+	path := filepath.Join(t.TempDir(), "config"+ext)
+	require.NoError(t, os.WriteFile(path, []byte(fmt.Sprintf(format, password)), 0o600))
+	return path
+}
+
+// Tests setting environment variables can't run in parallel.
+
+func TestLoad(t *testing.T) {
+	password := gofakeit.Password(true, true, true, false, false, 16)
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{name: "yaml", path: writeConfig(t, ".yaml", yamlConfig, password)},
+		{name: "toml", path: writeConfig(t, ".toml", tomlConfig, password)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("CONFIG_FILE", tt.path)
+			t.Setenv("AUTH_PORT", "50061")
+			// flags are applied as environment variables, registering them restores them after the test
+			t.Setenv("GRPC_PORT", "50062")
+			t.Setenv("LOG_LEVEL", "info")
+			t.Setenv("DB_REPLICA_HOSTS", "replica1,replica2:5433")
+			t.Setenv("HTTP_CORS_ALLOWED_ORIGINS", "https://chat.example.com")
+
+			cfg, err := config.Load([]string{"-grpc-port", "50072", "-log-level=warn"})
+			require.NoError(t, err)
+
+			// the file
+			require.Equal(t, "chat", cfg.DB.PostgresDB)
+			require.Equal(t, password, cfg.DB.PostgresPassword)
+			require.Equal(t, 3*time.Second, cfg.Auth.Timeout)
+			require.Equal(t, map[string]time.Duration{"/chat_v1.ChatV1/ListReports": 30 * time.Second}, cfg.Deadline.Methods)
+			// the environment over the file
+			require.Equal(t, 50061, cfg.Auth.Port)
+			require.Equal(t, "auth.local:50061", cfg.Auth.Address)
+			// flags over the environment
+			require.Equal(t, 50072, cfg.GRPC.Port)
+			require.Equal(t, "warn", cfg.Logger.Level)
+			// defaults
+			require.Equal(t, 8082, cfg.HTTP.Port)
+			require.Equal(t, 5432, cfg.DB.Port)
+			require.Equal(t, "remote", cfg.Access.Mode)
+			// derived
+			require.Contains(t, cfg.DB.PostgresDSN, "host='localhost' port='5432' dbname='chat'")
+			require.Contains(t, cfg.DB.PostgresDSN, "sslmode='disable'")
+			require.Contains(t, cfg.DB.PostgresDSN, "pool_max_conns='10'")
+			require.Contains(t, cfg.DB.PostgresDSN, "statement_timeout='30000'")
+			require.Len(t, cfg.DB.ReplicaDSNs, 2)
+			require.Contains(t, cfg.DB.ReplicaDSNs[0], "host='replica1' port='5432'")
+			require.Contains(t, cfg.DB.ReplicaDSNs[1], "host='replica2' port='5433'")
+			require.Equal(t, []string{"https://chat.example.com"}, cfg.WebSocket.AllowedOrigins)
+		})
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	path := writeConfig(t, ".yaml", yamlConfig, gofakeit.Password(true, true, true, false, false, 16))
+
+	tests := []struct {
+		name string
+		env  map[string]string
+		args []string
+		err  string
+	}{
+		{
+			name: "required options missing",
+			args: nil,
+			err:  "POSTGRES_DB (db.name) is required",
+		},
+		{
+			name: "unknown flag",
+			args: []string{"-config", path, "-unknown", "1"},
+			err:  "flag provided but not defined: -unknown",
+		},
+		{
+			name: "unsupported file format",
+			args: []string{"-config", "config.ini"},
+			err:  "cannot read config",
+		},
+		{
+			name: "invalid value",
+			args: []string{"-config", path, "-log-level", "verbose"},
+			err:  "LOG_LEVEL",
+		},
+		{
+			name: "inconsistent options",
+			env:  map[string]string{"AUTH_RETRY_BACKOFF": "2s", "AUTH_RETRY_MAX_BACKOFF": "1s"},
+			args: []string{"-config", path},
+			err:  "AUTH_RETRY_BACKOFF (2s) must not exceed AUTH_RETRY_MAX_BACKOFF (1s)",
+		},
+		{
+			name: "access mode without policy",
+			env:  map[string]string{"ACCESS_MODE": "local"},
+			args: []string{"-config", path},
+			err:  "ACCESS_POLICY_FILE is required when ACCESS_MODE is local",
+		},
+		{
+			name: "verified TLS without CA",
+			env:  map[string]string{"DB_SSL_MODE": "verify-full"},
+			args: []string{"-config", path},
+			err:  "DB_SSL_ROOT_CERT is required when DB_SSL_MODE is verify-full",
+		},
+		{
+			name: "malformed replica host",
+			env:  map[string]string{"DB_REPLICA_HOSTS": "replica:port"},
+			args: []string{"-config", path},
+			err:  "DB_REPLICA_HOSTS must contain host or host:port",
+		},
+		{
+			name: "mutual TLS in insecure mode",
+			env:  map[string]string{"TLS_INSECURE": "true", "TLS_CLIENT_AUTH": "true"},
+			args: []string{"-config", path},
+			err:  "TLS_CLIENT_AUTH can't be set when TLS_INSECURE is",
+		},
+		{
+			name: "allowed SANs without mutual TLS",
+			env:  map[string]string{"TLS_ALLOWED_SANS": "gateway.chat.svc"},
+			args: []string{"-config", path},
+			err:  "TLS_ALLOWED_SANS requires TLS_CLIENT_AUTH",
+		},
+		{
+			name: "zero port",
+			env:  map[string]string{"METRICS_PORT": "0"},
+			args: []string{"-config", path},
+			err:  "METRICS_PORT must be between 1 and 65535, got 0",
+		},
+		{
+			name: "any CORS origin",
+			env:  map[string]string{"HTTP_CORS_ALLOWED_ORIGINS": "https://chat.example.com,*"},
+			args: []string{"-config", path},
+			err:  "HTTP_CORS_ALLOWED_ORIGINS must list the origins",
+		},
+		{
+			name: "any WebSocket origin",
+			env:  map[string]string{"WS_ALLOWED_ORIGINS": "*"},
+			args: []string{"-config", path},
+			err:  "WS_ALLOWED_ORIGINS must list the origins",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("LOG_LEVEL", "info")
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			_, err := config.Load(tt.args)
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestLoadZeroValues(t *testing.T) {
+	const zeroConfig = `
+db:
+  name: chat
+  user: chat
+  password: %s
+  statement_timeout: 0s
+auth:
+  host: auth.local
+  port: 50051
+  cache_enabled: false
+logger:
+  access_sample_ratio: 0
+tracing:
+  otlp_insecure: false
+  sample_ratio: 0
+rate_limit:
+  enabled: false
+`
+
+	t.Setenv("LOG_LEVEL", "info")
+	path := writeConfig(t, ".yaml", zeroConfig, gofakeit.Password(true, true, true, false, false, 16))
+
+	cfg, err := config.Load([]string{"-config", path})
+	require.NoError(t, err)
+
+	// false and zero values of the file are not replaced by the defaults
+	require.False(t, cfg.RateLimit.Enabled)
+	require.False(t, cfg.Auth.CacheEnabled)
+	require.False(t, cfg.Tracing.Insecure)
+	require.Zero(t, cfg.DB.StatementTimeout)
+	require.NotContains(t, cfg.DB.PostgresDSN, "statement_timeout")
+	require.Zero(t, cfg.Logger.AccessSampleRatio)
+	require.Zero(t, cfg.Tracing.SampleRatio)
+	// options missing from the file keep the defaults
+	require.Equal(t, 10, cfg.DB.MaxConns)
+
+	// the environment is still read over the file
+	t.Setenv("RATE_LIMIT_ENABLED", "true")
+	cfg, err = config.Load([]string{"-config", path})
+	require.NoError(t, err)
+	require.True(t, cfg.RateLimit.Enabled)
+}
+
+func TestLoadDev(t *testing.T) {
+	t.Setenv("LOG_LEVEL", "info")
+	// flags are applied as environment variables, registering it restores it after the test
+	t.Setenv("DEV_MODE", "false")
+
+	cfg, err := config.Load([]string{"--dev"})
+	require.NoError(t, err, "the database and the authentication service options aren't required in dev mode")
+	require.True(t, cfg.Dev.Enabled)
+
+	t.Setenv("RATE_LIMIT_STORE", "postgres")
+	_, err = config.Load([]string{"--dev"})
+	require.ErrorContains(t, err, "RATE_LIMIT_STORE must be memory in dev mode")
+}
+
+func TestPrint(t *testing.T) {
+	password := gofakeit.Password(true, true, true, false, false, 16)
+
+	cfg, err := config.Load([]string{"-config", writeConfig(t, ".yaml", yamlConfig, password)})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, cfg.Print(&buf))
+
+	require.NotContains(t, buf.String(), password)
+	require.Contains(t, buf.String(), "password: '******'")
+	require.Contains(t, buf.String(), "timeout: 3s")
+	require.Equal(t, password, cfg.DB.PostgresPassword, "the config itself must not be masked")
+
+	// the printed config is a valid config file
+	printed := filepath.Join(t.TempDir(), "printed.yaml")
+	require.NoError(t, os.WriteFile(printed, buf.Bytes(), 0o600))
+	reloaded, err := config.Load([]string{"-config", printed})
+	require.NoError(t, err)
+	require.Equal(t, cfg.Auth, reloaded.Auth)
+}
@@ -0,0 +1,175 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// Accepted values of the options validated against a fixed set, the access modes match the ones
+// of the access client.
+var (
+	sslModes         = []string{"disable", "allow", "prefer", "require", "verify-ca", "verify-full"}
+	accessModes      = []string{"remote", "local", "both"}
+	tracingProtocols = []string{"grpc", "http"}
+	rateLimitStores  = []string{"memory", "postgres"}
+)
+
+// Validate checks the options and the consistency between them, returning all the problems found.
+// Options are named by their environment variables.
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	// the required options are the ones of the database and the authentication service, unused in dev mode
+	for _, f := range fields(c) {
+		check(c.Dev.Enabled || !f.required || !f.value.IsZero(), "%s (%s) is required", f.env, f.key)
+	}
+	check(
+		!c.Dev.Enabled || c.RateLimit.Store == "memory",
+		"RATE_LIMIT_STORE must be memory in dev mode, got %q", c.RateLimit.Store,
+	)
+
+	type port struct {
+		env   string
+		value int
+	}
+	ports := []port{
+		{"DB_PORT", c.DB.Port},
+		{"GRPC_PORT", c.GRPC.Port},
+		{"HTTP_PORT", c.HTTP.Port},
+		{"METRICS_PORT", c.Metrics.Port},
+	}
+	// a missing AUTH_PORT is reported above, the port is unused in dev mode
+	if c.Auth.Port != 0 {
+		ports = append(ports, port{"AUTH_PORT", c.Auth.Port})
+	}
+	for _, p := range ports {
+		check(p.value >= 1 && p.value <= 65535, "%s must be between 1 and 65535, got %d", p.env, p.value)
+	}
+	check(c.GRPC.Port != c.HTTP.Port, "GRPC_PORT and HTTP_PORT must differ, both are %d", c.GRPC.Port)
+	check(c.GRPC.Port != c.Metrics.Port, "GRPC_PORT and METRICS_PORT must differ, both are %d", c.GRPC.Port)
+	check(c.HTTP.Port != c.Metrics.Port, "HTTP_PORT and METRICS_PORT must differ, both are %d", c.HTTP.Port)
+
+	check(slices.Contains(sslModes, c.DB.SSLMode), "DB_SSL_MODE must be one of %v, got %q", sslModes, c.DB.SSLMode)
+	check(
+		c.DB.SSLRootCert != "" || (c.DB.SSLMode != "verify-ca" && c.DB.SSLMode != "verify-full"),
+		"DB_SSL_ROOT_CERT is required when DB_SSL_MODE is %s", c.DB.SSLMode,
+	)
+	check(c.DB.MaxConns > 0, "DB_MAX_CONNS must be positive")
+	check(
+		c.DB.MinConns >= 0 && c.DB.MinConns <= c.DB.MaxConns,
+		"DB_MIN_CONNS must be between 0 and DB_MAX_CONNS (%d), got %d", c.DB.MaxConns, c.DB.MinConns,
+	)
+	check(c.DB.MaxConnLifetime >= 0, "DB_MAX_CONN_LIFETIME must not be negative")
+	check(c.DB.MaxConnIdleTime >= 0, "DB_MAX_CONN_IDLE_TIME must not be negative")
+	check(c.DB.StatementTimeout >= 0, "DB_STATEMENT_TIMEOUT must not be negative")
+	for _, replica := range c.DB.ReplicaHosts {
+		_, _, err := splitHostPort(replica, c.DB.Port)
+		check(err == nil, "DB_REPLICA_HOSTS must contain host or host:port, %v", err)
+	}
+
+	if c.TLS.Insecure {
+		check(!c.TLS.ClientAuth, "TLS_CLIENT_AUTH can't be set when TLS_INSECURE is")
+	} else {
+		check(c.TLS.CertFile != "", "TLS_CERT_FILE is required unless TLS_INSECURE is set")
+		check(c.TLS.KeyFile != "", "TLS_KEY_FILE is required unless TLS_INSECURE is set")
+		check(c.TLS.CAFile != "", "TLS_CA_FILE is required unless TLS_INSECURE is set")
+	}
+	check(len(c.TLS.AllowedSANs) == 0 || c.TLS.ClientAuth, "TLS_ALLOWED_SANS requires TLS_CLIENT_AUTH")
+	check(c.TLS.ReloadInterval >= 0, "TLS_RELOAD_INTERVAL must not be negative")
+
+	check(
+		!slices.Contains(c.HTTP.AllowedOrigins, "*"),
+		"HTTP_CORS_ALLOWED_ORIGINS must list the origins, * can't be allowed to send credentials",
+	)
+	check(
+		!slices.Contains(c.WebSocket.AllowedOrigins, "*"),
+		"WS_ALLOWED_ORIGINS must list the origins, * can't be allowed to connect with credentials",
+	)
+
+	check(c.Health.CheckInterval > 0, "HEALTH_CHECK_INTERVAL must be positive")
+	check(c.Health.CheckTimeout > 0, "HEALTH_CHECK_TIMEOUT must be positive")
+	check(
+		c.Health.CheckTimeout <= c.Health.CheckInterval,
+		"HEALTH_CHECK_TIMEOUT (%s) must not exceed HEALTH_CHECK_INTERVAL (%s)",
+		c.Health.CheckTimeout, c.Health.CheckInterval,
+	)
+
+	check(c.Deadline.Default >= 0, "REQUEST_TIMEOUT must not be negative")
+	for method, timeout := range c.Deadline.Methods {
+		check(timeout > 0, "REQUEST_TIMEOUT_METHODS timeout of %s must be positive", method)
+	}
+
+	check(c.Shutdown.Timeout > 0, "SHUTDOWN_TIMEOUT must be positive")
+
+	check(c.WebSocket.PingInterval > 0, "WS_PING_INTERVAL must be positive")
+	check(
+		c.WebSocket.PongTimeout > c.WebSocket.PingInterval,
+		"WS_PONG_TIMEOUT (%s) must exceed WS_PING_INTERVAL (%s)",
+		c.WebSocket.PongTimeout, c.WebSocket.PingInterval,
+	)
+	check(c.WebSocket.WriteTimeout > 0, "WS_WRITE_TIMEOUT must be positive")
+	check(c.WebSocket.SendBuffer > 0, "WS_SEND_BUFFER must be positive")
+	check(c.WebSocket.MaxFrameSize > 0, "WS_MAX_FRAME_SIZE must be positive")
+
+	check(c.Auth.Insecure || c.Auth.CAFile != "", "AUTH_TLS_CA_FILE is required unless AUTH_INSECURE is set")
+	check(c.Auth.Timeout > 0, "AUTH_TIMEOUT must be positive")
+	check(c.Auth.RetryAttempts >= 1, "AUTH_RETRY_ATTEMPTS must be at least 1")
+	check(
+		c.Auth.RetryBackoff <= c.Auth.RetryMaxBackoff,
+		"AUTH_RETRY_BACKOFF (%s) must not exceed AUTH_RETRY_MAX_BACKOFF (%s)",
+		c.Auth.RetryBackoff, c.Auth.RetryMaxBackoff,
+	)
+	check(c.Auth.BreakerCooldown > 0, "AUTH_BREAKER_COOLDOWN must be positive")
+	if c.Auth.CacheEnabled {
+		check(c.Auth.CacheTTL > 0, "AUTH_CACHE_TTL must be positive when AUTH_CACHE_ENABLED is set")
+		check(
+			c.Auth.CacheNegativeTTL <= c.Auth.CacheTTL,
+			"AUTH_CACHE_NEGATIVE_TTL (%s) must not exceed AUTH_CACHE_TTL (%s)",
+			c.Auth.CacheNegativeTTL, c.Auth.CacheTTL,
+		)
+		check(c.Auth.CacheMaxEntries > 0, "AUTH_CACHE_MAX_ENTRIES must be positive when AUTH_CACHE_ENABLED is set")
+	}
+
+	check(slices.Contains(accessModes, c.Access.Mode), "ACCESS_MODE must be one of %v, got %q", accessModes, c.Access.Mode)
+	if c.Access.Mode == "local" || c.Access.Mode == "both" {
+		check(c.Access.PolicyFile != "", "ACCESS_POLICY_FILE is required when ACCESS_MODE is %s", c.Access.Mode)
+		check(
+			c.Access.PublicKeyFile != "" || c.Access.JWKSFile != "",
+			"ACCESS_JWT_PUBLIC_KEY_FILE or ACCESS_JWKS_FILE is required when ACCESS_MODE is %s", c.Access.Mode,
+		)
+	}
+
+	if _, err := zapcore.ParseLevel(c.Logger.Level); err != nil {
+		errs = append(errs, fmt.Errorf("LOG_LEVEL: %w", err))
+	}
+	check(ratio(c.Logger.AccessSampleRatio), "LOG_ACCESS_SAMPLE_RATIO must be between 0 and 1")
+
+	check(
+		slices.Contains(tracingProtocols, c.Tracing.Protocol),
+		"TRACING_OTLP_PROTOCOL must be one of %v, got %q", tracingProtocols, c.Tracing.Protocol,
+	)
+	check(ratio(c.Tracing.SampleRatio), "TRACING_SAMPLE_RATIO must be between 0 and 1")
+
+	check(
+		slices.Contains(rateLimitStores, c.RateLimit.Store),
+		"RATE_LIMIT_STORE must be one of %v, got %q", rateLimitStores, c.RateLimit.Store,
+	)
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("invalid config:\n%w", err)
+	}
+
+	return nil
+}
+
+func ratio(value float64) bool {
+	return value >= 0 && value <= 1
+}
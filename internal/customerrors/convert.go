@@ -1,23 +1,168 @@
 package customerrors
 
 import (
+	"context"
 	"errors"
+	"strconv"
 
+	"github.com/mikhailsoldatkin/chat-server/internal/logger"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
 )
 
-// ConvertError converts an error into a gRPC error with the appropriate status code.
+// Domain is the ErrorInfo domain of errors returned by the chat server.
+const Domain = "chat-server"
+
+// ErrorInfo reasons attached to the errors returned by the chat server.
+const (
+	ReasonNotFound           = "NOT_FOUND"
+	ReasonUserNotInChat      = "USER_NOT_IN_CHAT"
+	ReasonMessageRejected    = "MESSAGE_REJECTED"
+	ReasonReportResolved     = "REPORT_RESOLVED"
+	ReasonAlreadyExists      = "ALREADY_EXISTS"
+	ReasonPermissionDenied   = "PERMISSION_DENIED"
+	ReasonFailedPrecondition = "FAILED_PRECONDITION"
+	ReasonInvalidArgument    = "INVALID_ARGUMENT"
+	ReasonUnauthenticated    = "UNAUTHENTICATED"
+	ReasonUnavailable        = "UNAVAILABLE"
+)
+
+const internalErrorMessage = "internal error"
+
+// grpcStatus is implemented by errors carrying a gRPC status, e.g. the ones returned by gRPC clients.
+type grpcStatus interface {
+	GRPCStatus() *status.Status
+}
+
+// ConvertError converts an error into a gRPC error with the appropriate status code and
+// google.rpc.ErrorInfo / google.rpc.ResourceInfo details. Status codes of errors returned by other
+// gRPC services are preserved. Errors unknown to the chat server are logged and returned to the
+// client as Internal without the original message.
 func ConvertError(err error) error {
+	if err == nil {
+		return nil
+	}
+
 	var notFoundErr *NotFoundError
 	var userNotInChatErr *UserNotInChatError
+	var moderationErr *ModerationError
+	var reportResolvedErr *ReportResolvedError
+	var alreadyExistsErr *AlreadyExistsError
+	var permissionDeniedErr *PermissionDeniedError
+	var failedPreconditionErr *FailedPreconditionError
+	var invalidArgumentErr *InvalidArgumentError
+	var unauthenticatedErr *UnauthenticatedError
+	var unavailableErr *UnavailableError
+	var statusErr grpcStatus
 
 	switch {
 	case errors.As(err, &notFoundErr):
-		return status.Errorf(codes.NotFound, notFoundErr.Error())
+		return newStatus(
+			codes.NotFound, notFoundErr.Error(),
+			errorInfo(ReasonNotFound, "entity", notFoundErr.Entity, "id", strconv.FormatInt(notFoundErr.ID, 10)),
+			resourceInfo(notFoundErr.Entity, strconv.FormatInt(notFoundErr.ID, 10), notFoundErr.Error()),
+		)
 	case errors.As(err, &userNotInChatErr):
-		return status.Errorf(codes.NotFound, userNotInChatErr.Error())
+		return newStatus(
+			codes.NotFound, userNotInChatErr.Error(),
+			errorInfo(
+				ReasonUserNotInChat,
+				"user_id", strconv.FormatInt(userNotInChatErr.UserID, 10),
+				"chat_id", strconv.FormatInt(userNotInChatErr.ChatID, 10),
+			),
+			resourceInfo("chat", strconv.FormatInt(userNotInChatErr.ChatID, 10), userNotInChatErr.Error()),
+		)
+	case errors.As(err, &moderationErr):
+		return newStatus(
+			codes.InvalidArgument, moderationErr.Error(),
+			errorInfo(ReasonMessageRejected, "filter", moderationErr.Filter),
+		)
+	case errors.As(err, &reportResolvedErr):
+		return newStatus(
+			codes.FailedPrecondition, reportResolvedErr.Error(),
+			errorInfo(ReasonReportResolved, "id", strconv.FormatInt(reportResolvedErr.ID, 10)),
+			resourceInfo("report", strconv.FormatInt(reportResolvedErr.ID, 10), reportResolvedErr.Error()),
+		)
+	case errors.As(err, &alreadyExistsErr):
+		return newStatus(
+			codes.AlreadyExists, alreadyExistsErr.Error(),
+			errorInfo(ReasonAlreadyExists, "entity", alreadyExistsErr.Entity),
+			resourceInfo(alreadyExistsErr.Entity, alreadyExistsErr.Key, alreadyExistsErr.Error()),
+		)
+	case errors.As(err, &permissionDeniedErr):
+		return newStatus(codes.PermissionDenied, permissionDeniedErr.Error(), errorInfo(ReasonPermissionDenied))
+	case errors.As(err, &failedPreconditionErr):
+		return newStatus(codes.FailedPrecondition, failedPreconditionErr.Error(), errorInfo(ReasonFailedPrecondition))
+	case errors.As(err, &invalidArgumentErr):
+		return newStatus(codes.InvalidArgument, invalidArgumentErr.Error(), errorInfo(ReasonInvalidArgument))
+	case errors.As(err, &unauthenticatedErr):
+		return newStatus(codes.Unauthenticated, unauthenticatedErr.Error(), errorInfo(ReasonUnauthenticated))
+	case errors.As(err, &unavailableErr):
+		logger.Warn("dependency is unavailable", zap.String("service", unavailableErr.Service), zap.Error(err))
+		return newStatus(
+			codes.Unavailable, unavailableErr.Error(),
+			errorInfo(ReasonUnavailable, "service", unavailableErr.Service),
+		)
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, context.DeadlineExceeded.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, context.Canceled.Error())
+	case errors.As(err, &statusErr):
+		return convertStatus(statusErr.GRPCStatus(), err)
+	default:
+		logger.Error(internalErrorMessage, zap.Error(err))
+		return status.Error(codes.Internal, internalErrorMessage)
+	}
+}
+
+// convertStatus preserves the status returned by another gRPC service unless it reports an internal failure.
+func convertStatus(st *status.Status, err error) error {
+	switch st.Code() {
+	case codes.OK, codes.Unknown, codes.Internal, codes.DataLoss:
+		logger.Error(internalErrorMessage, zap.Error(err))
+		return status.Error(codes.Internal, internalErrorMessage)
 	default:
-		return status.Errorf(codes.Internal, err.Error())
+		return st.Err()
+	}
+}
+
+// newStatus builds a gRPC error with the given code, message and details.
+func newStatus(code codes.Code, msg string, details ...protoadapt.MessageV1) error {
+	st := status.New(code, msg)
+
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
+
+// errorInfo builds google.rpc.ErrorInfo with the given reason and metadata key-value pairs.
+func errorInfo(reason string, keyValues ...string) *errdetails.ErrorInfo {
+	info := &errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: Domain,
+	}
+
+	if len(keyValues) > 0 {
+		info.Metadata = make(map[string]string, len(keyValues)/2)
+		for i := 0; i+1 < len(keyValues); i += 2 {
+			info.Metadata[keyValues[i]] = keyValues[i+1]
+		}
+	}
+
+	return info
+}
+
+// resourceInfo builds google.rpc.ResourceInfo describing the resource the error relates to.
+func resourceInfo(resourceType, resourceName, description string) *errdetails.ResourceInfo {
+	return &errdetails.ResourceInfo{
+		ResourceType: resourceType,
+		ResourceName: resourceName,
+		Description:  description,
 	}
 }
@@ -39,3 +39,142 @@ func NewUserNotInChatError(userID, chatID int64) error {
 		ChatID: chatID,
 	}
 }
+
+// ModerationError represents an error indicating that a message was rejected by a moderation filter.
+type ModerationError struct {
+	Filter string
+	Reason string
+}
+
+// Error implements the error interface for ModerationError.
+func (e *ModerationError) Error() string {
+	return fmt.Sprintf("message rejected by moderation: %s", e.Reason)
+}
+
+// NewModerationError creates a new ModerationError.
+func NewModerationError(filter, reason string) error {
+	return &ModerationError{
+		Filter: filter,
+		Reason: reason,
+	}
+}
+
+// ReportResolvedError represents an error indicating that a report has already been resolved.
+type ReportResolvedError struct {
+	ID int64
+}
+
+// Error implements the error interface for ReportResolvedError.
+func (e *ReportResolvedError) Error() string {
+	return fmt.Sprintf("report %d is already resolved", e.ID)
+}
+
+// NewReportResolvedError creates a new ReportResolvedError.
+func NewReportResolvedError(id int64) error {
+	return &ReportResolvedError{ID: id}
+}
+
+// AlreadyExistsError represents an error indicating that an entity with the same key already exists.
+type AlreadyExistsError struct {
+	Entity string
+	Key    string
+}
+
+// Error implements the error interface for AlreadyExistsError.
+func (e *AlreadyExistsError) Error() string {
+	return fmt.Sprintf("%s %s already exists", e.Entity, e.Key)
+}
+
+// NewAlreadyExistsError creates a new AlreadyExistsError for a given entity and key.
+func NewAlreadyExistsError(entity, key string) error {
+	return &AlreadyExistsError{
+		Entity: entity,
+		Key:    key,
+	}
+}
+
+// PermissionDeniedError represents an error indicating that the caller is not allowed to perform the operation.
+type PermissionDeniedError struct {
+	Reason string
+}
+
+// Error implements the error interface for PermissionDeniedError.
+func (e *PermissionDeniedError) Error() string {
+	return fmt.Sprintf("permission denied: %s", e.Reason)
+}
+
+// NewPermissionDeniedError creates a new PermissionDeniedError.
+func NewPermissionDeniedError(reason string) error {
+	return &PermissionDeniedError{Reason: reason}
+}
+
+// FailedPreconditionError represents an error indicating that the system is not in a state
+// required for the operation.
+type FailedPreconditionError struct {
+	Reason string
+}
+
+// Error implements the error interface for FailedPreconditionError.
+func (e *FailedPreconditionError) Error() string {
+	return e.Reason
+}
+
+// NewFailedPreconditionError creates a new FailedPreconditionError.
+func NewFailedPreconditionError(reason string) error {
+	return &FailedPreconditionError{Reason: reason}
+}
+
+// InvalidArgumentError represents an error indicating that the request is invalid regardless of the state
+// of the system.
+type InvalidArgumentError struct {
+	Reason string
+}
+
+// Error implements the error interface for InvalidArgumentError.
+func (e *InvalidArgumentError) Error() string {
+	return e.Reason
+}
+
+// NewInvalidArgumentError creates a new InvalidArgumentError.
+func NewInvalidArgumentError(reason string) error {
+	return &InvalidArgumentError{Reason: reason}
+}
+
+// UnauthenticatedError represents an error indicating that the caller's credentials are missing or invalid.
+type UnauthenticatedError struct {
+	Reason string
+}
+
+// Error implements the error interface for UnauthenticatedError.
+func (e *UnauthenticatedError) Error() string {
+	return fmt.Sprintf("unauthenticated: %s", e.Reason)
+}
+
+// NewUnauthenticatedError creates a new UnauthenticatedError.
+func NewUnauthenticatedError(reason string) error {
+	return &UnauthenticatedError{Reason: reason}
+}
+
+// UnavailableError represents an error indicating that a service the chat server depends on is unavailable.
+type UnavailableError struct {
+	Service string
+	Err     error
+}
+
+// Error implements the error interface for UnavailableError.
+func (e *UnavailableError) Error() string {
+	return fmt.Sprintf("%s service is unavailable", e.Service)
+}
+
+// Unwrap returns the underlying error.
+func (e *UnavailableError) Unwrap() error {
+	return e.Err
+}
+
+// NewUnavailableError creates a new UnavailableError for a given service.
+func NewUnavailableError(service string, err error) error {
+	return &UnavailableError{
+		Service: service,
+		Err:     err,
+	}
+}
@@ -0,0 +1,146 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestConvertError(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id     = gofakeit.Int64()
+		dbErr  = fmt.Errorf("ERROR: relation \"chats\" does not exist (SQLSTATE 42P01)")
+		authSt = status.Error(codes.NotFound, "user not found")
+	)
+
+	tests := []struct {
+		name     string
+		err      error
+		code     codes.Code
+		msg      string
+		reason   string
+		resource bool
+	}{
+		{
+			name:     "not found",
+			err:      customerrors.NewNotFoundError("chat", id),
+			code:     codes.NotFound,
+			msg:      fmt.Sprintf("chat %d not found", id),
+			reason:   customerrors.ReasonNotFound,
+			resource: true,
+		},
+		{
+			name:     "already exists",
+			err:      errors.WithMessage(customerrors.NewAlreadyExistsError("report", "from user 1 on message 2"), "reporting"),
+			code:     codes.AlreadyExists,
+			msg:      "report from user 1 on message 2 already exists",
+			reason:   customerrors.ReasonAlreadyExists,
+			resource: true,
+		},
+		{
+			name:   "permission denied",
+			err:    customerrors.NewPermissionDeniedError("access denied"),
+			code:   codes.PermissionDenied,
+			msg:    "permission denied: access denied",
+			reason: customerrors.ReasonPermissionDenied,
+		},
+		{
+			name:   "failed precondition",
+			err:    customerrors.NewFailedPreconditionError("chat is archived"),
+			code:   codes.FailedPrecondition,
+			msg:    "chat is archived",
+			reason: customerrors.ReasonFailedPrecondition,
+		},
+		{
+			name:   "invalid argument",
+			err:    customerrors.NewInvalidArgumentError("unknown action"),
+			code:   codes.InvalidArgument,
+			msg:    "unknown action",
+			reason: customerrors.ReasonInvalidArgument,
+		},
+		{
+			name:   "unauthenticated",
+			err:    customerrors.NewUnauthenticatedError("token expired"),
+			code:   codes.Unauthenticated,
+			msg:    "unauthenticated: token expired",
+			reason: customerrors.ReasonUnauthenticated,
+		},
+		{
+			name:   "unavailable",
+			err:    customerrors.NewUnavailableError("auth", status.Error(codes.Unavailable, "connection refused")),
+			code:   codes.Unavailable,
+			msg:    "auth service is unavailable",
+			reason: customerrors.ReasonUnavailable,
+		},
+		{
+			name: "upstream status preserved",
+			err:  errors.WithMessage(authSt, "checking users existence"),
+			code: codes.NotFound,
+			msg:  "user not found",
+		},
+		{
+			name: "upstream internal status scrubbed",
+			err:  errors.WithMessage(status.Error(codes.Internal, "pq: connection reset"), "checking endpoint access"),
+			code: codes.Internal,
+			msg:  "internal error",
+		},
+		{
+			name: "deadline exceeded",
+			err:  errors.WithMessage(context.DeadlineExceeded, "sending message"),
+			code: codes.DeadlineExceeded,
+			msg:  context.DeadlineExceeded.Error(),
+		},
+		{
+			name: "internal error scrubbed",
+			err:  dbErr,
+			code: codes.Internal,
+			msg:  "internal error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			st := status.Convert(customerrors.ConvertError(tt.err))
+			require.Equal(t, tt.code, st.Code())
+			require.Equal(t, tt.msg, st.Message())
+
+			var info *errdetails.ErrorInfo
+			var resource *errdetails.ResourceInfo
+			for _, d := range st.Details() {
+				switch v := d.(type) {
+				case *errdetails.ErrorInfo:
+					info = v
+				case *errdetails.ResourceInfo:
+					resource = v
+				}
+			}
+
+			if tt.reason == "" {
+				require.Nil(t, info)
+			} else {
+				require.NotNil(t, info)
+				require.Equal(t, tt.reason, info.GetReason())
+				require.Equal(t, customerrors.Domain, info.GetDomain())
+			}
+			require.Equal(t, tt.resource, resource != nil)
+		})
+	}
+}
+
+func TestConvertErrorNil(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, customerrors.ConvertError(nil))
+}
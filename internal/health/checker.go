@@ -0,0 +1,130 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/logger"
+	"go.uber.org/zap"
+	grpcHealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Check checks a dependency of the server and returns an error if the dependency is unhealthy.
+type Check func(ctx context.Context) error
+
+// Checker periodically runs the dependency checks and reports the results through the gRPC health service.
+// Every dependency is reported under its own name, the served services and the overall server status
+// (empty service name) are SERVING only while all dependencies are healthy.
+type Checker struct {
+	server   *grpcHealth.Server
+	services []string
+	checks   map[string]Check
+	interval time.Duration
+	timeout  time.Duration
+
+	mu     sync.Mutex
+	failed map[string]bool
+}
+
+// NewChecker creates a new Checker reporting the statuses of the services to the health server.
+// All services are NOT_SERVING until the first checks complete.
+func NewChecker(
+	server *grpcHealth.Server,
+	services []string,
+	checks map[string]Check,
+	interval time.Duration,
+	timeout time.Duration,
+) *Checker {
+	c := &Checker{
+		server:   server,
+		services: append([]string{""}, services...),
+		checks:   checks,
+		interval: interval,
+		timeout:  timeout,
+		failed:   make(map[string]bool, len(checks)),
+	}
+
+	for _, service := range c.services {
+		server.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+
+	return c
+}
+
+// Run checks the dependencies right away and then every interval until the context is done.
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.CheckAll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckAll runs all dependency checks concurrently and updates the statuses.
+func (c *Checker) CheckAll(ctx context.Context) {
+	wg := &sync.WaitGroup{}
+	wg.Add(len(c.checks))
+
+	for name, check := range c.checks {
+		go func(name string, check Check) {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, c.timeout)
+			defer cancel()
+
+			c.report(name, check(checkCtx))
+		}(name, check)
+	}
+
+	wg.Wait()
+
+	status := healthpb.HealthCheckResponse_SERVING
+	c.mu.Lock()
+	for _, failed := range c.failed {
+		if failed {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+			break
+		}
+	}
+	c.mu.Unlock()
+
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, status)
+	}
+}
+
+// Shutdown sets all statuses to NOT_SERVING and ignores later updates, so clients stop sending requests
+// to the server being stopped.
+func (c *Checker) Shutdown() {
+	c.server.Shutdown()
+}
+
+// report updates the status of the dependency, logging its changes.
+func (c *Checker) report(name string, err error) {
+	c.mu.Lock()
+	wasFailed := c.failed[name]
+	c.failed[name] = err != nil
+	c.mu.Unlock()
+
+	if err != nil {
+		c.server.SetServingStatus(name, healthpb.HealthCheckResponse_NOT_SERVING)
+		if !wasFailed {
+			logger.Warn("dependency is unhealthy", zap.String("dependency", name), zap.Error(err))
+		}
+		return
+	}
+
+	c.server.SetServingStatus(name, healthpb.HealthCheckResponse_SERVING)
+	if wasFailed {
+		logger.Info("dependency is healthy again", zap.String("dependency", name))
+	}
+}
@@ -0,0 +1,39 @@
+package health
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// DBCheck checks that the database answers pings.
+func DBCheck(client db.Client) Check {
+	return func(ctx context.Context) error {
+		return client.DB().Ping(ctx)
+	}
+}
+
+// ConnCheck checks that the client connection is able to reach the server, connecting an idle connection
+// and waiting for the connection attempt to complete.
+func ConnCheck(conn *grpc.ClientConn) Check {
+	return func(ctx context.Context) error {
+		for {
+			state := conn.GetState()
+			switch state {
+			case connectivity.Ready:
+				return nil
+			case connectivity.Idle:
+				conn.Connect()
+			case connectivity.TransientFailure, connectivity.Shutdown:
+				return fmt.Errorf("connection to %s is in %s state", conn.Target(), state)
+			}
+
+			if !conn.WaitForStateChange(ctx, state) {
+				return fmt.Errorf("connection to %s is in %s state: %w", conn.Target(), state, ctx.Err())
+			}
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/mikhailsoldatkin/chat-server/internal/health"
+	"github.com/stretchr/testify/require"
+	grpcHealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	service  = "chat_v1.ChatV1"
+	postgres = "postgres"
+	auth     = "auth"
+)
+
+func TestChecker(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = context.Background()
+		healthy = func(_ context.Context) error { return nil }
+		failing = func(_ context.Context) error { return errors.New(gofakeit.Sentence(3)) }
+		serving = healthpb.HealthCheckResponse_SERVING
+		down    = healthpb.HealthCheckResponse_NOT_SERVING
+	)
+
+	tests := []struct {
+		name     string
+		checks   map[string]health.Check
+		shutdown bool
+		statuses map[string]healthpb.HealthCheckResponse_ServingStatus
+	}{
+		{
+			name:   "all dependencies healthy",
+			checks: map[string]health.Check{postgres: healthy, auth: healthy},
+			statuses: map[string]healthpb.HealthCheckResponse_ServingStatus{
+				"": serving, service: serving, postgres: serving, auth: serving,
+			},
+		},
+		{
+			name:   "dependency unhealthy",
+			checks: map[string]health.Check{postgres: healthy, auth: failing},
+			statuses: map[string]healthpb.HealthCheckResponse_ServingStatus{
+				"": down, service: down, postgres: serving, auth: down,
+			},
+		},
+		{
+			name:     "shutdown",
+			checks:   map[string]health.Check{postgres: healthy, auth: healthy},
+			shutdown: true,
+			statuses: map[string]healthpb.HealthCheckResponse_ServingStatus{
+				"": down, service: down, postgres: down, auth: down,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := grpcHealth.NewServer()
+			checker := health.NewChecker(server, []string{service}, tt.checks, time.Minute, time.Second)
+
+			checker.CheckAll(ctx)
+			if tt.shutdown {
+				checker.Shutdown()
+				checker.CheckAll(ctx)
+			}
+
+			for name, status := range tt.statuses {
+				res, err := server.Check(ctx, &healthpb.HealthCheckRequest{Service: name})
+				require.NoError(t, err)
+				require.Equal(t, status, res.GetStatus(), "service %q", name)
+			}
+		})
+	}
+}
+
+func TestCheckerNotServingBeforeChecks(t *testing.T) {
+	t.Parallel()
+
+	server := grpcHealth.NewServer()
+	_ = health.NewChecker(server, []string{service}, map[string]health.Check{}, time.Minute, time.Second)
+
+	res, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	require.NoError(t, err)
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, res.GetStatus())
+}
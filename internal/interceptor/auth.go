@@ -3,27 +3,164 @@ package interceptor
 import (
 	"context"
 
+	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/mikhailsoldatkin/chat-server/internal/client"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/service"
+	"github.com/mikhailsoldatkin/chat-server/internal/utils"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	reflectionpbalpha "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
 )
 
+// apiKeyHeader carries the API key of bots and integrations calling instead of users.
+const apiKeyHeader = "x-api-key"
+
+// publicMethods are called without access checks, e.g. by orchestrators probing the server health
+// or by tools listing the server API.
+var publicMethods = map[string]struct{}{
+	healthpb.Health_Check_FullMethodName:                                   {},
+	healthpb.Health_Watch_FullMethodName:                                   {},
+	reflectionpb.ServerReflection_ServerReflectionInfo_FullMethodName:      {},
+	reflectionpbalpha.ServerReflection_ServerReflectionInfo_FullMethodName: {},
+}
+
 // AuthInterceptor creates a gRPC server interceptor that checks access using the provided gRPC authentication client.
-func AuthInterceptor(cl client.AuthClient) grpc.UnaryServerInterceptor {
+// Requests carrying an API key are authenticated by the API keys service instead, API keys are not accepted
+// if the service is nil.
+func AuthInterceptor(cl client.AuthClient, keys service.APIKeyService) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req any,
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (any, error) {
+		if _, ok := publicMethods[info.FullMethod]; ok {
+			return handler(ctx, req)
+		}
+
 		md, _ := metadata.FromIncomingContext(ctx)
 		ctx = metadata.NewOutgoingContext(ctx, md)
 
-		err := cl.CheckAccess(ctx, info.FullMethod)
+		if secret := apiKeyFromMetadata(md); secret != "" {
+			var chatID int64
+			if r, ok := req.(chatScoped); ok {
+				chatID = r.GetChatId()
+			}
+
+			botCtx, err := authenticateBot(ctx, keys, secret, info.FullMethod, chatID)
+			if err != nil {
+				return nil, customerrors.ConvertError(err)
+			}
+
+			return handler(botCtx, req)
+		}
+
+		err := checkAccess(ctx, cl, info.FullMethod, req)
+		if err != nil {
+			return nil, customerrors.ConvertError(err)
+		}
+
+		return handler(contextWithUser(ctx), req)
+	}
+}
+
+// AuthStreamInterceptor creates a gRPC stream interceptor that checks access using the provided gRPC
+// authentication client or, for streams opened with an API key, the API keys service before the stream is handled.
+func AuthStreamInterceptor(cl client.AuthClient, keys service.APIKeyService) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if _, ok := publicMethods[info.FullMethod]; ok {
+			return handler(srv, ss)
+		}
+
+		md, _ := metadata.FromIncomingContext(ss.Context())
+		wrapped := grpcMiddleware.WrapServerStream(ss)
+		wrapped.WrappedContext = metadata.NewOutgoingContext(ss.Context(), md)
+
+		if secret := apiKeyFromMetadata(md); secret != "" {
+			botCtx, err := authenticateBot(wrapped.WrappedContext, keys, secret, info.FullMethod, 0)
+			if err != nil {
+				return customerrors.ConvertError(err)
+			}
+
+			wrapped.WrappedContext = botCtx
+			return handler(srv, wrapped)
+		}
+
+		err := cl.CheckAccess(wrapped.WrappedContext, info.FullMethod)
 		if err != nil {
-			return nil, err
+			return customerrors.ConvertError(err)
 		}
 
-		return handler(ctx, req)
+		wrapped.WrappedContext = contextWithUser(wrapped.WrappedContext)
+		return handler(srv, wrapped)
 	}
 }
+
+// checkAccess checks access to the method, called on the chat the request is addressed to if the client
+// can check access to chats.
+func checkAccess(ctx context.Context, cl client.AuthClient, method string, req any) error {
+	if checker, ok := cl.(client.ChatAccessChecker); ok {
+		if r, isChatScoped := req.(chatScoped); isChatScoped && r.GetChatId() != 0 {
+			return checker.CheckChatAccess(ctx, method, r.GetChatId())
+		}
+	}
+
+	return cl.CheckAccess(ctx, method)
+}
+
+// contextWithUser returns a copy of the context carrying the user of the access token the access check
+// has just been passed with, the context is returned as is if the request carries no token.
+func contextWithUser(ctx context.Context) context.Context {
+	token, err := utils.AccessTokenFromContext(ctx)
+	if err != nil {
+		return ctx
+	}
+
+	claims, err := utils.ParseUnverifiedClaims(token)
+	if err != nil {
+		return ctx
+	}
+
+	return utils.ContextWithUser(ctx, &utils.User{Username: claims.Username, Role: claims.Role})
+}
+
+// apiKeyFromMetadata returns the API key from the incoming metadata, empty if the request carries none.
+func apiKeyFromMetadata(md metadata.MD) string {
+	if values := md.Get(apiKeyHeader); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// authenticateBot authenticates the API key for the method called on the chat with chatID and returns
+// a copy of the context carrying the bot the key is issued for.
+func authenticateBot(
+	ctx context.Context,
+	keys service.APIKeyService,
+	secret, method string,
+	chatID int64,
+) (context.Context, error) {
+	if keys == nil {
+		return nil, customerrors.NewUnauthenticatedError("API keys are not accepted")
+	}
+
+	key, err := keys.Authenticate(ctx, secret, method, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.ContextWithBot(ctx, &utils.Bot{
+		KeyID:  key.ID,
+		UserID: key.UserID,
+		Name:   key.Name,
+	}), nil
+}
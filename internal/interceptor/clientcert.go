@@ -0,0 +1,75 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/utils"
+	"google.golang.org/grpc"
+)
+
+// ClientCertInterceptor creates a gRPC server interceptor that only lets clients whose mutual TLS certificate
+// has one of the allowed subject alternative names call the non-public methods.
+func ClientCertInterceptor(allowedSANs []string) grpc.UnaryServerInterceptor {
+	allowed := sanSet(allowedSANs)
+
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if err := checkClientCert(ctx, allowed, info.FullMethod); err != nil {
+			return nil, customerrors.ConvertError(err)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// ClientCertStreamInterceptor creates a gRPC stream interceptor that only lets clients whose mutual TLS
+// certificate has one of the allowed subject alternative names open streams of the non-public methods.
+func ClientCertStreamInterceptor(allowedSANs []string) grpc.StreamServerInterceptor {
+	allowed := sanSet(allowedSANs)
+
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := checkClientCert(ss.Context(), allowed, info.FullMethod); err != nil {
+			return customerrors.ConvertError(err)
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func sanSet(sans []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(sans))
+	for _, san := range sans {
+		set[san] = struct{}{}
+	}
+	return set
+}
+
+// checkClientCert checks that the client certificate has one of the allowed subject alternative names.
+func checkClientCert(ctx context.Context, allowed map[string]struct{}, method string) error {
+	if _, ok := publicMethods[method]; ok {
+		return nil
+	}
+
+	cert, ok := utils.ClientCertFromContext(ctx)
+	if !ok {
+		return customerrors.NewUnauthenticatedError("client certificate is not provided")
+	}
+
+	for _, san := range utils.CertificateSANs(cert) {
+		if _, ok = allowed[san]; ok {
+			return nil
+		}
+	}
+
+	return customerrors.NewPermissionDeniedError("client certificate is not allowed")
+}
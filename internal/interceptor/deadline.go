@@ -0,0 +1,37 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// DeadlineInterceptor creates a gRPC server interceptor applying a default timeout to requests sent without
+// a deadline. Timeouts map gRPC full method names to their timeouts, other methods get defaultTimeout,
+// no timeout is applied if it is zero. The deadline is carried by the context down to the database queries.
+func DeadlineInterceptor(defaultTimeout time.Duration, timeouts map[string]time.Duration) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if _, ok := ctx.Deadline(); ok {
+			return handler(ctx, req)
+		}
+
+		timeout, ok := timeouts[info.FullMethod]
+		if !ok {
+			timeout = defaultTimeout
+		}
+		if timeout <= 0 {
+			return handler(ctx, req)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,154 @@
+package interceptor
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/mikhailsoldatkin/chat-server/internal/logger"
+	"github.com/mikhailsoldatkin/chat-server/internal/utils"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const accessLogMessage = "grpc request"
+
+// userScoped is implemented by requests made on behalf of a user.
+type userScoped interface {
+	GetFromUser() int64
+}
+
+// LoggingInterceptor creates a gRPC server interceptor writing one access log line per request with the method,
+// status code, duration, peer, caller as verified by the authentication interceptor, the user the request is made
+// on behalf of and chat IDs as sent by the client, trace ID and the request with user content and credentials
+// redacted. Failed requests are always logged, successful ones with the sampleRatio probability.
+func LoggingInterceptor(sampleRatio float64) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		start := time.Now()
+
+		ctx, identity := utils.ContextWithIdentity(ctx)
+		res, err := handler(ctx, req)
+
+		code := status.Code(err)
+		if code == codes.OK && rand.Float64() >= sampleRatio { //nolint:gosec // sampling doesn't need a secure source
+			return res, err
+		}
+
+		fields := []zap.Field{
+			zap.String("method", info.FullMethod),
+			zap.String("code", code.String()),
+			zap.Duration("duration", time.Since(start)),
+		}
+		fields = append(fields, requestFields(ctx, identity, req)...)
+		if err != nil {
+			fields = append(fields, zap.String("error", status.Convert(err).Message()))
+		}
+
+		if ce := logger.Logger().Check(accessLogLevel(code), accessLogMessage); ce != nil {
+			ce.Write(fields...)
+		}
+
+		return res, err
+	}
+}
+
+// LoggingStreamInterceptor creates a gRPC stream interceptor writing one access log line per stream when it ends,
+// with the method, status code, duration, peer, verified caller and trace ID. Failed streams are always logged,
+// successful ones with the sampleRatio probability.
+func LoggingStreamInterceptor(sampleRatio float64) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+
+		ctx, identity := utils.ContextWithIdentity(ss.Context())
+		wrapped := grpcMiddleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+
+		err := handler(srv, wrapped)
+
+		code := status.Code(err)
+		if code == codes.OK && rand.Float64() >= sampleRatio { //nolint:gosec // sampling doesn't need a secure source
+			return err
+		}
+
+		fields := []zap.Field{
+			zap.String("method", info.FullMethod),
+			zap.String("code", code.String()),
+			zap.Duration("duration", time.Since(start)),
+			zap.Bool("stream", true),
+		}
+		fields = append(fields, requestFields(ctx, identity, nil)...)
+		if err != nil {
+			fields = append(fields, zap.String("error", status.Convert(err).Message()))
+		}
+
+		if ce := logger.Logger().Check(accessLogLevel(code), accessLogMessage); ce != nil {
+			ce.Write(fields...)
+		}
+
+		return err
+	}
+}
+
+// requestFields describes the caller and the request. The caller is logged only as verified by the authentication
+// interceptor, the user the request is made on behalf of is logged as from_user, as sent by the client.
+func requestFields(ctx context.Context, identity *utils.Identity, req any) []zap.Field {
+	var fields []zap.Field
+
+	if p, ok := peer.FromContext(ctx); ok {
+		fields = append(fields, zap.String("peer", p.Addr.String()))
+	}
+
+	if user, ok := identity.User(); ok && user.Username != "" {
+		fields = append(fields, zap.String("user", user.Username))
+	}
+	if bot, ok := identity.Bot(); ok {
+		fields = append(fields, zap.Int64("api_key_id", bot.KeyID))
+	}
+
+	if r, ok := req.(userScoped); ok && r.GetFromUser() != 0 {
+		fields = append(fields, zap.Int64("from_user", r.GetFromUser()))
+	}
+
+	if r, ok := req.(chatScoped); ok && r.GetChatId() != 0 {
+		fields = append(fields, zap.Int64("chat_id", r.GetChatId()))
+	}
+
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.HasTraceID() {
+		fields = append(fields, zap.String("trace_id", spanContext.TraceID().String()))
+	}
+
+	if msg, ok := req.(proto.Message); ok {
+		fields = append(fields, logger.Proto("request", msg))
+	}
+
+	return fields
+}
+
+// accessLogLevel logs server failures as errors and client errors as warnings.
+func accessLogLevel(code codes.Code) zapcore.Level {
+	switch code {
+	case codes.OK:
+		return zapcore.InfoLevel
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable, codes.DeadlineExceeded:
+		return zapcore.ErrorLevel
+	default:
+		return zapcore.WarnLevel
+	}
+}
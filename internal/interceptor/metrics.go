@@ -0,0 +1,34 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/metric"
+	"google.golang.org/grpc"
+)
+
+// MetricsInterceptor is a gRPC unary interceptor recording the number and the duration of requests
+// by method and status code.
+func MetricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+
+	res, err := handler(ctx, req)
+	metric.ObserveGRPCRequest(info.FullMethod, err, time.Since(start))
+
+	return res, err
+}
+
+// MetricsStreamInterceptor is a gRPC stream interceptor recording the number of open streams
+// and the number and the duration of streams by method and status code.
+func MetricsStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	metric.StreamOpened(metric.TransportGRPC)
+	defer metric.StreamClosed(metric.TransportGRPC)
+
+	start := time.Now()
+
+	err := handler(srv, ss)
+	metric.ObserveGRPCRequest(info.FullMethod, err, time.Since(start))
+
+	return err
+}
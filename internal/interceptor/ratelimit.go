@@ -0,0 +1,122 @@
+package interceptor
+
+import (
+	"context"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/logger"
+	"github.com/mikhailsoldatkin/chat-server/internal/ratelimit"
+	"github.com/mikhailsoldatkin/chat-server/internal/utils"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	retryAfterKey    = "retry-after"
+	forwardedForKey  = "x-forwarded-for"
+	botCallerPrefix  = "key:"
+	userCallerPrefix = "user:"
+	peerCallerPrefix = "peer:"
+)
+
+// chatScoped is implemented by requests addressed to a single chat.
+type chatScoped interface {
+	GetChatId() int64
+}
+
+// RateLimitInterceptor creates a gRPC server interceptor that throttles requests with token buckets
+// keyed by the authenticated user and, for chat scoped requests, by the chat.
+func RateLimitInterceptor(limiter *ratelimit.Limiter) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		allowed, wait, err := limiter.AllowUser(ctx, info.FullMethod, callerKey(ctx))
+		if err != nil {
+			return nil, customerrors.ConvertError(errors.WithMessage(err, "checking rate limit"))
+		}
+		if !allowed {
+			return nil, resourceExhausted(ctx, wait)
+		}
+
+		if r, ok := req.(chatScoped); ok {
+			allowed, wait, err = limiter.AllowChat(ctx, info.FullMethod, r.GetChatId())
+			if err != nil {
+				return nil, customerrors.ConvertError(errors.WithMessage(err, "checking rate limit"))
+			}
+			if !allowed {
+				return nil, resourceExhausted(ctx, wait)
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// callerKey identifies the caller by the API key of bots or the username from the access token, falling back
+// to the client address. The kinds of keys have distinct prefixes, so a username can't take the bucket of a bot.
+func callerKey(ctx context.Context) string {
+	if bot, ok := utils.BotFromContext(ctx); ok {
+		return botCallerPrefix + strconv.FormatInt(bot.KeyID, 10)
+	}
+
+	if token, err := utils.AccessTokenFromContext(ctx); err == nil {
+		if claims, errClaims := utils.ParseUnverifiedClaims(token); errClaims == nil && claims.Username != "" {
+			return userCallerPrefix + claims.Username
+		}
+	}
+
+	return peerCallerPrefix + clientAddr(ctx)
+}
+
+// clientAddr returns the IP address of the client. Requests of the HTTP gateway come from the loopback address,
+// so for them the address the gateway appends to x-forwarded-for is used. The header is not trusted from
+// other peers, which could set it to anything.
+func clientAddr(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return host
+	}
+
+	forwarded := metadata.ValueFromIncomingContext(ctx, forwardedForKey)
+	if len(forwarded) == 0 {
+		return host
+	}
+
+	addrs := strings.Split(forwarded[len(forwarded)-1], ",")
+
+	return strings.TrimSpace(addrs[len(addrs)-1])
+}
+
+// resourceExhausted sets the retry-after response header in seconds and returns a ResourceExhausted error.
+// The error is returned even if the header can't be set, the client is throttled all the same.
+func resourceExhausted(ctx context.Context, wait time.Duration) error {
+	seconds := strconv.Itoa(int(math.Ceil(wait.Seconds())))
+	err := grpc.SetHeader(ctx, metadata.Pairs(retryAfterKey, seconds))
+	if err != nil {
+		logger.Warn("failed to set retry-after header", zap.Error(err))
+	}
+
+	return status.Errorf(codes.ResourceExhausted, "rate limit exceeded, retry after %s seconds", seconds)
+}
@@ -0,0 +1,50 @@
+package interceptor
+
+import (
+	"context"
+	"runtime/debug"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/logger"
+	"github.com/mikhailsoldatkin/chat-server/internal/metric"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RecoveryInterceptor is a gRPC unary interceptor converting a panic in the handler into an Internal error,
+// so that the server keeps running. The panic is logged with the stack and counted.
+func RecoveryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (res interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recovered(info.FullMethod, r)
+		}
+	}()
+
+	return handler(ctx, req)
+}
+
+// RecoveryStreamInterceptor is a gRPC stream interceptor converting a panic in the handler into an Internal error,
+// so that the server keeps running. The panic is logged with the stack and counted.
+func RecoveryStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recovered(info.FullMethod, r)
+		}
+	}()
+
+	return handler(srv, ss)
+}
+
+// recovered logs and counts the recovered panic and returns the error for the client.
+func recovered(method string, r any) error {
+	logger.Error(
+		"panic in grpc handler",
+		zap.String("method", method),
+		zap.Any("panic", r),
+		zap.ByteString("stack", debug.Stack()),
+	)
+	metric.IncPanics(method)
+
+	return status.Error(codes.Internal, "internal error")
+}
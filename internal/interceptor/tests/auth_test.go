@@ -0,0 +1,180 @@
+package tests
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/chat-server/internal/client/mocks"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/interceptor"
+	"github.com/mikhailsoldatkin/chat-server/internal/service"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/apikey/model"
+	serviceMocks "github.com/mikhailsoldatkin/chat-server/internal/service/mocks"
+	"github.com/mikhailsoldatkin/chat-server/internal/utils"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthInterceptorAPIKey(t *testing.T) {
+	t.Parallel()
+	type apiKeyServiceMockFunc func(mc *minimock.Controller) service.APIKeyService
+
+	var (
+		mc = minimock.NewController(t)
+
+		secret = "ck_" + gofakeit.LetterN(43)
+		method = pb.ChatV1_SendMessage_FullMethodName
+		req    = &pb.SendMessageRequest{ChatId: gofakeit.Int64(), FromUser: gofakeit.Int64()}
+
+		key = &model.APIKey{ID: gofakeit.Int64(), UserID: req.GetFromUser(), Name: gofakeit.AppName()}
+	)
+
+	tests := []struct {
+		name              string
+		md                metadata.MD
+		code              codes.Code
+		bot               *utils.Bot
+		checkAccess       bool
+		apiKeyServiceMock apiKeyServiceMockFunc
+	}{
+		{
+			name: "valid key",
+			md:   metadata.MD{"x-api-key": {secret}},
+			code: codes.OK,
+			bot:  &utils.Bot{KeyID: key.ID, UserID: key.UserID, Name: key.Name},
+			apiKeyServiceMock: func(mc *minimock.Controller) service.APIKeyService {
+				mock := serviceMocks.NewAPIKeyServiceMock(mc)
+				mock.AuthenticateMock.Set(func(_ context.Context, s, m string, chatID int64) (*model.APIKey, error) {
+					require.Equal(t, secret, s)
+					require.Equal(t, method, m)
+					require.Equal(t, req.GetChatId(), chatID)
+					return key, nil
+				})
+				return mock
+			},
+		},
+		{
+			name: "invalid key",
+			md:   metadata.MD{"x-api-key": {secret}},
+			code: codes.Unauthenticated,
+			apiKeyServiceMock: func(mc *minimock.Controller) service.APIKeyService {
+				mock := serviceMocks.NewAPIKeyServiceMock(mc)
+				mock.AuthenticateMock.Return(nil, customerrors.NewUnauthenticatedError("invalid API key"))
+				return mock
+			},
+		},
+		{
+			name: "key not allowed",
+			md:   metadata.MD{"x-api-key": {secret}},
+			code: codes.PermissionDenied,
+			apiKeyServiceMock: func(mc *minimock.Controller) service.APIKeyService {
+				mock := serviceMocks.NewAPIKeyServiceMock(mc)
+				mock.AuthenticateMock.Return(nil, customerrors.NewPermissionDeniedError("not allowed"))
+				return mock
+			},
+		},
+		{
+			name:        "access token",
+			md:          metadata.MD{"authorization": {"Bearer " + gofakeit.UUID()}},
+			code:        codes.OK,
+			checkAccess: true,
+			apiKeyServiceMock: func(mc *minimock.Controller) service.APIKeyService {
+				return serviceMocks.NewAPIKeyServiceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			authClient := mocks.NewAuthClientMock(mc)
+			if tt.checkAccess {
+				authClient.CheckAccessMock.Return(nil)
+			}
+
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			info := &grpc.UnaryServerInfo{FullMethod: method}
+			handler := func(ctx context.Context, _ any) (any, error) {
+				bot, _ := utils.BotFromContext(ctx)
+				require.Equal(t, tt.bot, bot)
+				return nil, nil
+			}
+
+			auth := interceptor.AuthInterceptor(authClient, tt.apiKeyServiceMock(mc))
+			_, err := auth(ctx, req, info, handler)
+			require.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+func TestAuthInterceptorAPIKeyNotAccepted(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{"x-api-key": {gofakeit.UUID()}})
+	info := &grpc.UnaryServerInfo{FullMethod: pb.ChatV1_SendMessage_FullMethodName}
+	handler := func(_ context.Context, _ any) (any, error) {
+		return nil, nil
+	}
+
+	_, err := interceptor.AuthInterceptor(mocks.NewAuthClientMock(mc), nil)(ctx, nil, info, handler)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestAuthInterceptorUser(t *testing.T) {
+	t.Parallel()
+
+	var (
+		username = gofakeit.Username()
+		enc      = base64.RawURLEncoding
+		token    = enc.EncodeToString([]byte(`{"alg":"none"}`)) + "." +
+			enc.EncodeToString([]byte(fmt.Sprintf(`{"username":%q,"role":"ADMIN"}`, username))) + "." +
+			enc.EncodeToString([]byte("signature"))
+
+		ctx  = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+		info = &grpc.UnaryServerInfo{FullMethod: pb.ChatV1_ResolveReport_FullMethodName}
+	)
+
+	tests := []struct {
+		name      string
+		accessErr error
+		user      *utils.User
+	}{
+		{
+			name: "verified token",
+			user: &utils.User{Username: username, Role: "ADMIN"},
+		},
+		{
+			name:      "rejected token",
+			accessErr: customerrors.NewUnauthenticatedError("invalid access token"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			authClient := mocks.NewAuthClientMock(mc).CheckAccessMock.Return(tt.accessErr)
+
+			var user *utils.User
+			handler := func(ctx context.Context, _ any) (any, error) {
+				user, _ = utils.UserFromContext(ctx)
+				return nil, nil
+			}
+
+			_, err := interceptor.AuthInterceptor(authClient, nil)(ctx, &pb.ResolveReportRequest{}, info, handler)
+			require.Equal(t, tt.accessErr == nil, err == nil)
+			require.Equal(t, tt.user, user, "the user is only known once the token is verified")
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/url"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/mikhailsoldatkin/chat-server/internal/interceptor"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// peerContext returns a context of a mutual TLS peer with the verified client certificate.
+func peerContext(cert *x509.Certificate) context.Context {
+	state := tls.ConnectionState{}
+	if cert != nil {
+		state.VerifiedChains = [][]*x509.Certificate{{cert}}
+	}
+
+	return peer.NewContext(context.Background(), &peer.Peer{
+		Addr:     &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 50052},
+		AuthInfo: credentials.TLSInfo{State: state},
+	})
+}
+
+func TestClientCertInterceptor(t *testing.T) {
+	t.Parallel()
+
+	var (
+		allowed = []string{"gateway.chat.svc", "spiffe://chat/bot"}
+
+		dnsCert    = &x509.Certificate{DNSNames: []string{gofakeit.DomainName(), "gateway.chat.svc"}}
+		uriCert    = &x509.Certificate{URIs: []*url.URL{{Scheme: "spiffe", Host: "chat", Path: "/bot"}}}
+		strangeCrt = &x509.Certificate{DNSNames: []string{gofakeit.DomainName()}}
+	)
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		code   codes.Code
+	}{
+		{
+			name:   "allowed DNS name",
+			ctx:    peerContext(dnsCert),
+			method: pb.ChatV1_SendMessage_FullMethodName,
+			code:   codes.OK,
+		},
+		{
+			name:   "allowed URI",
+			ctx:    peerContext(uriCert),
+			method: pb.ChatV1_SendMessage_FullMethodName,
+			code:   codes.OK,
+		},
+		{
+			name:   "not allowed certificate",
+			ctx:    peerContext(strangeCrt),
+			method: pb.ChatV1_SendMessage_FullMethodName,
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:   "no client certificate",
+			ctx:    peerContext(nil),
+			method: pb.ChatV1_SendMessage_FullMethodName,
+			code:   codes.Unauthenticated,
+		},
+		{
+			name:   "plaintext peer",
+			ctx:    context.Background(),
+			method: pb.ChatV1_SendMessage_FullMethodName,
+			code:   codes.Unauthenticated,
+		},
+		{
+			name:   "public method",
+			ctx:    peerContext(nil),
+			method: healthpb.Health_Check_FullMethodName,
+			code:   codes.OK,
+		},
+	}
+
+	unary := interceptor.ClientCertInterceptor(allowed)
+	stream := interceptor.ClientCertStreamInterceptor(allowed)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := unary(tt.ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(context.Context, any) (any, error) { return nil, nil })
+			require.Equal(t, tt.code, status.Code(err))
+
+			err = stream(nil, &serverStream{ctx: tt.ctx}, &grpc.StreamServerInfo{FullMethod: tt.method},
+				func(any, grpc.ServerStream) error { return nil })
+			require.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+// serverStream is a server stream carrying the given context.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/interceptor"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestDeadlineInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const (
+		method         = "/chat_v1.ChatV1/ListReports"
+		otherMethod    = "/chat_v1.ChatV1/SendMessage"
+		defaultTimeout = time.Minute
+		methodTimeout  = time.Hour
+		clientTimeout  = time.Second
+	)
+
+	timeouts := map[string]time.Duration{method: methodTimeout}
+
+	tests := []struct {
+		name           string
+		method         string
+		defaultTimeout time.Duration
+		clientTimeout  time.Duration
+		timeout        time.Duration
+	}{
+		{
+			name:           "method timeout",
+			method:         method,
+			defaultTimeout: defaultTimeout,
+			timeout:        methodTimeout,
+		},
+		{
+			name:           "default timeout",
+			method:         otherMethod,
+			defaultTimeout: defaultTimeout,
+			timeout:        defaultTimeout,
+		},
+		{
+			name:           "client deadline kept",
+			method:         method,
+			defaultTimeout: defaultTimeout,
+			clientTimeout:  clientTimeout,
+			timeout:        clientTimeout,
+		},
+		{
+			name:   "no default timeout",
+			method: otherMethod,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if tt.clientTimeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.clientTimeout)
+				defer cancel()
+			}
+
+			var (
+				deadline    time.Time
+				hasDeadline bool
+			)
+			handler := func(ctx context.Context, _ any) (any, error) {
+				deadline, hasDeadline = ctx.Deadline()
+				return nil, nil
+			}
+
+			start := time.Now()
+			i := interceptor.DeadlineInterceptor(tt.defaultTimeout, timeouts)
+			_, err := i(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			require.NoError(t, err)
+
+			if tt.timeout == 0 {
+				require.False(t, hasDeadline)
+				return
+			}
+			require.True(t, hasDeadline)
+			require.WithinDuration(t, start.Add(tt.timeout), deadline, time.Second)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/mikhailsoldatkin/chat-server/internal/interceptor"
+	"github.com/mikhailsoldatkin/chat-server/internal/logger"
+	"github.com/mikhailsoldatkin/chat-server/internal/utils"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestLoggingInterceptor(t *testing.T) {
+	t.Parallel()
+
+	var (
+		username = gofakeit.Username()
+		// the claims of the token are not verified yet when the request is logged
+		ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			"authorization", "Bearer "+unverifiedToken(t, username+"-spoofed"),
+		))
+		chatID = int64(gofakeit.Number(1, 1000000))
+		userID = int64(gofakeit.Number(1, 1000000))
+		text   = gofakeit.Sentence(5)
+		req    = &pb.SendMessageRequest{ChatId: chatID, FromUser: userID, Text: text}
+	)
+
+	tests := []struct {
+		name        string
+		err         error
+		sampleRatio float64
+		logged      bool
+		level       zapcore.Level
+	}{
+		{
+			name:        "success",
+			sampleRatio: 1,
+			logged:      true,
+			level:       zapcore.InfoLevel,
+		},
+		{
+			name:        "success not sampled",
+			sampleRatio: 0,
+			logged:      false,
+		},
+		{
+			name:        "client error not sampled",
+			err:         status.Error(codes.NotFound, gofakeit.Sentence(3)),
+			sampleRatio: 0,
+			logged:      true,
+			level:       zapcore.WarnLevel,
+		},
+		{
+			name:        "server error",
+			err:         status.Error(codes.Internal, gofakeit.Sentence(3)),
+			sampleRatio: 1,
+			logged:      true,
+			level:       zapcore.ErrorLevel,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			method := fmt.Sprintf("/chat_v1.ChatV1/%s", gofakeit.UUID())
+			info := &grpc.UnaryServerInfo{FullMethod: method}
+			handler := func(ctx context.Context, _ any) (any, error) {
+				// as the authentication interceptor does once the token is verified
+				utils.ContextWithUser(ctx, &utils.User{Username: username})
+				return nil, tt.err
+			}
+
+			_, err := interceptor.LoggingInterceptor(tt.sampleRatio)(ctx, req, info, handler)
+			require.Equal(t, tt.err, err)
+
+			entries := logs.FilterField(zap.String("method", method)).All()
+			if !tt.logged {
+				require.Empty(t, entries)
+				return
+			}
+
+			require.Len(t, entries, 1)
+			require.Equal(t, tt.level, entries[0].Level)
+
+			fields := entries[0].ContextMap()
+			require.Equal(t, status.Code(tt.err).String(), fields["code"])
+			require.Equal(t, chatID, fields["chat_id"])
+			require.Equal(t, userID, fields["from_user"])
+			require.Equal(t, username, fields["user"], "the verified user is logged, not the token claims")
+
+			request, err := json.Marshal(fields["request"])
+			require.NoError(t, err)
+			require.NotContains(t, string(request), text)
+			require.Contains(t, string(request), logger.Redacted)
+		})
+	}
+}
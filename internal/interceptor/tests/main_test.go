@@ -0,0 +1,21 @@
+package tests
+
+import (
+	"os"
+	"testing"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/logger"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// logs records the entries written to the global logger by the interceptors under test.
+var logs *observer.ObservedLogs
+
+func TestMain(m *testing.M) {
+	var core zapcore.Core
+	core, logs = observer.New(zapcore.DebugLevel)
+	logger.Init(core)
+
+	os.Exit(m.Run())
+}
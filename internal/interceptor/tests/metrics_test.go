@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/mikhailsoldatkin/chat-server/internal/interceptor"
+	"github.com/mikhailsoldatkin/chat-server/internal/metric"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMetricsInterceptor(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{
+			name: "success",
+			err:  nil,
+			code: codes.OK,
+		},
+		{
+			name: "error",
+			err:  status.Error(codes.NotFound, gofakeit.Sentence(3)),
+			code: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			method := fmt.Sprintf("/chat_v1.ChatV1/%s", gofakeit.UUID())
+			info := &grpc.UnaryServerInfo{FullMethod: method}
+			handler := func(_ context.Context, _ any) (any, error) {
+				return nil, tt.err
+			}
+
+			_, err := interceptor.MetricsInterceptor(ctx, nil, info, handler)
+			require.Equal(t, tt.err, err)
+
+			require.Equal(t, float64(1), requestsTotal(t, method, tt.code))
+			require.Equal(t, uint64(1), requestDurationCount(t, method, tt.code))
+		})
+	}
+}
+
+// requestsTotal returns the requests counter of the method and code.
+func requestsTotal(t *testing.T, method string, code codes.Code) float64 {
+	m := findSeries(t, "chat_server_grpc_requests_total", method, code)
+	return m.GetCounter().GetValue()
+}
+
+// requestDurationCount returns the number of observed request durations of the method and code.
+func requestDurationCount(t *testing.T, method string, code codes.Code) uint64 {
+	m := findSeries(t, "chat_server_grpc_request_duration_seconds", method, code)
+	return m.GetHistogram().GetSampleCount()
+}
+
+// findSeries returns the series of the metric with the method and code labels.
+func findSeries(t *testing.T, name, method string, code codes.Code) *dto.Metric {
+	families, err := metric.Registry().Gather()
+	require.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			labels := make(map[string]string, len(m.GetLabel()))
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+			if labels["method"] == method && labels["code"] == code.String() {
+				return m
+			}
+		}
+	}
+
+	require.Failf(t, "series not found", "%s{method=%q,code=%q}", name, method, code)
+
+	return nil
+}
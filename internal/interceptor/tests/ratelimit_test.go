@@ -0,0 +1,103 @@
+package tests
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/mikhailsoldatkin/chat-server/internal/interceptor"
+	"github.com/mikhailsoldatkin/chat-server/internal/ratelimit"
+	"github.com/mikhailsoldatkin/chat-server/internal/utils"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// unverifiedToken returns an unsigned access token of the user, the rate limit interceptor runs before
+// the token is verified.
+func unverifiedToken(t *testing.T, username string) string {
+	t.Helper()
+
+	payload, err := json.Marshal(utils.UserClaims{Username: username})
+	require.NoError(t, err)
+
+	return "e30." + base64.RawURLEncoding.EncodeToString(payload) + ".c2ln"
+}
+
+func TestRateLimitInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const method = "/chat_v1.ChatV1/Create"
+
+	loopback := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 40000}
+	remote := &net.TCPAddr{IP: net.IPv4(203, 0, 113, 7), Port: 40000}
+
+	// callerContext returns the context of a call from the peer with the incoming metadata pairs.
+	callerContext := func(addr net.Addr, kv ...string) context.Context {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+		return metadata.NewIncomingContext(ctx, metadata.Pairs(kv...))
+	}
+
+	tests := []struct {
+		name    string
+		first   context.Context
+		second  context.Context
+		allowed bool
+	}{
+		{
+			name:    "gateway clients with different addresses",
+			first:   callerContext(loopback, "x-forwarded-for", "198.51.100.1"),
+			second:  callerContext(loopback, "x-forwarded-for", "198.51.100.2"),
+			allowed: true,
+		},
+		{
+			name:    "gateway client with a spoofed forwarded address",
+			first:   callerContext(loopback, "x-forwarded-for", "198.51.100.1, 198.51.100.3"),
+			second:  callerContext(loopback, "x-forwarded-for", "198.51.100.2, 198.51.100.3"),
+			allowed: false,
+		},
+		{
+			name:    "forwarded address of a remote peer ignored",
+			first:   callerContext(remote, "x-forwarded-for", "198.51.100.1"),
+			second:  callerContext(remote, "x-forwarded-for", "198.51.100.2"),
+			allowed: false,
+		},
+		{
+			name:    "username looking like a bot key",
+			first:   utils.ContextWithBot(callerContext(remote), &utils.Bot{KeyID: 5}),
+			second:  callerContext(remote, "authorization", "Bearer "+unverifiedToken(t, "key:5")),
+			allowed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			limiter, err := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), map[string]string{method: "0.01/1"}, nil)
+			require.NoError(t, err)
+
+			i := interceptor.RateLimitInterceptor(limiter)
+			info := &grpc.UnaryServerInfo{FullMethod: method}
+			handler := func(_ context.Context, _ any) (any, error) {
+				return gofakeit.Word(), nil
+			}
+
+			_, err = i(tt.first, nil, info, handler)
+			require.NoError(t, err)
+
+			_, err = i(tt.second, nil, info, handler)
+			if tt.allowed {
+				require.NoError(t, err)
+			} else {
+				require.Equal(t, codes.ResourceExhausted, status.Code(err))
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/mikhailsoldatkin/chat-server/internal/interceptor"
+	"github.com/mikhailsoldatkin/chat-server/internal/metric"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecoveryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	res := gofakeit.Sentence(3)
+
+	tests := []struct {
+		name    string
+		handler grpc.UnaryHandler
+		res     any
+		code    codes.Code
+		panics  float64
+	}{
+		{
+			name: "success",
+			handler: func(_ context.Context, _ any) (any, error) {
+				return res, nil
+			},
+			res:  res,
+			code: codes.OK,
+		},
+		{
+			name: "panic",
+			handler: func(_ context.Context, _ any) (any, error) {
+				panic(gofakeit.Sentence(3))
+			},
+			code:   codes.Internal,
+			panics: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			method := fmt.Sprintf("/chat_v1.ChatV1/%s", gofakeit.UUID())
+			info := &grpc.UnaryServerInfo{FullMethod: method}
+
+			got, err := interceptor.RecoveryInterceptor(ctx, nil, info, tt.handler)
+			require.Equal(t, tt.res, got)
+			require.Equal(t, tt.code, status.Code(err))
+			require.Equal(t, tt.panics, panicsTotal(t, method))
+		})
+	}
+}
+
+func TestRecoveryStreamInterceptor(t *testing.T) {
+	t.Parallel()
+
+	method := fmt.Sprintf("/chat_v1.ChatV1/%s", gofakeit.UUID())
+	info := &grpc.StreamServerInfo{FullMethod: method, IsServerStream: true}
+	handler := func(_ any, _ grpc.ServerStream) error {
+		panic(gofakeit.Sentence(3))
+	}
+
+	err := interceptor.RecoveryStreamInterceptor(nil, nil, info, handler)
+	require.Equal(t, codes.Internal, status.Code(err))
+	require.Equal(t, float64(1), panicsTotal(t, method))
+}
+
+// panicsTotal returns the recovered panics counter of the method, zero if no panic was recovered.
+func panicsTotal(t *testing.T, method string) float64 {
+	families, err := metric.Registry().Gather()
+	require.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != "chat_server_grpc_panics_total" {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "method" && l.GetValue() == method {
+					return m.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,213 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/chat-server/internal/client/mocks"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/interceptor"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	grpcHealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	streamService = "test.Stream"
+	watchMethod   = "/test.Stream/Watch"
+	panicMethod   = "/test.Stream/Panic"
+)
+
+// streamServiceDesc describes a server streaming test service, Watch sends back the authorization
+// header forwarded to the auth service, Panic panics.
+var streamServiceDesc = grpc.ServiceDesc{
+	ServiceName: streamService,
+	HandlerType: (*any)(nil),
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			ServerStreams: true,
+			Handler: func(_ any, stream grpc.ServerStream) error {
+				if err := stream.RecvMsg(&emptypb.Empty{}); err != nil {
+					return err
+				}
+				md, _ := metadata.FromOutgoingContext(stream.Context())
+				return stream.SendMsg(wrapperspb.String(strings.Join(md.Get("authorization"), ",")))
+			},
+		},
+		{
+			StreamName:    "Panic",
+			ServerStreams: true,
+			Handler: func(_ any, _ grpc.ServerStream) error {
+				panic(gofakeit.Sentence(3))
+			},
+		},
+	},
+}
+
+// newStreamClient starts an in-memory server with the stream interceptor chain and returns a client connection.
+func newStreamClient(t *testing.T, authClient *mocks.AuthClientMock) *grpc.ClientConn {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler(otelgrpc.WithTracerProvider(sdktrace.NewTracerProvider()))),
+		grpc.ChainStreamInterceptor(
+			interceptor.TraceIDStreamInterceptor,
+			interceptor.LoggingStreamInterceptor(1),
+			interceptor.RecoveryStreamInterceptor,
+			interceptor.AuthStreamInterceptor(authClient, nil),
+		),
+	)
+	server.RegisterService(&streamServiceDesc, struct{}{})
+	healthpb.RegisterHealthServer(server, grpcHealth.NewServer())
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return conn
+}
+
+// watch opens a server stream of the method and receives the first message into res.
+func watch(ctx context.Context, conn *grpc.ClientConn, method string, res proto.Message) (metadata.MD, error) {
+	stream, err := conn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, method)
+	if err != nil {
+		return nil, err
+	}
+	if err = stream.SendMsg(&emptypb.Empty{}); err != nil {
+		return nil, err
+	}
+	if err = stream.CloseSend(); err != nil {
+		return nil, err
+	}
+	if err = stream.RecvMsg(res); err != nil {
+		return nil, err
+	}
+
+	return stream.Header()
+}
+
+func TestStreamInterceptors(t *testing.T) {
+	t.Parallel()
+
+	token := gofakeit.UUID()
+
+	tests := []struct {
+		name      string
+		method    string
+		accessErr error
+		checked   bool
+		code      codes.Code
+	}{
+		{
+			name:    "access granted",
+			method:  watchMethod,
+			checked: true,
+			code:    codes.OK,
+		},
+		{
+			name:      "access denied",
+			method:    watchMethod,
+			accessErr: customerrors.NewPermissionDeniedError(gofakeit.Sentence(3)),
+			checked:   true,
+			code:      codes.PermissionDenied,
+		},
+		{
+			name:    "panic",
+			method:  panicMethod,
+			checked: true,
+			code:    codes.Internal,
+		},
+		{
+			name:   "public method",
+			method: healthpb.Health_Watch_FullMethodName,
+			code:   codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			authClient := mocks.NewAuthClientMock(mc)
+			if tt.checked {
+				authClient.CheckAccessMock.Set(func(_ context.Context, endpoint string) error {
+					require.Equal(t, tt.method, endpoint)
+					return tt.accessErr
+				})
+			}
+
+			conn := newStreamClient(t, authClient)
+			ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+
+			res := &wrapperspb.StringValue{}
+			header, err := watch(ctx, conn, tt.method, res)
+			require.Equal(t, tt.code, status.Code(err))
+			if err != nil {
+				return
+			}
+
+			require.Len(t, header.Get("x-trace-id"), 1)
+			if tt.method == watchMethod {
+				require.Equal(t, "Bearer "+token, res.GetValue())
+			}
+		})
+	}
+}
+
+func TestLoggingStreamInterceptor(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	authClient := mocks.NewAuthClientMock(mc).
+		CheckAccessMock.Return(customerrors.NewPermissionDeniedError(gofakeit.Sentence(3)))
+
+	conn := newStreamClient(t, authClient)
+
+	_, err := watch(context.Background(), conn, watchMethod, &wrapperspb.StringValue{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// the server writes the log line after the status is sent to the client
+	require.Eventually(t, func() bool {
+		return logs.FilterField(zap.String("method", watchMethod)).
+			FilterField(zap.String("code", codes.PermissionDenied.String())).Len() > 0
+	}, time.Second, 10*time.Millisecond)
+
+	entry := logs.FilterField(zap.String("method", watchMethod)).
+		FilterField(zap.String("code", codes.PermissionDenied.String())).All()[0]
+	fields := entry.ContextMap()
+	require.Equal(t, true, fields["stream"])
+	require.NotEmpty(t, fields["trace_id"])
+}
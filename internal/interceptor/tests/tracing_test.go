@@ -0,0 +1,90 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/interceptor"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// transportStream records the headers set by the server, like the gRPC transport it refuses to set headers
+// once they are sent.
+type transportStream struct {
+	header metadata.MD
+	sent   bool
+}
+
+func (s *transportStream) Method() string {
+	return "/chat_v1.ChatV1/Test"
+}
+
+func (s *transportStream) SetHeader(md metadata.MD) error {
+	if s.sent {
+		return errors.New("headers already sent")
+	}
+
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *transportStream) SendHeader(md metadata.MD) error {
+	err := s.SetHeader(md)
+	s.sent = true
+
+	return err
+}
+
+func (s *transportStream) SetTrailer(_ metadata.MD) error {
+	return nil
+}
+
+func TestTraceIDInterceptor(t *testing.T) {
+	t.Parallel()
+
+	traceID := trace.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  trace.SpanID{1, 2, 3, 4, 5, 6, 7, 8},
+	})
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		header []string
+	}{
+		{
+			name:   "with trace",
+			ctx:    trace.ContextWithSpanContext(context.Background(), spanContext),
+			header: []string{traceID.String()},
+		},
+		{
+			name:   "without trace",
+			ctx:    context.Background(),
+			header: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			stream := &transportStream{}
+			ctx := grpc.NewContextWithServerTransportStream(tt.ctx, stream)
+			info := &grpc.UnaryServerInfo{FullMethod: stream.Method()}
+			handler := func(ctx context.Context, _ any) (any, error) {
+				// headers are still set further in the chain
+				return nil, grpc.SetHeader(ctx, metadata.Pairs("retry-after", "1"))
+			}
+
+			_, err := interceptor.TraceIDInterceptor(ctx, nil, info, handler)
+			require.NoError(t, err)
+			require.Equal(t, tt.header, stream.header.Get("x-trace-id"))
+			require.Equal(t, []string{"1"}, stream.header.Get("retry-after"))
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/mikhailsoldatkin/chat-server/internal/interceptor"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidateInterceptor(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx  = context.Background()
+		info = &grpc.UnaryServerInfo{FullMethod: "/chat_v1.ChatV1/Test"}
+
+		userID = int64(gofakeit.Number(1, 1000000))
+		chatID = int64(gofakeit.Number(1, 1000000))
+	)
+
+	tests := []struct {
+		name   string
+		req    any
+		fields []string
+	}{
+		{
+			name: "valid request",
+			req: &pb.SendMessageRequest{
+				ChatId:   chatID,
+				FromUser: userID,
+				Text:     gofakeit.Sentence(5),
+			},
+			fields: nil,
+		},
+		{
+			name:   "empty message",
+			req:    &pb.SendMessageRequest{},
+			fields: []string{"chat_id", "from_user", "text"},
+		},
+		{
+			name:   "no users",
+			req:    &pb.CreateRequest{},
+			fields: []string{"users_ids"},
+		},
+		{
+			name:   "duplicate and negative users",
+			req:    &pb.CreateRequest{UsersIds: []int64{userID, userID, -1}},
+			fields: []string{"users_ids[1]", "users_ids[2]"},
+		},
+		{
+			name:   "zero id",
+			req:    &pb.DeleteRequest{},
+			fields: []string{"id"},
+		},
+		{
+			name:   "unknown action",
+			req:    &pb.ResolveReportRequest{Id: chatID},
+			fields: []string{"action"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			called := false
+			handler := func(_ context.Context, _ any) (any, error) {
+				called = true
+				return nil, nil
+			}
+
+			_, err := interceptor.ValidateInterceptor(ctx, tt.req, info, handler)
+			if tt.fields == nil {
+				require.NoError(t, err)
+				require.True(t, called)
+				return
+			}
+
+			require.False(t, called)
+
+			st := status.Convert(err)
+			require.Equal(t, codes.InvalidArgument, st.Code())
+			require.Len(t, st.Details(), 1)
+
+			badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+			require.True(t, ok)
+
+			fields := make([]string, 0, len(badRequest.GetFieldViolations()))
+			for _, v := range badRequest.GetFieldViolations() {
+				require.NotEmpty(t, v.GetDescription())
+				fields = append(fields, v.GetField())
+			}
+			require.Equal(t, tt.fields, fields)
+		})
+	}
+}
@@ -3,37 +3,39 @@ package interceptor
 import (
 	"context"
 
-	"github.com/opentracing/opentracing-go"
-	"github.com/opentracing/opentracing-go/ext"
-	"github.com/uber/jaeger-client-go"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
 
 const traceIDKey = "x-trace-id"
 
-// ServerTracingInterceptor is a gRPC unary interceptor that integrates OpenTracing for distributed tracing.
-// It starts a new span for each gRPC method call, extracts trace information, and attaches the trace ID to the outgoing gRPC context and response header.
-func ServerTracingInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, info.FullMethod)
-	defer span.Finish()
-
-	spanContext, ok := span.Context().(jaeger.SpanContext)
-
-	if ok {
-		ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(traceIDKey, spanContext.TraceID().String()))
-		header := metadata.New(map[string]string{traceIDKey: spanContext.TraceID().String()})
-		err := grpc.SendHeader(ctx, header)
+// TraceIDInterceptor is a gRPC unary interceptor returning the ID of the trace the request is handled in
+// to the client in the x-trace-id response header. The server span itself is started by the OpenTelemetry
+// stats handler of the server. The header is set, not sent, so that the interceptors and the handler further
+// in the chain can add their own headers, e.g. retry-after.
+func TraceIDInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if spanContext.HasTraceID() {
+		err := grpc.SetHeader(ctx, metadata.Pairs(traceIDKey, spanContext.TraceID().String()))
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	res, err := handler(ctx, req)
-	if err != nil {
-		ext.Error.Set(span, true)
-		span.SetTag("err", err.Error())
+	return handler(ctx, req)
+}
+
+// TraceIDStreamInterceptor is a gRPC stream interceptor returning the ID of the trace the stream is handled in
+// to the client in the x-trace-id response header.
+func TraceIDStreamInterceptor(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	spanContext := trace.SpanContextFromContext(ss.Context())
+	if spanContext.HasTraceID() {
+		err := ss.SetHeader(metadata.Pairs(traceIDKey, spanContext.TraceID().String()))
+		if err != nil {
+			return err
+		}
 	}
 
-	return res, err
+	return handler(srv, ss)
 }
@@ -0,0 +1,102 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// validator is implemented by request messages generated with validation rules.
+type validator interface {
+	ValidateAll() error
+}
+
+// multiError is implemented by errors aggregating all rule violations of a message.
+type multiError interface {
+	AllErrors() []error
+}
+
+// fieldError is implemented by a single rule violation of a message field.
+type fieldError interface {
+	Field() string
+	Reason() string
+}
+
+// ValidateInterceptor is a gRPC unary interceptor that checks the request against the validation rules
+// declared in the proto file and rejects invalid requests with InvalidArgument and google.rpc.BadRequest
+// field violations.
+func ValidateInterceptor(
+	ctx context.Context,
+	req any,
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	if val, ok := req.(validator); ok {
+		if err := val.ValidateAll(); err != nil {
+			return nil, invalidArgument(req, err)
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+// invalidArgument builds an InvalidArgument status with a field violation for every broken rule.
+func invalidArgument(req any, err error) error {
+	errs := []error{err}
+	var me multiError
+	if errors.As(err, &me) {
+		errs = me.AllErrors()
+	}
+
+	badRequest := &errdetails.BadRequest{
+		FieldViolations: make([]*errdetails.BadRequest_FieldViolation, 0, len(errs)),
+	}
+	for _, e := range errs {
+		violation := &errdetails.BadRequest_FieldViolation{Description: e.Error()}
+
+		var fe fieldError
+		if errors.As(e, &fe) {
+			violation.Field = protoFieldPath(req, fe.Field())
+			violation.Description = fe.Reason()
+		}
+
+		badRequest.FieldViolations = append(badRequest.FieldViolations, violation)
+	}
+
+	st, errDetails := status.New(codes.InvalidArgument, "invalid request").WithDetails(badRequest)
+	if errDetails != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	return st.Err()
+}
+
+// protoFieldPath converts a Go field path reported by the generated validators, e.g. UsersIds[1],
+// into the proto field path, e.g. users_ids[1].
+func protoFieldPath(req any, field string) string {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return field
+	}
+
+	name, suffix := field, ""
+	if i := strings.IndexByte(field, '['); i >= 0 {
+		name, suffix = field[:i], field[i:]
+	}
+
+	fields := msg.ProtoReflect().Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if strings.EqualFold(fd.JSONName(), name) {
+			return string(fd.Name()) + suffix
+		}
+	}
+
+	return field
+}
@@ -5,8 +5,9 @@ import (
 	"go.uber.org/zap/zapcore"
 )
 
-var globalLogger *zap.Logger
-var sugarLogger *zap.SugaredLogger
+// globalLogger and sugarLogger discard all entries until Init is called.
+var globalLogger = zap.NewNop()
+var sugarLogger = globalLogger.Sugar()
 
 // Init initializes the global logger with the provided zap core and options.
 // It also sets up the sugared logger for use with formatted logging.
@@ -0,0 +1,100 @@
+package logger
+
+import (
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Redacted replaces the values of sensitive fields in logged messages.
+const Redacted = "[REDACTED]"
+
+// sensitiveFields are the names of message fields holding user content or credentials.
+var sensitiveFields = map[protoreflect.Name]struct{}{
+	"text":   {},
+	"reason": {},
+}
+
+// sensitiveSubstrings mark credential fields whatever their exact name is.
+var sensitiveSubstrings = []string{"token", "password", "secret"}
+
+// Proto constructs a field with the message encoded as JSON, the values of the fields holding user content
+// or credentials are replaced with Redacted.
+func Proto(key string, msg proto.Message) zap.Field {
+	if msg == nil {
+		return zap.Skip()
+	}
+
+	redacted := proto.Clone(msg)
+	redact(redacted.ProtoReflect())
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(redacted)
+	if err != nil {
+		return zap.String(key, Redacted)
+	}
+
+	return zap.Reflect(key, jsonValue(data))
+}
+
+// isSensitive reports whether the field name belongs to a field holding user content or credentials.
+func isSensitive(name string) bool {
+	name = strings.ToLower(name)
+	if _, ok := sensitiveFields[protoreflect.Name(name)]; ok {
+		return true
+	}
+
+	for _, s := range sensitiveSubstrings {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redact replaces the sensitive fields of the message and its nested messages in place.
+func redact(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if isSensitive(string(fd.Name())) {
+			redactField(m, fd)
+			return true
+		}
+
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				redact(list.Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				redact(mv.Message())
+				return true
+			})
+		case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+			redact(v.Message())
+		}
+
+		return true
+	})
+}
+
+// redactField replaces a string field with Redacted and clears fields of other kinds.
+func redactField(m protoreflect.Message, fd protoreflect.FieldDescriptor) {
+	if fd.Kind() == protoreflect.StringKind && !fd.IsList() && !fd.IsMap() {
+		m.Set(fd, protoreflect.ValueOfString(Redacted))
+		return
+	}
+
+	m.Clear(fd)
+}
+
+// jsonValue is an encoded JSON value logged as is.
+type jsonValue []byte
+
+func (v jsonValue) MarshalJSON() ([]byte, error) {
+	return v, nil
+}
@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/mikhailsoldatkin/chat-server/internal/logger"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestProto(t *testing.T) {
+	t.Parallel()
+
+	var (
+		chatID    = int64(gofakeit.Number(1, 1000000))
+		userID    = int64(gofakeit.Number(1, 1000000))
+		messageID = int64(gofakeit.Number(1, 1000000))
+		text      = gofakeit.Sentence(5)
+	)
+
+	tests := []struct {
+		name     string
+		msg      proto.Message
+		expected map[string]any
+	}{
+		{
+			name: "message text",
+			msg:  &pb.SendMessageRequest{ChatId: chatID, FromUser: userID, Text: text},
+			expected: map[string]any{
+				"chat_id":   strconv.FormatInt(chatID, 10),
+				"from_user": strconv.FormatInt(userID, 10),
+				"text":      logger.Redacted,
+			},
+		},
+		{
+			name: "report reason",
+			msg:  &pb.ReportMessageRequest{MessageId: messageID, FromUser: userID, Reason: text},
+			expected: map[string]any{
+				"message_id": strconv.FormatInt(messageID, 10),
+				"from_user":  strconv.FormatInt(userID, 10),
+				"reason":     logger.Redacted,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			enc := zapcore.NewMapObjectEncoder()
+			logger.Proto("request", tt.msg).AddTo(enc)
+
+			data, err := json.Marshal(enc.Fields["request"])
+			require.NoError(t, err)
+			require.NotContains(t, string(data), text)
+
+			// int64 fields are encoded as strings
+			var got map[string]any
+			require.NoError(t, json.Unmarshal(data, &got))
+			require.Equal(t, tt.expected, got)
+		})
+	}
+}
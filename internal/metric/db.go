@@ -0,0 +1,97 @@
+package metric
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+)
+
+var (
+	_ db.Client = (*dbClient)(nil)
+	_ db.DB     = (*instrumentedDB)(nil)
+)
+
+type dbClient struct {
+	client db.Client
+	db     db.DB
+}
+
+// NewDBClient wraps the database client so that the duration of every query is recorded by query name.
+func NewDBClient(client db.Client) db.Client {
+	return &dbClient{
+		client: client,
+		db:     &instrumentedDB{DB: client.DB()},
+	}
+}
+
+func (c *dbClient) DB() db.DB {
+	return c.db
+}
+
+func (c *dbClient) Close() error {
+	return c.client.Close()
+}
+
+// instrumentedDB records query durations, transactions, pings and closing are passed through as is.
+type instrumentedDB struct {
+	db.DB
+}
+
+func (d *instrumentedDB) ScanOneContext(ctx context.Context, dest interface{}, q db.Query, args ...interface{}) error {
+	start := time.Now()
+	err := d.DB.ScanOneContext(ctx, dest, q, args...)
+	ObserveDBQuery(q.Name, err, time.Since(start))
+
+	return err
+}
+
+func (d *instrumentedDB) ScanAllContext(ctx context.Context, dest interface{}, q db.Query, args ...interface{}) error {
+	start := time.Now()
+	err := d.DB.ScanAllContext(ctx, dest, q, args...)
+	ObserveDBQuery(q.Name, err, time.Since(start))
+
+	return err
+}
+
+func (d *instrumentedDB) ExecContext(ctx context.Context, q db.Query, args ...interface{}) (pgconn.CommandTag, error) {
+	start := time.Now()
+	tag, err := d.DB.ExecContext(ctx, q, args...)
+	ObserveDBQuery(q.Name, err, time.Since(start))
+
+	return tag, err
+}
+
+func (d *instrumentedDB) QueryContext(ctx context.Context, q db.Query, args ...interface{}) (pgx.Rows, error) {
+	start := time.Now()
+	rows, err := d.DB.QueryContext(ctx, q, args...)
+	ObserveDBQuery(q.Name, err, time.Since(start))
+
+	return rows, err
+}
+
+// QueryRowContext defers the query until the row is scanned, so the duration is recorded on Scan.
+func (d *instrumentedDB) QueryRowContext(ctx context.Context, q db.Query, args ...interface{}) pgx.Row {
+	start := time.Now()
+
+	return &instrumentedRow{
+		row:   d.DB.QueryRowContext(ctx, q, args...),
+		name:  q.Name,
+		start: start,
+	}
+}
+
+type instrumentedRow struct {
+	row   pgx.Row
+	name  string
+	start time.Time
+}
+
+func (r *instrumentedRow) Scan(dest ...interface{}) error {
+	err := r.row.Scan(dest...)
+	ObserveDBQuery(r.name, err, time.Since(r.start))
+
+	return err
+}
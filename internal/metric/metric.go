@@ -0,0 +1,255 @@
+package metric
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc/status"
+)
+
+const namespace = "chat_server"
+
+// Stream transports reported by the active streams gauge.
+const (
+	TransportGRPC      = "grpc"
+	TransportWebSocket = "websocket"
+)
+
+// Query statuses reported by the DB query duration histogram.
+const (
+	queryStatusOK    = "ok"
+	queryStatusError = "error"
+)
+
+var registry = prometheus.NewRegistry()
+
+var (
+	grpcRequestsTotal = promauto.With(registry).NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "requests_total",
+			Help:      "Number of gRPC requests handled by the server by method and status code.",
+		},
+		[]string{"method", "code"},
+	)
+
+	grpcRequestDuration = promauto.With(registry).NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "request_duration_seconds",
+			Help:      "Duration of gRPC requests handled by the server by method and status code.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"method", "code"},
+	)
+
+	panicsTotal = promauto.With(registry).NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "panics_total",
+			Help:      "Number of panics recovered in gRPC handlers by method.",
+		},
+		[]string{"method"},
+	)
+
+	activeStreams = promauto.With(registry).NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "active_streams",
+			Help:      "Number of open client streams by transport.",
+		},
+		[]string{"transport"},
+	)
+
+	dbQueryDuration = promauto.With(registry).NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "db",
+			Name:      "query_duration_seconds",
+			Help:      "Duration of database queries by query name and status.",
+			Buckets:   []float64{0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5},
+		},
+		[]string{"query", "status"},
+	)
+
+	authRequestDuration = promauto.With(registry).NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "auth",
+			Name:      "request_duration_seconds",
+			Help:      "Duration of authentication service calls by method and status code.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"method", "code"},
+	)
+
+	authErrorsTotal = promauto.With(registry).NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "auth",
+			Name:      "errors_total",
+			Help:      "Number of failed authentication service calls by method and status code.",
+		},
+		[]string{"method", "code"},
+	)
+
+	authCacheRequestsTotal = promauto.With(registry).NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "auth",
+			Name:      "cache_requests_total",
+			Help:      "Number of authentication checks answered from the cache (hit) or by the service (miss) by method.",
+		},
+		[]string{"method", "result"},
+	)
+
+	authRetriesTotal = promauto.With(registry).NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "auth",
+			Name:      "retries_total",
+			Help:      "Number of retried authentication service calls by method.",
+		},
+		[]string{"method"},
+	)
+
+	authCircuitOpen = promauto.With(registry).NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "auth",
+			Name:      "circuit_open",
+			Help:      "Whether the circuit breaker rejects authentication service calls.",
+		},
+	)
+
+	messagesSentTotal = promauto.With(registry).NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "messages_sent_total",
+			Help:      "Number of messages sent to chats.",
+		},
+	)
+
+	chatsCreatedTotal = promauto.With(registry).NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "chats_created_total",
+			Help:      "Number of chats created.",
+		},
+	)
+
+	moderationRejectionsTotal = promauto.With(registry).NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "moderation",
+			Name:      "rejections_total",
+			Help:      "Number of messages rejected by moderation by rule.",
+		},
+		[]string{"rule"},
+	)
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// Handler returns the HTTP handler exposing the metrics in the Prometheus format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{Registry: registry})
+}
+
+// Registry returns the registry holding the chat server metrics.
+func Registry() *prometheus.Registry {
+	return registry
+}
+
+// ObserveGRPCRequest records a gRPC request handled by the server.
+func ObserveGRPCRequest(method string, err error, duration time.Duration) {
+	code := status.Code(err).String()
+	grpcRequestsTotal.WithLabelValues(method, code).Inc()
+	grpcRequestDuration.WithLabelValues(method, code).Observe(duration.Seconds())
+}
+
+// IncPanics increments the number of panics recovered in handlers of the method.
+func IncPanics(method string) {
+	panicsTotal.WithLabelValues(method).Inc()
+}
+
+// StreamOpened increments the number of open streams of the transport.
+func StreamOpened(transport string) {
+	activeStreams.WithLabelValues(transport).Inc()
+}
+
+// StreamClosed decrements the number of open streams of the transport.
+func StreamClosed(transport string) {
+	activeStreams.WithLabelValues(transport).Dec()
+}
+
+// ObserveDBQuery records a database query executed by the repositories.
+func ObserveDBQuery(name string, err error, duration time.Duration) {
+	queryStatus := queryStatusOK
+	// no rows is an expected result of lookups and existence checks rather than a failed query
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		queryStatus = queryStatusError
+	}
+	dbQueryDuration.WithLabelValues(name, queryStatus).Observe(duration.Seconds())
+}
+
+// ObserveAuthRequest records a call to the authentication service.
+func ObserveAuthRequest(method string, err error, duration time.Duration) {
+	code := status.Code(err).String()
+	authRequestDuration.WithLabelValues(method, code).Observe(duration.Seconds())
+	if err != nil {
+		authErrorsTotal.WithLabelValues(method, code).Inc()
+	}
+}
+
+// ObserveAuthCache records an authentication check answered from the cache or passed to the service.
+func ObserveAuthCache(method string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	authCacheRequestsTotal.WithLabelValues(method, result).Inc()
+}
+
+// IncAuthRetries increments the number of retried authentication service calls of the method.
+func IncAuthRetries(method string) {
+	authRetriesTotal.WithLabelValues(method).Inc()
+}
+
+// SetAuthCircuitOpen records whether the circuit breaker rejects authentication service calls.
+func SetAuthCircuitOpen(open bool) {
+	if open {
+		authCircuitOpen.Set(1)
+		return
+	}
+	authCircuitOpen.Set(0)
+}
+
+// IncMessagesSent increments the number of messages sent.
+func IncMessagesSent() {
+	messagesSentTotal.Inc()
+}
+
+// IncChatsCreated increments the number of chats created.
+func IncChatsCreated() {
+	chatsCreatedTotal.Inc()
+}
+
+// IncModerationRejections increments the number of messages rejected by the moderation rule.
+func IncModerationRejections(rule string) {
+	moderationRejectionsTotal.WithLabelValues(rule).Inc()
+}
@@ -0,0 +1,241 @@
+package moderation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	actionReject   = "reject"
+	actionMask     = "mask"
+	actionCollapse = "collapse"
+
+	maskRune           = '*'
+	defaultReplacement = "***"
+)
+
+var linkRegexp = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+`)
+
+// Filter checks a message text and either allows it as is, rewrites it or rejects it.
+type Filter interface {
+	// Name returns the filter name used in logs and rejection counters.
+	Name() string
+	// Apply returns the possibly rewritten text, or a non-empty reason if the text must be rejected.
+	Apply(text string) (string, string)
+}
+
+type maxLengthFilter struct {
+	name string
+	max  int
+}
+
+// NewMaxLengthFilter creates a filter that rejects texts longer than maxLength characters.
+func NewMaxLengthFilter(name string, maxLength int) Filter {
+	return &maxLengthFilter{name: name, max: maxLength}
+}
+
+func (f *maxLengthFilter) Name() string {
+	return f.name
+}
+
+func (f *maxLengthFilter) Apply(text string) (string, string) {
+	if utf8.RuneCountInString(text) > f.max {
+		return text, fmt.Sprintf("message is longer than %d characters", f.max)
+	}
+
+	return text, ""
+}
+
+type bannedWordsFilter struct {
+	name   string
+	words  map[string]struct{}
+	action string
+}
+
+// NewBannedWordsFilter creates a filter that rejects or masks texts containing any of the words, ignoring case.
+func NewBannedWordsFilter(name string, words []string, action string) (Filter, error) {
+	if action != actionReject && action != actionMask {
+		return nil, fmt.Errorf("unsupported action %q for banned words filter %s", action, name)
+	}
+
+	set := make(map[string]struct{}, len(words))
+	for _, w := range words {
+		set[strings.ToLower(w)] = struct{}{}
+	}
+
+	return &bannedWordsFilter{name: name, words: set, action: action}, nil
+}
+
+func (f *bannedWordsFilter) Name() string {
+	return f.name
+}
+
+func (f *bannedWordsFilter) Apply(text string) (string, string) {
+	runes := []rune(text)
+	banned := false
+
+	for start := 0; start < len(runes); {
+		if !isWordRune(runes[start]) {
+			start++
+			continue
+		}
+
+		end := start
+		for end < len(runes) && isWordRune(runes[end]) {
+			end++
+		}
+
+		if _, ok := f.words[strings.ToLower(string(runes[start:end]))]; ok {
+			if f.action == actionReject {
+				return text, "message contains a banned word"
+			}
+			banned = true
+			for i := start; i < end; i++ {
+				runes[i] = maskRune
+			}
+		}
+
+		start = end
+	}
+
+	if !banned {
+		return text, ""
+	}
+
+	return string(runes), ""
+}
+
+type regexFilter struct {
+	name        string
+	re          *regexp.Regexp
+	action      string
+	replacement string
+	reason      string
+}
+
+// NewRegexFilter creates a filter that rejects texts matching the pattern or replaces matches with replacement.
+func NewRegexFilter(name, pattern, action, replacement, reason string) (Filter, error) {
+	if action != actionReject && action != actionMask {
+		return nil, fmt.Errorf("unsupported action %q for regex filter %s", action, name)
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern for regex filter %s: %w", name, err)
+	}
+
+	if replacement == "" {
+		replacement = defaultReplacement
+	}
+	if reason == "" {
+		reason = "message matches a forbidden pattern"
+	}
+
+	return &regexFilter{
+		name:        name,
+		re:          re,
+		action:      action,
+		replacement: replacement,
+		reason:      reason,
+	}, nil
+}
+
+func (f *regexFilter) Name() string {
+	return f.name
+}
+
+func (f *regexFilter) Apply(text string) (string, string) {
+	if !f.re.MatchString(text) {
+		return text, ""
+	}
+
+	if f.action == actionReject {
+		return text, f.reason
+	}
+
+	return f.re.ReplaceAllLiteralString(text, f.replacement), ""
+}
+
+type linkLimitFilter struct {
+	name string
+	max  int
+}
+
+// NewLinkLimitFilter creates a filter that rejects texts containing more than maxLinks links.
+func NewLinkLimitFilter(name string, maxLinks int) Filter {
+	return &linkLimitFilter{name: name, max: maxLinks}
+}
+
+func (f *linkLimitFilter) Name() string {
+	return f.name
+}
+
+func (f *linkLimitFilter) Apply(text string) (string, string) {
+	if len(linkRegexp.FindAllStringIndex(text, f.max+1)) > f.max {
+		return text, fmt.Sprintf("message contains more than %d links", f.max)
+	}
+
+	return text, ""
+}
+
+type repeatedCharsFilter struct {
+	name   string
+	max    int
+	action string
+}
+
+// NewRepeatedCharsFilter creates a filter that rejects texts with a character repeated more than maxRepeated
+// times in a row, or collapses such runs to maxRepeated characters.
+func NewRepeatedCharsFilter(name string, maxRepeated int, action string) (Filter, error) {
+	if action != actionReject && action != actionCollapse {
+		return nil, fmt.Errorf("unsupported action %q for repeated characters filter %s", action, name)
+	}
+
+	return &repeatedCharsFilter{name: name, max: maxRepeated, action: action}, nil
+}
+
+func (f *repeatedCharsFilter) Name() string {
+	return f.name
+}
+
+func (f *repeatedCharsFilter) Apply(text string) (string, string) {
+	var b strings.Builder
+	b.Grow(len(text))
+
+	var prev rune
+	run := 0
+	collapsed := false
+
+	for i, r := range text {
+		if i > 0 && r == prev {
+			run++
+		} else {
+			run = 1
+		}
+		prev = r
+
+		if run > f.max {
+			if f.action == actionReject {
+				return text, fmt.Sprintf("message repeats a character more than %d times in a row", f.max)
+			}
+			collapsed = true
+			continue
+		}
+
+		b.WriteRune(r)
+	}
+
+	if !collapsed {
+		return text, ""
+	}
+
+	return b.String(), ""
+}
+
+// isWordRune reports whether r is a part of a word.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
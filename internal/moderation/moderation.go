@@ -0,0 +1,148 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/logger"
+	"github.com/mikhailsoldatkin/chat-server/internal/metric"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	filterMaxLength     = "max_length"
+	filterBannedWords   = "banned_words"
+	filterRegex         = "regex"
+	filterLinkLimit     = "link_limit"
+	filterRepeatedChars = "repeated_chars"
+)
+
+// Moderator runs outgoing messages through an ordered chain of filters.
+type Moderator interface {
+	// Moderate returns the text of the message the user sends to the chat to be stored,
+	// or a customerrors.ModerationError if the text is rejected.
+	Moderate(ctx context.Context, chatID, userID int64, text string) (string, error)
+}
+
+type moderator struct {
+	filters []Filter
+}
+
+// NewModerator creates a new Moderator applying the filters in the given order.
+func NewModerator(filters ...Filter) Moderator {
+	return &moderator{filters: filters}
+}
+
+// Moderate applies filters one by one, passing the text rewritten by a filter to the next one.
+// Rejections are counted by filter in the moderation rejections metric and logged with the chat, the user
+// and the trace of the request.
+func (m *moderator) Moderate(ctx context.Context, chatID, userID int64, text string) (string, error) {
+	for _, f := range m.filters {
+		var reason string
+		text, reason = f.Apply(text)
+		if reason == "" {
+			continue
+		}
+
+		metric.IncModerationRejections(f.Name())
+
+		fields := []zap.Field{
+			zap.String("filter", f.Name()),
+			zap.String("reason", reason),
+			zap.Int64("chat_id", chatID),
+			zap.Int64("user_id", userID),
+		}
+		if spanContext := trace.SpanContextFromContext(ctx); spanContext.HasTraceID() {
+			fields = append(fields, zap.String("trace_id", spanContext.TraceID().String()))
+		}
+		logger.Warn("message rejected by moderation", fields...)
+
+		return "", customerrors.NewModerationError(f.Name(), reason)
+	}
+
+	return text, nil
+}
+
+// rules represents the moderation rules file.
+type rules struct {
+	Filters []rule `yaml:"filters"`
+}
+
+// rule represents a single filter definition in the moderation rules file.
+type rule struct {
+	Type        string   `yaml:"type"`
+	Name        string   `yaml:"name"`
+	Action      string   `yaml:"action"`
+	Max         int      `yaml:"max"`
+	Words       []string `yaml:"words"`
+	Pattern     string   `yaml:"pattern"`
+	Replacement string   `yaml:"replacement"`
+	Reason      string   `yaml:"reason"`
+}
+
+// NewModeratorFromFile creates a new Moderator with filters defined in the YAML rules file.
+func NewModeratorFromFile(path string) (Moderator, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read moderation rules: %w", err)
+	}
+
+	var r rules
+	if err = yaml.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("failed to parse moderation rules: %w", err)
+	}
+
+	filters := make([]Filter, 0, len(r.Filters))
+	for i, fr := range r.Filters {
+		f, errFilter := fr.filter(i)
+		if errFilter != nil {
+			return nil, errFilter
+		}
+		filters = append(filters, f)
+	}
+
+	return NewModerator(filters...), nil
+}
+
+// filter builds a Filter from the rule with the given position in the rules file.
+func (r rule) filter(pos int) (Filter, error) {
+	name := r.Name
+	if name == "" {
+		name = fmt.Sprintf("%s#%d", r.Type, pos)
+	}
+
+	action := r.Action
+	if action == "" {
+		action = actionReject
+	}
+
+	switch r.Type {
+	case filterMaxLength, filterRepeatedChars:
+		if r.Max < 1 {
+			return nil, fmt.Errorf("filter %s requires a positive max", name)
+		}
+	case filterLinkLimit:
+		if r.Max < 0 {
+			return nil, fmt.Errorf("filter %s requires a non-negative max", name)
+		}
+	}
+
+	switch r.Type {
+	case filterMaxLength:
+		return NewMaxLengthFilter(name, r.Max), nil
+	case filterBannedWords:
+		return NewBannedWordsFilter(name, r.Words, action)
+	case filterRegex:
+		return NewRegexFilter(name, r.Pattern, action, r.Replacement, r.Reason)
+	case filterLinkLimit:
+		return NewLinkLimitFilter(name, r.Max), nil
+	case filterRepeatedChars:
+		return NewRepeatedCharsFilter(name, r.Max, action)
+	default:
+		return nil, fmt.Errorf("unknown moderation filter type %q", r.Type)
+	}
+}
@@ -0,0 +1,153 @@
+package tests
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/metric"
+	"github.com/mikhailsoldatkin/chat-server/internal/moderation"
+	"github.com/stretchr/testify/require"
+)
+
+const rules = `
+filters:
+  - type: max_length
+    max: 40
+  - type: banned_words
+    name: profanity
+    action: mask
+    words: [darn, Блин]
+  - type: regex
+    name: phones
+    action: mask
+    pattern: '\+\d{11}'
+    replacement: '[phone]'
+  - type: link_limit
+    max: 1
+  - type: repeated_chars
+    action: collapse
+    max: 4
+`
+
+func TestModerateFromFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "moderation.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(rules), 0o600))
+
+	moderator, err := moderation.NewModeratorFromFile(path)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		text string
+		want string
+		err  error
+	}{
+		{
+			name: "clean text",
+			text: "hello there",
+			want: "hello there",
+		},
+		{
+			name: "banned words masked ignoring case",
+			text: "DARN it, блин!",
+			want: "**** it, ****!",
+		},
+		{
+			name: "regex replaced",
+			text: "call +79991234567",
+			want: "call [phone]",
+		},
+		{
+			name: "repeated characters collapsed",
+			text: "nooooooo",
+			want: "noooo",
+		},
+		{
+			name: "too many links",
+			text: "https://a.example and www.b.example",
+			err:  customerrors.NewModerationError("link_limit#3", "message contains more than 1 links"),
+		},
+		{
+			name: "too long",
+			text: "this message is definitely longer than forty characters",
+			err:  customerrors.NewModerationError("max_length#0", "message is longer than 40 characters"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, errModerate := moderator.Moderate(context.Background(), gofakeit.Int64(), gofakeit.Int64(), tt.text)
+			require.Equal(t, tt.err, errModerate)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestModeratorRejections(t *testing.T) {
+	t.Parallel()
+
+	rule := "links-" + gofakeit.UUID()
+	moderator := moderation.NewModerator(moderation.NewLinkLimitFilter(rule, 0))
+
+	_, err := moderator.Moderate(context.Background(), gofakeit.Int64(), gofakeit.Int64(), "see https://example.com")
+	require.Error(t, err)
+	_, err = moderator.Moderate(context.Background(), gofakeit.Int64(), gofakeit.Int64(), "no links here")
+	require.NoError(t, err)
+
+	require.Equal(t, float64(1), rejectionsTotal(t, rule), "only the rejection is counted")
+}
+
+// rejectionsTotal returns the moderation rejections counter of the rule, zero if no message was rejected by it.
+func rejectionsTotal(t *testing.T, rule string) float64 {
+	families, err := metric.Registry().Gather()
+	require.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != "chat_server_moderation_rejections_total" {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "rule" && l.GetValue() == rule {
+					return m.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+
+	return 0
+}
+
+func TestNewModeratorFromFileInvalidRules(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		rules string
+	}{
+		{name: "unknown type", rules: "filters:\n  - type: unknown\n"},
+		{name: "invalid action", rules: "filters:\n  - type: banned_words\n    action: collapse\n"},
+		{name: "invalid pattern", rules: "filters:\n  - type: regex\n    pattern: '('\n"},
+		{name: "missing max", rules: "filters:\n  - type: max_length\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "moderation.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.rules), 0o600))
+
+			_, err := moderation.NewModeratorFromFile(path)
+			require.Error(t, err)
+		})
+	}
+}
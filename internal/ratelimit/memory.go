@@ -0,0 +1,85 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval defines how often full buckets are evicted from the stores.
+const sweepInterval = time.Minute
+
+var _ Store = (*memoryStore)(nil)
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+	limit     Limit
+}
+
+type memoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewMemoryStore creates a new in-memory token bucket store. Limits are enforced per process only.
+func NewMemoryStore() Store {
+	return &memoryStore{
+		buckets:   make(map[string]*bucket),
+		lastSweep: time.Now(),
+		now:       time.Now,
+	}
+}
+
+// Take takes one token from the bucket identified by key.
+func (s *memoryStore) Take(_ context.Context, key string, limit Limit) (bool, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), updatedAt: now}
+		s.buckets[key] = b
+	}
+
+	b.limit = limit
+	b.tokens = refill(b.tokens, now.Sub(b.updatedAt), limit)
+	b.updatedAt = now
+
+	if b.tokens < 1 {
+		return false, retryAfter(b.tokens, limit), nil
+	}
+
+	b.tokens--
+
+	return true, 0, nil
+}
+
+// sweep evicts buckets that have been refilled completely, as they are equal to new ones.
+func (s *memoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = now
+
+	for key, b := range s.buckets {
+		if refill(b.tokens, now.Sub(b.updatedAt), b.limit) >= float64(b.limit.Burst) {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+// refill returns the number of tokens in a bucket after the elapsed time.
+func refill(tokens float64, elapsed time.Duration, limit Limit) float64 {
+	return min(float64(limit.Burst), tokens+elapsed.Seconds()*limit.Rate)
+}
+
+// retryAfter returns the time needed for a bucket to get one token.
+func retryAfter(tokens float64, limit Limit) time.Duration {
+	return time.Duration((1 - tokens) / limit.Rate * float64(time.Second))
+}
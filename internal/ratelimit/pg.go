@@ -0,0 +1,111 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/mikhailsoldatkin/chat-server/internal/logger"
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+	"go.uber.org/zap"
+)
+
+const (
+	tableRateLimits = "rate_limits"
+	columnKey       = "key"
+	columnTokens    = "tokens"
+	columnUpdatedAt = "updated_at"
+	columnFullAt    = "full_at"
+)
+
+var _ Store = (*pgStore)(nil)
+
+type pgStore struct {
+	db db.Client
+
+	mu        sync.Mutex
+	lastSweep time.Time
+}
+
+// NewPGStore creates a new Postgres token bucket store, so limits are shared by all server replicas.
+func NewPGStore(db db.Client) Store {
+	return &pgStore{
+		db:        db,
+		lastSweep: time.Now(),
+	}
+}
+
+// Take takes one token from the bucket identified by key atomically in a single statement.
+func (s *pgStore) Take(ctx context.Context, key string, limit Limit) (bool, time.Duration, error) {
+	s.sweep(ctx)
+
+	refilled := fmt.Sprintf(
+		"LEAST($2::double precision, %[1]s.%[2]s + EXTRACT(EPOCH FROM NOW() - %[1]s.%[3]s)::double precision * $3::double precision)",
+		tableRateLimits, columnTokens, columnUpdatedAt,
+	)
+
+	// the bucket is full again once the taken token and the missing ones are refilled
+	query := fmt.Sprintf(
+		`INSERT INTO %[1]s (%[2]s, %[3]s, %[4]s, %[6]s)
+		VALUES ($1, $2::double precision - 1, NOW(), NOW() + INTERVAL '1 second' / $3::double precision)
+		ON CONFLICT (%[2]s) DO UPDATE SET
+			%[3]s = %[5]s - 1,
+			%[4]s = NOW(),
+			%[6]s = NOW() + INTERVAL '1 second' * (($2::double precision - %[5]s + 1) / $3::double precision)
+		WHERE %[5]s >= 1
+		RETURNING %[3]s`,
+		tableRateLimits, columnKey, columnTokens, columnUpdatedAt, refilled, columnFullAt,
+	)
+
+	q := db.Query{
+		Name:     "rate_limit_store.Take",
+		QueryRaw: query,
+	}
+
+	var tokens float64
+	err := s.db.DB().QueryRowContext(ctx, q, key, limit.Burst, limit.Rate).Scan(&tokens)
+	if err == nil {
+		return true, 0, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return false, 0, err
+	}
+
+	qTokens := db.Query{
+		Name:     "rate_limit_store.Tokens",
+		QueryRaw: fmt.Sprintf("SELECT %s FROM %s WHERE %s=$1", refilled, tableRateLimits, columnKey),
+	}
+
+	err = s.db.DB().QueryRowContext(ctx, qTokens, key, limit.Burst, limit.Rate).Scan(&tokens)
+	if err != nil {
+		return false, 0, err
+	}
+
+	return false, retryAfter(tokens, limit), nil
+}
+
+// sweep deletes buckets that have been refilled completely, as they are equal to new ones. A failed sweep
+// doesn't fail the request, the buckets are deleted by the next one.
+func (s *pgStore) sweep(ctx context.Context) {
+	s.mu.Lock()
+	now := time.Now()
+	if now.Sub(s.lastSweep) < sweepInterval {
+		s.mu.Unlock()
+		return
+	}
+	s.lastSweep = now
+	s.mu.Unlock()
+
+	q := db.Query{
+		Name:     "rate_limit_store.Sweep",
+		QueryRaw: fmt.Sprintf("DELETE FROM %s WHERE %s <= NOW()", tableRateLimits, columnFullAt),
+	}
+
+	_, err := s.db.DB().ExecContext(ctx, q)
+	if err != nil {
+		logger.Warn("failed to delete full rate limit buckets", zap.Error(err))
+	}
+}
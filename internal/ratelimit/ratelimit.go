@@ -0,0 +1,104 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	userKeyPrefix = "user"
+	chatKeyPrefix = "chat"
+)
+
+// Limit describes a token bucket which is refilled with Rate tokens per second up to Burst tokens.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Store keeps token buckets and takes tokens from them.
+type Store interface {
+	// Take takes one token from the bucket identified by key. If the bucket is empty,
+	// it returns false and the time after which a token becomes available.
+	Take(ctx context.Context, key string, limit Limit) (bool, time.Duration, error)
+}
+
+// Limiter applies per-method limits to requests of authenticated users and chats.
+type Limiter struct {
+	store      Store
+	userLimits map[string]Limit
+	chatLimits map[string]Limit
+}
+
+// NewLimiter creates a new Limiter with limits in "<tokens per second>/<burst>" format keyed by gRPC full method name.
+func NewLimiter(store Store, userLimits, chatLimits map[string]string) (*Limiter, error) {
+	users, err := parseLimits(userLimits)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user rate limits: %w", err)
+	}
+
+	chats, err := parseLimits(chatLimits)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chat rate limits: %w", err)
+	}
+
+	return &Limiter{
+		store:      store,
+		userLimits: users,
+		chatLimits: chats,
+	}, nil
+}
+
+// AllowUser takes a token from the bucket of the user for the given method.
+func (l *Limiter) AllowUser(ctx context.Context, method, user string) (bool, time.Duration, error) {
+	limit, ok := l.userLimits[method]
+	if !ok {
+		return true, 0, nil
+	}
+
+	return l.store.Take(ctx, bucketKey(userKeyPrefix, user, method), limit)
+}
+
+// AllowChat takes a token from the bucket of the chat for the given method.
+func (l *Limiter) AllowChat(ctx context.Context, method string, chatID int64) (bool, time.Duration, error) {
+	limit, ok := l.chatLimits[method]
+	if !ok {
+		return true, 0, nil
+	}
+
+	return l.store.Take(ctx, bucketKey(chatKeyPrefix, strconv.FormatInt(chatID, 10), method), limit)
+}
+
+// bucketKey builds a store key of a bucket for the given subject and method.
+func bucketKey(prefix, subject, method string) string {
+	return fmt.Sprintf("%s:%s:%s", prefix, subject, method)
+}
+
+// parseLimits parses limits in "<tokens per second>/<burst>" format.
+func parseLimits(raw map[string]string) (map[string]Limit, error) {
+	limits := make(map[string]Limit, len(raw))
+
+	for method, value := range raw {
+		rateStr, burstStr, ok := strings.Cut(value, "/")
+		if !ok {
+			return nil, fmt.Errorf("limit %q for method %s must be in <rate>/<burst> format", value, method)
+		}
+
+		rate, err := strconv.ParseFloat(rateStr, 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("invalid rate %q for method %s", rateStr, method)
+		}
+
+		burst, err := strconv.Atoi(burstStr)
+		if err != nil || burst < 1 {
+			return nil, fmt.Errorf("invalid burst %q for method %s", burstStr, method)
+		}
+
+		limits[method] = Limit{Rate: rate, Burst: burst}
+	}
+
+	return limits, nil
+}
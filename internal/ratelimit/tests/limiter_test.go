@@ -0,0 +1,123 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/mikhailsoldatkin/chat-server/internal/ratelimit"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	sendMessageMethod = "/chat_v1.ChatV1/SendMessage"
+	deleteMethod      = "/chat_v1.ChatV1/Delete"
+)
+
+func TestLimiter(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		user   = gofakeit.Username()
+		chatID = gofakeit.Int64()
+	)
+
+	tests := []struct {
+		name    string
+		calls   int
+		allowed []bool
+		take    func(l *ratelimit.Limiter) (bool, error)
+	}{
+		{
+			name:    "user burst exhausted",
+			calls:   3,
+			allowed: []bool{true, true, false},
+			take: func(l *ratelimit.Limiter) (bool, error) {
+				allowed, _, err := l.AllowUser(ctx, sendMessageMethod, user)
+				return allowed, err
+			},
+		},
+		{
+			name:    "chat burst exhausted",
+			calls:   2,
+			allowed: []bool{true, false},
+			take: func(l *ratelimit.Limiter) (bool, error) {
+				allowed, _, err := l.AllowChat(ctx, sendMessageMethod, chatID)
+				return allowed, err
+			},
+		},
+		{
+			name:    "method without limit",
+			calls:   3,
+			allowed: []bool{true, true, true},
+			take: func(l *ratelimit.Limiter) (bool, error) {
+				allowed, _, err := l.AllowUser(ctx, deleteMethod, user)
+				return allowed, err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			limiter, err := ratelimit.NewLimiter(
+				ratelimit.NewMemoryStore(),
+				map[string]string{sendMessageMethod: "0.001/2"},
+				map[string]string{sendMessageMethod: "0.001/1"},
+			)
+			require.NoError(t, err)
+
+			for i := 0; i < tt.calls; i++ {
+				allowed, errTake := tt.take(limiter)
+				require.NoError(t, errTake)
+				require.Equal(t, tt.allowed[i], allowed)
+			}
+		})
+	}
+}
+
+func TestLimiterRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	limiter, err := ratelimit.NewLimiter(
+		ratelimit.NewMemoryStore(),
+		map[string]string{sendMessageMethod: "0.5/1"},
+		nil,
+	)
+	require.NoError(t, err)
+
+	user := gofakeit.Username()
+
+	allowed, _, err := limiter.AllowUser(context.Background(), sendMessageMethod, user)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	allowed, wait, err := limiter.AllowUser(context.Background(), sendMessageMethod, user)
+	require.NoError(t, err)
+	require.False(t, allowed)
+	require.Greater(t, wait.Seconds(), 1.0)
+}
+
+func TestNewLimiterInvalidLimits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		limits map[string]string
+	}{
+		{name: "missing burst", limits: map[string]string{sendMessageMethod: "5"}},
+		{name: "invalid rate", limits: map[string]string{sendMessageMethod: "abc/5"}},
+		{name: "zero burst", limits: map[string]string{sendMessageMethod: "5/0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), tt.limits, nil)
+			require.Error(t, err)
+		})
+	}
+}
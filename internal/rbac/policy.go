@@ -0,0 +1,110 @@
+package rbac
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// AnyRole allows a method to callers with any role.
+const AnyRole = "*"
+
+// Policy tells which roles may call gRPC methods. Methods missing from the policy are denied. Chat rules further
+// restrict methods called on particular chats to some of the roles.
+type Policy struct {
+	methods map[string]roleSet
+	chats   map[int64]map[string]roleSet
+}
+
+// roleSet is a set of role names in lower case.
+type roleSet map[string]struct{}
+
+func newRoleSet(roles []string) roleSet {
+	set := make(roleSet, len(roles))
+	for _, role := range roles {
+		set[strings.ToLower(role)] = struct{}{}
+	}
+
+	return set
+}
+
+func (s roleSet) has(role string) bool {
+	if _, ok := s[AnyRole]; ok {
+		return true
+	}
+	_, ok := s[strings.ToLower(role)]
+
+	return ok
+}
+
+// policyFile represents the policy file.
+type policyFile struct {
+	Methods map[string][]string `yaml:"methods"`
+	Chats   []chatRule          `yaml:"chats"`
+}
+
+// chatRule represents the role requirements of the methods called on a chat in the policy file.
+type chatRule struct {
+	ID      int64               `yaml:"id"`
+	Methods map[string][]string `yaml:"methods"`
+}
+
+// NewPolicyFromFile creates a new Policy with the rules defined in the YAML policy file.
+func NewPolicyFromFile(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read access policy: %w", err)
+	}
+
+	var f policyFile
+	if err = yaml.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse access policy: %w", err)
+	}
+
+	p := &Policy{
+		methods: make(map[string]roleSet, len(f.Methods)),
+		chats:   make(map[int64]map[string]roleSet, len(f.Chats)),
+	}
+	for method, roles := range f.Methods {
+		p.methods[method] = newRoleSet(roles)
+	}
+
+	for i, rule := range f.Chats {
+		if rule.ID <= 0 {
+			return nil, fmt.Errorf("chat rule #%d must have a positive chat id", i)
+		}
+
+		methods := make(map[string]roleSet, len(rule.Methods))
+		for method, roles := range rule.Methods {
+			if _, ok := p.methods[method]; !ok {
+				return nil, fmt.Errorf("chat %d rule refers to method %s missing from the policy methods", rule.ID, method)
+			}
+			methods[method] = newRoleSet(roles)
+		}
+		p.chats[rule.ID] = methods
+	}
+
+	return p, nil
+}
+
+// Allowed reports whether a caller with the role may call the method, on the chat with chatID unless it is zero.
+func (p *Policy) Allowed(role, method string, chatID int64) bool {
+	if role == "" {
+		return false
+	}
+
+	roles, ok := p.methods[method]
+	if !ok || !roles.has(role) {
+		return false
+	}
+
+	if chatID == 0 {
+		return true
+	}
+
+	chatRoles, ok := p.chats[chatID][method]
+
+	return !ok || chatRoles.has(role)
+}
@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/rbac"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	create      = "/chat_v1.ChatV1/Create"
+	sendMessage = "/chat_v1.ChatV1/SendMessage"
+	listReports = "/chat_v1.ChatV1/ListReports"
+	unknown     = "/chat_v1.ChatV1/Unknown"
+
+	announcements = int64(1)
+	otherChat     = int64(2)
+)
+
+const policyYAML = `
+methods:
+  /chat_v1.ChatV1/Create: ["*"]
+  /chat_v1.ChatV1/SendMessage: [admin, user]
+  /chat_v1.ChatV1/ListReports: [ADMIN]
+chats:
+  - id: 1
+    methods:
+      /chat_v1.ChatV1/SendMessage: [admin]
+`
+
+// writeFile writes the data to a file in a temporary directory and returns its path.
+func writeFile(t *testing.T, name, data string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+
+	return path
+}
+
+func TestPolicyAllowed(t *testing.T) {
+	t.Parallel()
+
+	policy, err := rbac.NewPolicyFromFile(writeFile(t, "policy.yaml", policyYAML))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		role    string
+		method  string
+		chatID  int64
+		allowed bool
+	}{
+		{name: "any role", role: "guest", method: create, allowed: true},
+		{name: "listed role", role: "user", method: sendMessage, allowed: true},
+		{name: "role case ignored", role: "admin", method: listReports, allowed: true},
+		{name: "role not listed", role: "user", method: listReports, allowed: false},
+		{name: "no role", role: "", method: create, allowed: false},
+		{name: "method not listed", role: "admin", method: unknown, allowed: false},
+		{name: "chat rule allows", role: "admin", method: sendMessage, chatID: announcements, allowed: true},
+		{name: "chat rule denies", role: "user", method: sendMessage, chatID: announcements, allowed: false},
+		{name: "chat without rules", role: "user", method: sendMessage, chatID: otherChat, allowed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.allowed, policy.Allowed(tt.role, tt.method, tt.chatID))
+		})
+	}
+}
+
+func TestNewPolicyFromFileErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		yaml string
+	}{
+		{
+			name: "invalid yaml",
+			yaml: "methods: [",
+		},
+		{
+			name: "chat without id",
+			yaml: "methods:\n  /a.B/C: [admin]\nchats:\n  - methods:\n      /a.B/C: [admin]\n",
+		},
+		{
+			name: "chat rule of unknown method",
+			yaml: "methods:\n  /a.B/C: [admin]\nchats:\n  - id: 1\n    methods:\n      /a.B/D: [admin]\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := rbac.NewPolicyFromFile(writeFile(t, "policy.yaml", tt.yaml))
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package tests
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/mikhailsoldatkin/chat-server/internal/rbac"
+	"github.com/stretchr/testify/require"
+)
+
+// signToken signs an access token of the role expiring at exp.
+func signToken(t *testing.T, method jwt.SigningMethod, key any, kid, role string, exp time.Time) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(method, rbac.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(exp)},
+		Username:         gofakeit.Username(),
+		Role:             role,
+	})
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+
+	return signed
+}
+
+// publicKeyPEM encodes the public key in PEM.
+func publicKeyPEM(t *testing.T, key any) string {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+// ecJWKS encodes the ECDSA P-256 public key as a JWKS with the key ID.
+func ecJWKS(t *testing.T, key *ecdsa.PublicKey, kid string) string {
+	t.Helper()
+
+	data, err := json.Marshal(map[string]any{
+		"keys": []map[string]string{{
+			"kty": "EC",
+			"kid": kid,
+			"crv": "P-256",
+			"x":   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+			"y":   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+		}},
+	})
+	require.NoError(t, err)
+
+	return string(data)
+}
+
+func TestVerifier(t *testing.T) {
+	t.Parallel()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	kid := gofakeit.UUID()
+	verifier, err := rbac.NewVerifierFromFiles(
+		writeFile(t, "public.pem", publicKeyPEM(t, &rsaKey.PublicKey)),
+		writeFile(t, "jwks.json", ecJWKS(t, &ecKey.PublicKey, kid)),
+	)
+	require.NoError(t, err)
+
+	var (
+		role    = gofakeit.RandomString([]string{"admin", "user"})
+		valid   = time.Now().Add(time.Hour)
+		expired = time.Now().Add(-time.Hour)
+	)
+
+	tests := []struct {
+		name  string
+		token string
+		valid bool
+	}{
+		{
+			name:  "public key",
+			token: signToken(t, jwt.SigningMethodRS256, rsaKey, "", role, valid),
+			valid: true,
+		},
+		{
+			name:  "jwks key",
+			token: signToken(t, jwt.SigningMethodES256, ecKey, kid, role, valid),
+			valid: true,
+		},
+		{
+			name:  "expired",
+			token: signToken(t, jwt.SigningMethodRS256, rsaKey, "", role, expired),
+			valid: false,
+		},
+		{
+			name:  "untrusted key",
+			token: signToken(t, jwt.SigningMethodRS256, otherKey, "", role, valid),
+			valid: false,
+		},
+		{
+			name:  "symmetric algorithm",
+			token: signToken(t, jwt.SigningMethodHS256, []byte(gofakeit.Password(true, true, true, false, false, 32)), "", role, valid),
+			valid: false,
+		},
+		{
+			name:  "malformed",
+			token: gofakeit.UUID(),
+			valid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			claims, err := verifier.Verify(tt.token)
+			if !tt.valid {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, role, claims.Role)
+		})
+	}
+}
+
+func TestNewVerifierFromFilesWithoutKeys(t *testing.T) {
+	t.Parallel()
+
+	_, err := rbac.NewVerifierFromFiles("", "")
+	require.Error(t, err)
+}
@@ -0,0 +1,220 @@
+package rbac
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// signingMethods are the asymmetric algorithms accepted for access tokens.
+var signingMethods = []string{
+	"RS256", "RS384", "RS512",
+	"PS256", "PS384", "PS512",
+	"ES256", "ES384", "ES512",
+	"EdDSA",
+}
+
+// Claims represents the claims of an access token issued by the authentication service.
+type Claims struct {
+	jwt.RegisteredClaims
+	Username string `json:"username"`
+	Role     string `json:"role"`
+}
+
+// Verifier verifies the signatures and the expiration of access tokens.
+type Verifier struct {
+	key  crypto.PublicKey
+	keys map[string]crypto.PublicKey
+}
+
+// NewVerifierFromFiles creates a new Verifier trusting the public key in the PEM file, the keys in the JWKS file
+// selected by the key ID in the token header, or both. At least one of the files must be given.
+func NewVerifierFromFiles(publicKeyFile, jwksFile string) (*Verifier, error) {
+	if publicKeyFile == "" && jwksFile == "" {
+		return nil, errors.New("either a public key or a JWKS file is required to verify access tokens")
+	}
+
+	v := &Verifier{}
+
+	if publicKeyFile != "" {
+		key, err := readPublicKey(publicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		v.key = key
+	}
+
+	if jwksFile != "" {
+		keys, err := readJWKS(jwksFile)
+		if err != nil {
+			return nil, err
+		}
+		v.keys = keys
+	}
+
+	return v, nil
+}
+
+// Verify returns the claims of the access token if it is signed with a trusted key and hasn't expired.
+func (v *Verifier) Verify(token string) (*Claims, error) {
+	var claims Claims
+
+	_, err := jwt.ParseWithClaims(
+		token,
+		&claims,
+		v.keyFor,
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &claims, nil
+}
+
+// keyFor returns the key the token must be signed with.
+func (v *Verifier) keyFor(token *jwt.Token) (any, error) {
+	if kid, ok := token.Header["kid"].(string); ok && v.keys != nil {
+		if key, found := v.keys[kid]; found {
+			return key, nil
+		}
+	}
+
+	if v.key == nil {
+		return nil, errors.New("no trusted key for the token")
+	}
+
+	return v.key, nil
+}
+
+// readPublicKey reads the RSA, ECDSA or Ed25519 public key from the PEM file.
+func readPublicKey(path string) (crypto.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read public key: %w", err)
+	}
+
+	if key, errRSA := jwt.ParseRSAPublicKeyFromPEM(data); errRSA == nil {
+		return key, nil
+	}
+	if key, errEC := jwt.ParseECPublicKeyFromPEM(data); errEC == nil {
+		return key, nil
+	}
+	if key, errEd := jwt.ParseEdPublicKeyFromPEM(data); errEd == nil {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("failed to parse public key %s: unsupported key type", path)
+}
+
+// jwks represents a JSON Web Key Set.
+type jwks struct {
+	Keys []jwk `json:"keys"`
+}
+
+// jwk represents a public JSON Web Key.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// readJWKS reads the public keys from the JWKS file by their key IDs.
+func readJWKS(path string) (map[string]crypto.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWKS: %w", err)
+	}
+
+	var set jwks
+	if err = json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for i, k := range set.Keys {
+		key, errKey := k.publicKey()
+		if errKey != nil {
+			return nil, fmt.Errorf("failed to parse JWKS key #%d: %w", i, errKey)
+		}
+		keys[k.Kid] = key
+	}
+
+	return keys, nil
+}
+
+// publicKey decodes the public key.
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 key size")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+// decodeInt decodes a base64url encoded big-endian integer.
+func decodeInt(s string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(data), nil
+}
@@ -0,0 +1,133 @@
+package replica
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+)
+
+var (
+	_ db.Client    = (*client)(nil)
+	_ db.DB        = (*routedDB)(nil)
+	_ db.TxManager = (*txManager)(nil)
+)
+
+type (
+	readOnlyKey    struct{}
+	transactionKey struct{}
+)
+
+// ReadOnly marks the queries made with the context as read-only, they are sent to a replica unless they run
+// in a transaction. Only reads tolerating the replication lag should be marked, e.g. listings.
+func ReadOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readOnlyKey{}, true)
+}
+
+func isReadOnly(ctx context.Context) bool {
+	readOnly, _ := ctx.Value(readOnlyKey{}).(bool)
+	inTx, _ := ctx.Value(transactionKey{}).(bool)
+
+	return readOnly && !inTx
+}
+
+type client struct {
+	primary  db.Client
+	replicas []db.Client
+	db       db.DB
+}
+
+// NewClient creates a database client sending queries to the primary, except the read-only ones which are
+// spread over the replicas in turn. Without replicas all the queries go to the primary.
+func NewClient(primary db.Client, replicas ...db.Client) db.Client {
+	if len(replicas) == 0 {
+		return primary
+	}
+
+	dbs := make([]db.DB, len(replicas))
+	for i, r := range replicas {
+		dbs[i] = r.DB()
+	}
+
+	return &client{
+		primary:  primary,
+		replicas: replicas,
+		db:       &routedDB{DB: primary.DB(), replicas: dbs},
+	}
+}
+
+func (c *client) DB() db.DB {
+	return c.db
+}
+
+func (c *client) Close() error {
+	errs := []error{c.primary.Close()}
+	for _, r := range c.replicas {
+		errs = append(errs, r.Close())
+	}
+
+	return errors.Join(errs...)
+}
+
+// routedDB sends read-only queries to a replica, everything else including transactions and pings
+// goes to the primary.
+type routedDB struct {
+	db.DB
+	replicas []db.DB
+	next     atomic.Uint64
+}
+
+func (d *routedDB) pick(ctx context.Context) db.DB {
+	if !isReadOnly(ctx) {
+		return d.DB
+	}
+
+	n := d.next.Add(1)
+	return d.replicas[n%uint64(len(d.replicas))]
+}
+
+func (d *routedDB) ScanOneContext(ctx context.Context, dest interface{}, q db.Query, args ...interface{}) error {
+	return d.pick(ctx).ScanOneContext(ctx, dest, q, args...)
+}
+
+func (d *routedDB) ScanAllContext(ctx context.Context, dest interface{}, q db.Query, args ...interface{}) error {
+	return d.pick(ctx).ScanAllContext(ctx, dest, q, args...)
+}
+
+func (d *routedDB) ExecContext(ctx context.Context, q db.Query, args ...interface{}) (pgconn.CommandTag, error) {
+	return d.pick(ctx).ExecContext(ctx, q, args...)
+}
+
+func (d *routedDB) QueryContext(ctx context.Context, q db.Query, args ...interface{}) (pgx.Rows, error) {
+	return d.pick(ctx).QueryContext(ctx, q, args...)
+}
+
+func (d *routedDB) QueryRowContext(ctx context.Context, q db.Query, args ...interface{}) pgx.Row {
+	return d.pick(ctx).QueryRowContext(ctx, q, args...)
+}
+
+func (d *routedDB) Close() {
+	d.DB.Close()
+	for _, r := range d.replicas {
+		r.Close()
+	}
+}
+
+type txManager struct {
+	db.TxManager
+}
+
+// NewTxManager wraps the transaction manager so that queries made in transactions stay on the primary,
+// even when marked read-only.
+func NewTxManager(manager db.TxManager) db.TxManager {
+	return &txManager{TxManager: manager}
+}
+
+func (m *txManager) ReadCommitted(ctx context.Context, f db.Handler) error {
+	return m.TxManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		return f(context.WithValue(ctx, transactionKey{}, true))
+	})
+}
@@ -0,0 +1,85 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/mikhailsoldatkin/chat-server/internal/replica"
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClient is a database client whose queries scan the name of the server.
+type fakeClient struct {
+	name string
+}
+
+func (c *fakeClient) DB() db.DB {
+	return fakeDB{name: c.name}
+}
+
+func (c *fakeClient) Close() error {
+	return nil
+}
+
+type fakeDB struct {
+	db.DB
+	name string
+}
+
+func (d fakeDB) ScanOneContext(_ context.Context, dest interface{}, _ db.Query, _ ...interface{}) error {
+	*dest.(*string) = d.name
+	return nil
+}
+
+// fakeTxManager runs the handler without a transaction.
+type fakeTxManager struct{}
+
+func (fakeTxManager) ReadCommitted(ctx context.Context, f db.Handler) error {
+	return f(ctx)
+}
+
+// server returns the name of the server the query made with the context is sent to.
+func server(ctx context.Context, t *testing.T, client db.Client) string {
+	var name string
+	require.NoError(t, client.DB().ScanOneContext(ctx, &name, db.Query{Name: gofakeit.Word()}))
+	return name
+}
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := replica.NewClient(
+		&fakeClient{name: "primary"},
+		&fakeClient{name: "replica1"},
+		&fakeClient{name: "replica2"},
+	)
+
+	require.Equal(t, "primary", server(ctx, t, client))
+
+	readOnly := replica.ReadOnly(ctx)
+	seen := map[string]int{}
+	for range 4 {
+		seen[server(readOnly, t, client)]++
+	}
+	require.Equal(t, map[string]int{"replica1": 2, "replica2": 2}, seen, "reads must be spread over the replicas")
+
+	err := replica.NewTxManager(fakeTxManager{}).ReadCommitted(readOnly, func(ctx context.Context) error {
+		require.Equal(t, "primary", server(ctx, t, client), "transactions must stay on the primary")
+		require.Equal(t, "primary", server(replica.ReadOnly(ctx), t, client))
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestClientWithoutReplicas(t *testing.T) {
+	t.Parallel()
+
+	primary := &fakeClient{name: "primary"}
+	client := replica.NewClient(primary)
+
+	require.Same(t, primary, client)
+	require.Equal(t, "primary", server(replica.ReadOnly(context.Background()), t, client))
+}
@@ -0,0 +1,34 @@
+package converter
+
+import (
+	modelRepo "github.com/mikhailsoldatkin/chat-server/internal/repository/apikey/model"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/apikey/model"
+)
+
+// FromRepoToService converter from Postgres repository APIKey model to service APIKey model.
+func FromRepoToService(key *modelRepo.APIKey) *model.APIKey {
+	return &model.APIKey{
+		ID:         key.ID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Hash:       key.KeyHash,
+		UserID:     key.UserID,
+		ChatIDs:    key.ChatIDs,
+		Methods:    key.Methods,
+		CreatedBy:  key.CreatedBy,
+		CreatedAt:  key.CreatedAt,
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
+		RevokedAt:  key.RevokedAt,
+	}
+}
+
+// FromRepoToServiceList converts list of Postgres repository APIKey models to list of service APIKey models.
+func FromRepoToServiceList(keys []*modelRepo.APIKey) []*model.APIKey {
+	res := make([]*model.APIKey, len(keys))
+	for i, key := range keys {
+		res[i] = FromRepoToService(key)
+	}
+
+	return res
+}
@@ -0,0 +1,137 @@
+package apikey
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/repository"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/apikey/model"
+)
+
+var _ repository.APIKeyRepository = (*memoryRepo)(nil)
+
+type memoryRepo struct {
+	mu sync.RWMutex
+	// keys are ordered by ID, which is also the creation order.
+	keys []*model.APIKey
+}
+
+// NewMemoryRepository creates a new instance of the API keys repository keeping the keys in memory, for running
+// the server without a database. It is safe for concurrent use, the keys are lost on restart.
+func NewMemoryRepository() repository.APIKeyRepository {
+	return &memoryRepo{}
+}
+
+// Create stores a new API key and returns its ID.
+func (r *memoryRepo) Create(_ context.Context, key *model.APIKey) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := clone(key)
+	stored.ID = int64(len(r.keys)) + 1
+	stored.CreatedAt = time.Now()
+	r.keys = append(r.keys, stored)
+
+	return stored.ID, nil
+}
+
+// GetByHash retrieves an API key by the hash of the key.
+func (r *memoryRepo) GetByHash(_ context.Context, hash string) (*model.APIKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, key := range r.keys {
+		if key.Hash == hash {
+			return clone(key), nil
+		}
+	}
+
+	return nil, customerrors.NewUnauthenticatedError(invalidKeyReason)
+}
+
+// List retrieves API keys, including revoked and expired ones, oldest first.
+func (r *memoryRepo) List(_ context.Context, limit, offset int64) ([]*model.APIKey, error) {
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	total := int64(len(r.keys))
+	from, to := min(offset, total), min(offset+limit, total)
+
+	res := make([]*model.APIKey, 0, to-from)
+	for _, key := range r.keys[from:to] {
+		res = append(res, clone(key))
+	}
+
+	return res, nil
+}
+
+// Revoke marks an API key as revoked, revoking a revoked key keeps its original revocation time.
+func (r *memoryRepo) Revoke(_ context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key, ok := r.get(id)
+	if !ok {
+		return customerrors.NewNotFoundError(apiKeyEntity, id)
+	}
+
+	if key.RevokedAt == nil {
+		now := time.Now()
+		key.RevokedAt = &now
+	}
+
+	return nil
+}
+
+// TouchLastUsed records the use of an API key.
+func (r *memoryRepo) TouchLastUsed(_ context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if key, ok := r.get(id); ok {
+		now := time.Now()
+		key.LastUsedAt = &now
+	}
+
+	return nil
+}
+
+// get returns the stored key by ID, the caller must hold the lock.
+func (r *memoryRepo) get(id int64) (*model.APIKey, bool) {
+	if id < 1 || id > int64(len(r.keys)) {
+		return nil, false
+	}
+
+	return r.keys[id-1], true
+}
+
+// clone returns a copy of the key not sharing memory with it.
+func clone(key *model.APIKey) *model.APIKey {
+	res := *key
+	res.ChatIDs = slices.Clone(key.ChatIDs)
+	res.Methods = slices.Clone(key.Methods)
+	res.ExpiresAt = cloneTime(key.ExpiresAt)
+	res.LastUsedAt = cloneTime(key.LastUsedAt)
+	res.RevokedAt = cloneTime(key.RevokedAt)
+
+	return &res
+}
+
+func cloneTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	res := *t
+	return &res
+}
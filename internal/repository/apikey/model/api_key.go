@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// APIKey represents an API key entity in the Postgres database.
+type APIKey struct {
+	ID         int64      `db:"id"`
+	Name       string     `db:"name"`
+	Prefix     string     `db:"prefix"`
+	KeyHash    string     `db:"key_hash"`
+	UserID     int64      `db:"user_id"`
+	ChatIDs    []int64    `db:"chat_ids"`
+	Methods    []string   `db:"methods"`
+	CreatedBy  string     `db:"created_by"`
+	CreatedAt  time.Time  `db:"created_at"`
+	ExpiresAt  *time.Time `db:"expires_at"`
+	LastUsedAt *time.Time `db:"last_used_at"`
+	RevokedAt  *time.Time `db:"revoked_at"`
+}
@@ -0,0 +1,222 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/replica"
+	"github.com/mikhailsoldatkin/chat-server/internal/repository"
+	"github.com/mikhailsoldatkin/chat-server/internal/repository/apikey/converter"
+	modelRepo "github.com/mikhailsoldatkin/chat-server/internal/repository/apikey/model"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/apikey/model"
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+)
+
+const (
+	tableAPIKeys     = "api_keys"
+	columnID         = "id"
+	columnName       = "name"
+	columnPrefix     = "prefix"
+	columnKeyHash    = "key_hash"
+	columnUserID     = "user_id"
+	columnChatIDs    = "chat_ids"
+	columnMethods    = "methods"
+	columnCreatedBy  = "created_by"
+	columnCreatedAt  = "created_at"
+	columnExpiresAt  = "expires_at"
+	columnLastUsedAt = "last_used_at"
+	columnRevokedAt  = "revoked_at"
+	apiKeyEntity     = "api key"
+	invalidKeyReason = "invalid API key"
+
+	defaultPageSize = 10
+
+	// lastUsedPrecision limits how often the last use of a key is written, as keys are used on every request.
+	lastUsedPrecision = "1 minute"
+)
+
+var _ repository.APIKeyRepository = (*repo)(nil)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of the API keys Postgres repository.
+func NewRepository(db db.Client) repository.APIKeyRepository {
+	return &repo{db: db}
+}
+
+// selectAPIKeys returns a builder selecting API keys.
+func selectAPIKeys() sq.SelectBuilder {
+	return sq.Select(
+		columnID,
+		columnName,
+		columnPrefix,
+		columnKeyHash,
+		columnUserID,
+		columnChatIDs,
+		columnMethods,
+		columnCreatedBy,
+		columnCreatedAt,
+		columnExpiresAt,
+		columnLastUsedAt,
+		columnRevokedAt,
+	).
+		From(tableAPIKeys).
+		PlaceholderFormat(sq.Dollar)
+}
+
+// Create stores a new API key and returns its ID.
+func (r *repo) Create(ctx context.Context, key *model.APIKey) (int64, error) {
+	chatIDs := key.ChatIDs
+	if chatIDs == nil {
+		chatIDs = []int64{}
+	}
+
+	builder := sq.Insert(tableAPIKeys).
+		PlaceholderFormat(sq.Dollar).
+		Columns(
+			columnName, columnPrefix, columnKeyHash, columnUserID,
+			columnChatIDs, columnMethods, columnCreatedBy, columnExpiresAt,
+		).
+		Values(
+			key.Name, key.Prefix, key.Hash, key.UserID,
+			chatIDs, key.Methods, key.CreatedBy, key.ExpiresAt,
+		).
+		Suffix("RETURNING id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "api_key_repository.Create",
+		QueryRaw: query,
+	}
+
+	var id int64
+	err = r.db.DB().ScanOneContext(ctx, &id, q, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// GetByHash retrieves an API key by the hash of the key.
+func (r *repo) GetByHash(ctx context.Context, hash string) (*model.APIKey, error) {
+	query, args, err := selectAPIKeys().Where(sq.Eq{columnKeyHash: hash}).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "api_key_repository.GetByHash",
+		QueryRaw: query,
+	}
+
+	var key modelRepo.APIKey
+	err = r.db.DB().ScanOneContext(ctx, &key, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, customerrors.NewUnauthenticatedError(invalidKeyReason)
+		}
+		return nil, err
+	}
+
+	return converter.FromRepoToService(&key), nil
+}
+
+// List retrieves API keys, including revoked and expired ones, oldest first. Served by a replica if any.
+func (r *repo) List(ctx context.Context, limit, offset int64) ([]*model.APIKey, error) {
+	ctx = replica.ReadOnly(ctx)
+
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	query, args, err := selectAPIKeys().
+		OrderBy(columnCreatedAt, columnID).
+		Limit(uint64(limit)).
+		Offset(uint64(offset)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "api_key_repository.List",
+		QueryRaw: query,
+	}
+
+	var keys []*modelRepo.APIKey
+	err = r.db.DB().ScanAllContext(ctx, &keys, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromRepoToServiceList(keys), nil
+}
+
+// Revoke marks an API key as revoked, revoking a revoked key keeps its original revocation time.
+func (r *repo) Revoke(ctx context.Context, id int64) error {
+	builder := sq.Update(tableAPIKeys).
+		PlaceholderFormat(sq.Dollar).
+		Set(columnRevokedAt, sq.Expr(fmt.Sprintf("COALESCE(%s, NOW())", columnRevokedAt))).
+		Where(sq.Eq{columnID: id})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "api_key_repository.Revoke",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return customerrors.NewNotFoundError(apiKeyEntity, id)
+	}
+
+	return nil
+}
+
+// TouchLastUsed records the use of an API key, at most once per lastUsedPrecision.
+func (r *repo) TouchLastUsed(ctx context.Context, id int64) error {
+	builder := sq.Update(tableAPIKeys).
+		PlaceholderFormat(sq.Dollar).
+		Set(columnLastUsedAt, sq.Expr("NOW()")).
+		Where(sq.Eq{columnID: id}).
+		Where(sq.Or{
+			sq.Eq{columnLastUsedAt: nil},
+			sq.Expr(fmt.Sprintf("%s < NOW() - INTERVAL '%s'", columnLastUsedAt, lastUsedPrecision)),
+		})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "api_key_repository.TouchLastUsed",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+
+	return err
+}
@@ -0,0 +1,18 @@
+package converter
+
+import (
+	modelRepo "github.com/mikhailsoldatkin/chat-server/internal/repository/chat/model"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/chat/model"
+)
+
+// FromRepoToService converter from Postgres repository Message model to service Message model.
+func FromRepoToService(message *modelRepo.Message) *model.Message {
+	return &model.Message{
+		ID:         message.ID,
+		ChatID:     message.ChatID,
+		FromUser:   message.FromUser,
+		SenderType: message.SenderType,
+		Text:       message.Text,
+		Timestamp:  message.Timestamp,
+	}
+}
@@ -0,0 +1,138 @@
+package chat
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/repository"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/chat/model"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+)
+
+var _ repository.ChatRepository = (*memoryRepo)(nil)
+
+type memoryRepo struct {
+	mu            sync.RWMutex
+	lastChatID    int64
+	lastMessageID int64
+	// chats maps chat IDs to the set of their members.
+	chats    map[int64]map[int64]struct{}
+	messages map[int64]*model.Message
+}
+
+// NewMemoryRepository creates a new instance of the chat repository keeping the data in memory, for running
+// the server without a database. It is safe for concurrent use, the data is lost on restart.
+func NewMemoryRepository() repository.ChatRepository {
+	return &memoryRepo{
+		chats:    make(map[int64]map[int64]struct{}),
+		messages: make(map[int64]*model.Message),
+	}
+}
+
+// IsUserInChat checks if a user is a member of a chat.
+func (r *memoryRepo) IsUserInChat(_ context.Context, userID int64, chatID int64) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if _, ok := r.chats[chatID][userID]; !ok {
+		return customerrors.NewUserNotInChatError(userID, chatID)
+	}
+
+	return nil
+}
+
+// Create adds a new chat with the users.
+func (r *memoryRepo) Create(_ context.Context, users []int64) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	members := make(map[int64]struct{}, len(users))
+	for _, userID := range users {
+		members[userID] = struct{}{}
+	}
+
+	r.lastChatID++
+	r.chats[r.lastChatID] = members
+
+	return r.lastChatID, nil
+}
+
+// Delete removes a chat by ID along with its messages.
+func (r *memoryRepo) Delete(_ context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.chats, id)
+	for msgID, msg := range r.messages {
+		if msg.ChatID == id {
+			delete(r.messages, msgID)
+		}
+	}
+
+	return nil
+}
+
+// SendMessage adds a message from a user or a bot to a chat tagged with the sender type and returns its ID.
+func (r *memoryRepo) SendMessage(_ context.Context, req *pb.SendMessageRequest, senderType string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	members, ok := r.chats[req.GetChatId()]
+	if !ok {
+		return 0, customerrors.NewNotFoundError(chatEntity, req.GetChatId())
+	}
+	if _, ok = members[req.GetFromUser()]; !ok {
+		return 0, customerrors.NewUserNotInChatError(req.GetFromUser(), req.GetChatId())
+	}
+
+	r.lastMessageID++
+	r.messages[r.lastMessageID] = &model.Message{
+		ID:         r.lastMessageID,
+		ChatID:     req.GetChatId(),
+		FromUser:   req.GetFromUser(),
+		SenderType: senderType,
+		Text:       req.GetText(),
+		Timestamp:  time.Now().UTC(),
+	}
+
+	return r.lastMessageID, nil
+}
+
+// GetMessage retrieves a copy of a message by ID.
+func (r *memoryRepo) GetMessage(_ context.Context, id int64) (*model.Message, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	msg, ok := r.messages[id]
+	if !ok {
+		return nil, customerrors.NewNotFoundError(messageEntity, id)
+	}
+
+	res := *msg
+	return &res, nil
+}
+
+// DeleteMessage removes a message by ID.
+func (r *memoryRepo) DeleteMessage(_ context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.messages, id)
+
+	return nil
+}
+
+// RemoveUser removes a user from the members of a chat.
+func (r *memoryRepo) RemoveUser(_ context.Context, chatID int64, userID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.chats[chatID][userID]; !ok {
+		return customerrors.NewUserNotInChatError(userID, chatID)
+	}
+	delete(r.chats[chatID], userID)
+
+	return nil
+}
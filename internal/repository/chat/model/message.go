@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// Message represents a message entity in the Postgres database.
+type Message struct {
+	ID         int64     `db:"id"`
+	ChatID     int64     `db:"chat_id"`
+	FromUser   int64     `db:"from_user"`
+	SenderType string    `db:"sender_type"`
+	Text       string    `db:"text"`
+	Timestamp  time.Time `db:"timestamp"`
+}
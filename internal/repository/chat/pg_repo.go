@@ -10,22 +10,27 @@ import (
 	"github.com/jackc/pgx/v4"
 	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
 	"github.com/mikhailsoldatkin/chat-server/internal/repository"
+	"github.com/mikhailsoldatkin/chat-server/internal/repository/chat/converter"
+	modelRepo "github.com/mikhailsoldatkin/chat-server/internal/repository/chat/model"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/chat/model"
 	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
 	"github.com/mikhailsoldatkin/platform_common/pkg/db"
 )
 
 const (
-	tableChatUsers  = "chat_users"
-	tableChats      = "chats"
-	tableMessages   = "messages"
-	columnID        = "id"
-	columnCreatedAt = "created_at"
-	columnChatID    = "chat_id"
-	columnUserID    = "user_id"
-	columnFromUser  = "from_user"
-	columnTimestamp = "timestamp"
-	columnText      = "text"
-	chatEntity      = "chat"
+	tableChatUsers   = "chat_users"
+	tableChats       = "chats"
+	tableMessages    = "messages"
+	columnID         = "id"
+	columnCreatedAt  = "created_at"
+	columnChatID     = "chat_id"
+	columnUserID     = "user_id"
+	columnFromUser   = "from_user"
+	columnSenderType = "sender_type"
+	columnTimestamp  = "timestamp"
+	columnText       = "text"
+	chatEntity       = "chat"
+	messageEntity    = "message"
 )
 
 var _ repository.ChatRepository = (*repo)(nil)
@@ -59,8 +64,8 @@ func (r *repo) chatExists(ctx context.Context, id int64) error {
 	return nil
 }
 
-// isUserInChat checks if a user is a member of a chat.
-func (r *repo) isUserInChat(ctx context.Context, userID int64, chatID int64) error {
+// IsUserInChat checks if a user is a member of a chat.
+func (r *repo) IsUserInChat(ctx context.Context, userID int64, chatID int64) error {
 	query := fmt.Sprintf(
 		"SELECT 1 FROM %s WHERE %s=$1 AND %s=$2",
 		tableChatUsers, columnChatID, columnUserID,
@@ -174,24 +179,26 @@ func (r *repo) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
-// SendMessage handles sending a message from a user to a chat, creates a message in database.
-func (r *repo) SendMessage(ctx context.Context, req *pb.SendMessageRequest) error {
+// SendMessage handles sending a message from a user or a bot to a chat, creates a message in database
+// tagged with the sender type and returns its ID.
+func (r *repo) SendMessage(ctx context.Context, req *pb.SendMessageRequest, senderType string) (int64, error) {
 	if err := r.chatExists(ctx, req.GetChatId()); err != nil {
-		return err
+		return 0, err
 	}
 
-	if err := r.isUserInChat(ctx, req.GetFromUser(), req.GetChatId()); err != nil {
-		return err
+	if err := r.IsUserInChat(ctx, req.GetFromUser(), req.GetChatId()); err != nil {
+		return 0, err
 	}
 
 	builder := sq.Insert(tableMessages).
 		PlaceholderFormat(sq.Dollar).
-		Columns(columnChatID, columnFromUser, columnText, columnTimestamp).
-		Values(req.GetChatId(), req.GetFromUser(), req.GetText(), time.Now().UTC())
+		Columns(columnChatID, columnFromUser, columnSenderType, columnText, columnTimestamp).
+		Values(req.GetChatId(), req.GetFromUser(), senderType, req.GetText(), time.Now().UTC()).
+		Suffix("RETURNING id")
 
 	query, args, err := builder.ToSql()
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	q := db.Query{
@@ -199,6 +206,62 @@ func (r *repo) SendMessage(ctx context.Context, req *pb.SendMessageRequest) erro
 		QueryRaw: query,
 	}
 
+	var id int64
+	err = r.db.DB().ScanOneContext(ctx, &id, q, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// GetMessage retrieves a message by ID from the database.
+func (r *repo) GetMessage(ctx context.Context, id int64) (*model.Message, error) {
+	builder := sq.Select(columnID, columnChatID, columnFromUser, columnSenderType, columnText, columnTimestamp).
+		From(tableMessages).
+		Where(sq.Eq{columnID: id}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.GetMessage",
+		QueryRaw: query,
+	}
+
+	var msg modelRepo.Message
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(
+		&msg.ID, &msg.ChatID, &msg.FromUser, &msg.SenderType, &msg.Text, &msg.Timestamp,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, customerrors.NewNotFoundError(messageEntity, id)
+		}
+		return nil, err
+	}
+
+	return converter.FromRepoToService(&msg), nil
+}
+
+// DeleteMessage removes a message by ID from the database.
+func (r *repo) DeleteMessage(ctx context.Context, id int64) error {
+	builder := sq.Delete(tableMessages).
+		Where(sq.Eq{columnID: id}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.DeleteMessage",
+		QueryRaw: query,
+	}
+
 	_, err = r.db.DB().ExecContext(ctx, q, args...)
 	if err != nil {
 		return err
@@ -206,3 +269,31 @@ func (r *repo) SendMessage(ctx context.Context, req *pb.SendMessageRequest) erro
 
 	return nil
 }
+
+// RemoveUser removes a user from the members of a chat.
+func (r *repo) RemoveUser(ctx context.Context, chatID int64, userID int64) error {
+	builder := sq.Delete(tableChatUsers).
+		Where(sq.Eq{columnChatID: chatID, columnUserID: userID}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.RemoveUser",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return customerrors.NewUserNotInChatError(userID, chatID)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/repository/chat"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/chat/model"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryRepository(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx  = context.Background()
+		repo = chat.NewMemoryRepository()
+
+		member   = gofakeit.Int64()
+		stranger = member + 1
+		text     = gofakeit.Sentence(5)
+	)
+
+	chatID, err := repo.Create(ctx, []int64{member})
+	require.NoError(t, err)
+	require.NoError(t, repo.IsUserInChat(ctx, member, chatID))
+	require.Equal(t, customerrors.NewUserNotInChatError(stranger, chatID), repo.IsUserInChat(ctx, stranger, chatID))
+
+	msgID, err := repo.SendMessage(ctx, &pb.SendMessageRequest{ChatId: chatID, FromUser: member, Text: text}, model.SenderBot)
+	require.NoError(t, err)
+
+	msg, err := repo.GetMessage(ctx, msgID)
+	require.NoError(t, err)
+	require.Equal(t, chatID, msg.ChatID)
+	require.Equal(t, member, msg.FromUser)
+	require.Equal(t, model.SenderBot, msg.SenderType)
+	require.Equal(t, text, msg.Text)
+
+	_, err = repo.SendMessage(ctx, &pb.SendMessageRequest{ChatId: chatID, FromUser: stranger}, model.SenderUser)
+	require.Equal(t, customerrors.NewUserNotInChatError(stranger, chatID), err)
+	_, err = repo.SendMessage(ctx, &pb.SendMessageRequest{ChatId: chatID + 1, FromUser: member}, model.SenderUser)
+	require.Equal(t, customerrors.NewNotFoundError("chat", chatID+1), err)
+
+	require.NoError(t, repo.RemoveUser(ctx, chatID, member))
+	require.Equal(t, customerrors.NewUserNotInChatError(member, chatID), repo.RemoveUser(ctx, chatID, member))
+
+	require.NoError(t, repo.Delete(ctx, chatID))
+	_, err = repo.GetMessage(ctx, msgID)
+	require.Equal(t, customerrors.NewNotFoundError("message", msgID), err, "messages are deleted with the chat")
+}
+
+func TestMemoryRepositoryConcurrency(t *testing.T) {
+	t.Parallel()
+
+	const senders = 10
+
+	var (
+		ctx  = context.Background()
+		repo = chat.NewMemoryRepository()
+		wg   sync.WaitGroup
+	)
+
+	users := make([]int64, senders)
+	for i := range users {
+		users[i] = int64(i + 1)
+	}
+	chatID, err := repo.Create(ctx, users)
+	require.NoError(t, err)
+
+	ids := make(chan int64, senders)
+	errs := make(chan error, senders)
+	for _, userID := range users {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			id, errSend := repo.SendMessage(ctx, &pb.SendMessageRequest{ChatId: chatID, FromUser: userID}, model.SenderUser)
+			if errSend == nil {
+				_, errSend = repo.GetMessage(ctx, id)
+			}
+			ids <- id
+			errs <- errSend
+		}()
+	}
+	wg.Wait()
+	close(ids)
+	close(errs)
+
+	for errSend := range errs {
+		require.NoError(t, errSend)
+	}
+	seen := map[int64]bool{}
+	for id := range ids {
+		require.False(t, seen[id], "message IDs must be unique")
+		seen[id] = true
+	}
+}
@@ -0,0 +1,279 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	embeddedpostgres "github.com/fergusstrange/embedded-postgres"
+	_ "github.com/jackc/pgx/v4/stdlib" // registers the pgx database/sql driver used by goose
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/repository"
+	"github.com/mikhailsoldatkin/chat-server/internal/repository/chat"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/chat/model"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+	"github.com/mikhailsoldatkin/platform_common/pkg/db/pg"
+	"github.com/mikhailsoldatkin/platform_common/pkg/db/transaction"
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/require"
+)
+
+// The suite runs against the Postgres given by TEST_PG_DSN or, if it's not set, against an ephemeral Postgres
+// started for the run. The migrations are applied before the tests.
+const (
+	dsnEnv        = "TEST_PG_DSN"
+	migrationsDir = "../../../../migrations"
+)
+
+var (
+	dbClient  db.Client
+	txManager db.TxManager
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(run(m))
+}
+
+func run(m *testing.M) int {
+	ctx := context.Background()
+
+	dsn := os.Getenv(dsnEnv)
+	if dsn == "" {
+		var (
+			stop func() error
+			err  error
+		)
+		dsn, stop, err = startPostgres()
+		if err != nil {
+			log.Printf("failed to start Postgres: %v", err)
+			return 1
+		}
+		defer func() {
+			if err = stop(); err != nil {
+				log.Printf("failed to stop Postgres: %v", err)
+			}
+		}()
+	}
+
+	if err := migrate(dsn); err != nil {
+		log.Printf("failed to apply migrations: %v", err)
+		return 1
+	}
+
+	cl, err := pg.New(ctx, dsn)
+	if err != nil {
+		log.Printf("failed to create db client: %v", err)
+		return 1
+	}
+	defer func() { _ = cl.Close() }()
+
+	dbClient = cl
+	txManager = transaction.NewTransactionManager(cl.DB())
+
+	return m.Run()
+}
+
+// startPostgres starts an ephemeral Postgres on a free port with the data in a temporary directory.
+func startPostgres() (string, func() error, error) {
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return "", nil, err
+	}
+	port := lis.Addr().(*net.TCPAddr).Port
+	if err = lis.Close(); err != nil {
+		return "", nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "chat-server-pg")
+	if err != nil {
+		return "", nil, err
+	}
+
+	postgres := embeddedpostgres.NewDatabase(embeddedpostgres.DefaultConfig().
+		Port(uint32(port)).
+		Database("chat").
+		Username("chat").
+		Password("chat").
+		RuntimePath(filepath.Join(dir, "runtime")).
+		DataPath(filepath.Join(dir, "data")).
+		Logger(io.Discard))
+	if err = postgres.Start(); err != nil {
+		return "", nil, errors.Join(err, os.RemoveAll(dir))
+	}
+
+	stop := func() error {
+		return errors.Join(postgres.Stop(), os.RemoveAll(dir))
+	}
+	dsn := fmt.Sprintf("host=localhost port=%d dbname=chat user=chat password=chat sslmode=disable", port)
+
+	return dsn, stop, nil
+}
+
+// migrate applies the migrations of the server the way the migrator does.
+func migrate(dsn string) error {
+	sqlDB, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = sqlDB.Close() }()
+
+	if err = goose.SetDialect("postgres"); err != nil {
+		return err
+	}
+	goose.SetLogger(goose.NopLogger())
+
+	return goose.Up(sqlDB, migrationsDir)
+}
+
+// newChat creates a chat of new users and returns its ID and the users.
+func newChat(ctx context.Context, t *testing.T, repo repository.ChatRepository) (int64, []int64) {
+	users := []int64{gofakeit.Int64(), gofakeit.Int64()}
+	chatID, err := repo.Create(ctx, users)
+	require.NoError(t, err)
+
+	return chatID, users
+}
+
+func TestPGCreate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := chat.NewRepository(dbClient)
+
+	chatID, users := newChat(ctx, t, repo)
+	otherID, _ := newChat(ctx, t, repo)
+	require.NotEqual(t, chatID, otherID)
+
+	for _, userID := range users {
+		require.NoError(t, repo.IsUserInChat(ctx, userID, chatID))
+		require.Equal(t, customerrors.NewUserNotInChatError(userID, otherID), repo.IsUserInChat(ctx, userID, otherID))
+	}
+}
+
+func TestPGSendMessage(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := chat.NewRepository(dbClient)
+	chatID, users := newChat(ctx, t, repo)
+	stranger := gofakeit.Int64()
+	text := gofakeit.Sentence(5)
+
+	id, err := repo.SendMessage(ctx, &pb.SendMessageRequest{ChatId: chatID, FromUser: users[0], Text: text}, model.SenderBot)
+	require.NoError(t, err)
+
+	msg, err := repo.GetMessage(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, chatID, msg.ChatID)
+	require.Equal(t, users[0], msg.FromUser)
+	require.Equal(t, model.SenderBot, msg.SenderType)
+	require.Equal(t, text, msg.Text)
+
+	tests := []struct {
+		name string
+		req  *pb.SendMessageRequest
+		err  error
+	}{
+		{
+			name: "chat not found",
+			req:  &pb.SendMessageRequest{ChatId: -chatID, FromUser: users[0], Text: text},
+			err:  customerrors.NewNotFoundError("chat", -chatID),
+		},
+		{
+			name: "user not in chat",
+			req:  &pb.SendMessageRequest{ChatId: chatID, FromUser: stranger, Text: text},
+			err:  customerrors.NewUserNotInChatError(stranger, chatID),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := repo.SendMessage(ctx, tt.req, model.SenderUser)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestPGDeleteCascade(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := chat.NewRepository(dbClient)
+	chatID, users := newChat(ctx, t, repo)
+
+	msgID, err := repo.SendMessage(ctx, &pb.SendMessageRequest{ChatId: chatID, FromUser: users[0], Text: "hi"}, model.SenderUser)
+	require.NoError(t, err)
+
+	require.NoError(t, repo.Delete(ctx, chatID))
+
+	_, err = repo.GetMessage(ctx, msgID)
+	require.Equal(t, customerrors.NewNotFoundError("message", msgID), err, "messages are deleted with the chat")
+	for _, userID := range users {
+		require.Equal(t, customerrors.NewUserNotInChatError(userID, chatID), repo.IsUserInChat(ctx, userID, chatID))
+	}
+}
+
+func TestPGRemoveUser(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := chat.NewRepository(dbClient)
+	chatID, users := newChat(ctx, t, repo)
+
+	require.NoError(t, repo.RemoveUser(ctx, chatID, users[0]))
+	require.Equal(t, customerrors.NewUserNotInChatError(users[0], chatID), repo.RemoveUser(ctx, chatID, users[0]))
+	require.NoError(t, repo.IsUserInChat(ctx, users[1], chatID))
+}
+
+func TestPGTransaction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := chat.NewRepository(dbClient)
+	errRollback := errors.New("rollback")
+
+	var rolledBack struct {
+		chatID int64
+		users  []int64
+	}
+	err := txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		rolledBack.chatID, rolledBack.users = newChat(ctx, t, repo)
+		_, errSend := repo.SendMessage(
+			ctx,
+			&pb.SendMessageRequest{ChatId: rolledBack.chatID, FromUser: rolledBack.users[0], Text: "hi"},
+			model.SenderUser,
+		)
+		require.NoError(t, errSend)
+
+		return errRollback
+	})
+	require.ErrorIs(t, err, errRollback)
+	require.Equal(
+		t,
+		customerrors.NewUserNotInChatError(rolledBack.users[0], rolledBack.chatID),
+		repo.IsUserInChat(ctx, rolledBack.users[0], rolledBack.chatID),
+		"the chat created in the failed transaction must be rolled back",
+	)
+
+	var committedID int64
+	var committedUsers []int64
+	err = txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		committedID, committedUsers = newChat(ctx, t, repo)
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, repo.IsUserInChat(ctx, committedUsers[0], committedID))
+}
@@ -2,3 +2,5 @@ package repository
 
 //go:generate sh -c "rm -rf mocks && mkdir -p mocks"
 //go:generate minimock -i ChatRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i ReportRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i APIKeyRepository -o ./mocks/ -s "_minimock.go"
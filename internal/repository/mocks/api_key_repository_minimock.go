@@ -0,0 +1,1753 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/mikhailsoldatkin/chat-server/internal/repository.APIKeyRepository -o api_key_repository_minimock.go -n APIKeyRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	apiKeyModel "github.com/mikhailsoldatkin/chat-server/internal/service/apikey/model"
+)
+
+// APIKeyRepositoryMock implements repository.APIKeyRepository
+type APIKeyRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, key *apiKeyModel.APIKey) (i1 int64, err error)
+	inspectFuncCreate   func(ctx context.Context, key *apiKeyModel.APIKey)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mAPIKeyRepositoryMockCreate
+
+	funcGetByHash          func(ctx context.Context, hash string) (ap1 *apiKeyModel.APIKey, err error)
+	inspectFuncGetByHash   func(ctx context.Context, hash string)
+	afterGetByHashCounter  uint64
+	beforeGetByHashCounter uint64
+	GetByHashMock          mAPIKeyRepositoryMockGetByHash
+
+	funcList          func(ctx context.Context, limit int64, offset int64) (apa1 []*apiKeyModel.APIKey, err error)
+	inspectFuncList   func(ctx context.Context, limit int64, offset int64)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mAPIKeyRepositoryMockList
+
+	funcRevoke          func(ctx context.Context, id int64) (err error)
+	inspectFuncRevoke   func(ctx context.Context, id int64)
+	afterRevokeCounter  uint64
+	beforeRevokeCounter uint64
+	RevokeMock          mAPIKeyRepositoryMockRevoke
+
+	funcTouchLastUsed          func(ctx context.Context, id int64) (err error)
+	inspectFuncTouchLastUsed   func(ctx context.Context, id int64)
+	afterTouchLastUsedCounter  uint64
+	beforeTouchLastUsedCounter uint64
+	TouchLastUsedMock          mAPIKeyRepositoryMockTouchLastUsed
+}
+
+// NewAPIKeyRepositoryMock returns a mock for repository.APIKeyRepository
+func NewAPIKeyRepositoryMock(t minimock.Tester) *APIKeyRepositoryMock {
+	m := &APIKeyRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mAPIKeyRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*APIKeyRepositoryMockCreateParams{}
+
+	m.GetByHashMock = mAPIKeyRepositoryMockGetByHash{mock: m}
+	m.GetByHashMock.callArgs = []*APIKeyRepositoryMockGetByHashParams{}
+
+	m.ListMock = mAPIKeyRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*APIKeyRepositoryMockListParams{}
+
+	m.RevokeMock = mAPIKeyRepositoryMockRevoke{mock: m}
+	m.RevokeMock.callArgs = []*APIKeyRepositoryMockRevokeParams{}
+
+	m.TouchLastUsedMock = mAPIKeyRepositoryMockTouchLastUsed{mock: m}
+	m.TouchLastUsedMock.callArgs = []*APIKeyRepositoryMockTouchLastUsedParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAPIKeyRepositoryMockCreate struct {
+	optional           bool
+	mock               *APIKeyRepositoryMock
+	defaultExpectation *APIKeyRepositoryMockCreateExpectation
+	expectations       []*APIKeyRepositoryMockCreateExpectation
+
+	callArgs []*APIKeyRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// APIKeyRepositoryMockCreateExpectation specifies expectation struct of the APIKeyRepository.Create
+type APIKeyRepositoryMockCreateExpectation struct {
+	mock      *APIKeyRepositoryMock
+	params    *APIKeyRepositoryMockCreateParams
+	paramPtrs *APIKeyRepositoryMockCreateParamPtrs
+	results   *APIKeyRepositoryMockCreateResults
+	Counter   uint64
+}
+
+// APIKeyRepositoryMockCreateParams contains parameters of the APIKeyRepository.Create
+type APIKeyRepositoryMockCreateParams struct {
+	ctx context.Context
+	key *apiKeyModel.APIKey
+}
+
+// APIKeyRepositoryMockCreateParamPtrs contains pointers to parameters of the APIKeyRepository.Create
+type APIKeyRepositoryMockCreateParamPtrs struct {
+	ctx *context.Context
+	key **apiKeyModel.APIKey
+}
+
+// APIKeyRepositoryMockCreateResults contains results of the APIKeyRepository.Create
+type APIKeyRepositoryMockCreateResults struct {
+	i1  int64
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mAPIKeyRepositoryMockCreate) Optional() *mAPIKeyRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for APIKeyRepository.Create
+func (mmCreate *mAPIKeyRepositoryMockCreate) Expect(ctx context.Context, key *apiKeyModel.APIKey) *mAPIKeyRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("APIKeyRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &APIKeyRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("APIKeyRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &APIKeyRepositoryMockCreateParams{ctx, key}
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for APIKeyRepository.Create
+func (mmCreate *mAPIKeyRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mAPIKeyRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("APIKeyRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &APIKeyRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("APIKeyRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &APIKeyRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreate
+}
+
+// ExpectKeyParam2 sets up expected param key for APIKeyRepository.Create
+func (mmCreate *mAPIKeyRepositoryMockCreate) ExpectKeyParam2(key *apiKeyModel.APIKey) *mAPIKeyRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("APIKeyRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &APIKeyRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("APIKeyRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &APIKeyRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.key = &key
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the APIKeyRepository.Create
+func (mmCreate *mAPIKeyRepositoryMockCreate) Inspect(f func(ctx context.Context, key *apiKeyModel.APIKey)) *mAPIKeyRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for APIKeyRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by APIKeyRepository.Create
+func (mmCreate *mAPIKeyRepositoryMockCreate) Return(i1 int64, err error) *APIKeyRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("APIKeyRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &APIKeyRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &APIKeyRepositoryMockCreateResults{i1, err}
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the APIKeyRepository.Create method
+func (mmCreate *mAPIKeyRepositoryMockCreate) Set(f func(ctx context.Context, key *apiKeyModel.APIKey) (i1 int64, err error)) *APIKeyRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the APIKeyRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the APIKeyRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	return mmCreate.mock
+}
+
+// When sets expectation for the APIKeyRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mAPIKeyRepositoryMockCreate) When(ctx context.Context, key *apiKeyModel.APIKey) *APIKeyRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("APIKeyRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &APIKeyRepositoryMockCreateExpectation{
+		mock:   mmCreate.mock,
+		params: &APIKeyRepositoryMockCreateParams{ctx, key},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up APIKeyRepository.Create return parameters for the expectation previously defined by the When method
+func (e *APIKeyRepositoryMockCreateExpectation) Then(i1 int64, err error) *APIKeyRepositoryMock {
+	e.results = &APIKeyRepositoryMockCreateResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times APIKeyRepository.Create should be invoked
+func (mmCreate *mAPIKeyRepositoryMockCreate) Times(n uint64) *mAPIKeyRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of APIKeyRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	return mmCreate
+}
+
+func (mmCreate *mAPIKeyRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements repository.APIKeyRepository
+func (mmCreate *APIKeyRepositoryMock) Create(ctx context.Context, key *apiKeyModel.APIKey) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, key)
+	}
+
+	mm_params := APIKeyRepositoryMockCreateParams{ctx, key}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := APIKeyRepositoryMockCreateParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("APIKeyRepositoryMock.Create got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmCreate.t.Errorf("APIKeyRepositoryMock.Create got unexpected parameter key, want: %#v, got: %#v%s\n", *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("APIKeyRepositoryMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the APIKeyRepositoryMock.Create")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, key)
+	}
+	mmCreate.t.Fatalf("Unexpected call to APIKeyRepositoryMock.Create. %v %v", ctx, key)
+	return
+}
+
+// CreateAfterCounter returns a count of finished APIKeyRepositoryMock.Create invocations
+func (mmCreate *APIKeyRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of APIKeyRepositoryMock.Create invocations
+func (mmCreate *APIKeyRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to APIKeyRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mAPIKeyRepositoryMockCreate) Calls() []*APIKeyRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*APIKeyRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *APIKeyRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *APIKeyRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to APIKeyRepositoryMock.Create with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to APIKeyRepositoryMock.Create")
+		} else {
+			m.t.Errorf("Expected call to APIKeyRepositoryMock.Create with params: %#v", *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Error("Expected call to APIKeyRepositoryMock.Create")
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to APIKeyRepositoryMock.Create but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), afterCreateCounter)
+	}
+}
+
+type mAPIKeyRepositoryMockGetByHash struct {
+	optional           bool
+	mock               *APIKeyRepositoryMock
+	defaultExpectation *APIKeyRepositoryMockGetByHashExpectation
+	expectations       []*APIKeyRepositoryMockGetByHashExpectation
+
+	callArgs []*APIKeyRepositoryMockGetByHashParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// APIKeyRepositoryMockGetByHashExpectation specifies expectation struct of the APIKeyRepository.GetByHash
+type APIKeyRepositoryMockGetByHashExpectation struct {
+	mock      *APIKeyRepositoryMock
+	params    *APIKeyRepositoryMockGetByHashParams
+	paramPtrs *APIKeyRepositoryMockGetByHashParamPtrs
+	results   *APIKeyRepositoryMockGetByHashResults
+	Counter   uint64
+}
+
+// APIKeyRepositoryMockGetByHashParams contains parameters of the APIKeyRepository.GetByHash
+type APIKeyRepositoryMockGetByHashParams struct {
+	ctx  context.Context
+	hash string
+}
+
+// APIKeyRepositoryMockGetByHashParamPtrs contains pointers to parameters of the APIKeyRepository.GetByHash
+type APIKeyRepositoryMockGetByHashParamPtrs struct {
+	ctx  *context.Context
+	hash *string
+}
+
+// APIKeyRepositoryMockGetByHashResults contains results of the APIKeyRepository.GetByHash
+type APIKeyRepositoryMockGetByHashResults struct {
+	ap1 *apiKeyModel.APIKey
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetByHash *mAPIKeyRepositoryMockGetByHash) Optional() *mAPIKeyRepositoryMockGetByHash {
+	mmGetByHash.optional = true
+	return mmGetByHash
+}
+
+// Expect sets up expected params for APIKeyRepository.GetByHash
+func (mmGetByHash *mAPIKeyRepositoryMockGetByHash) Expect(ctx context.Context, hash string) *mAPIKeyRepositoryMockGetByHash {
+	if mmGetByHash.mock.funcGetByHash != nil {
+		mmGetByHash.mock.t.Fatalf("APIKeyRepositoryMock.GetByHash mock is already set by Set")
+	}
+
+	if mmGetByHash.defaultExpectation == nil {
+		mmGetByHash.defaultExpectation = &APIKeyRepositoryMockGetByHashExpectation{}
+	}
+
+	if mmGetByHash.defaultExpectation.paramPtrs != nil {
+		mmGetByHash.mock.t.Fatalf("APIKeyRepositoryMock.GetByHash mock is already set by ExpectParams functions")
+	}
+
+	mmGetByHash.defaultExpectation.params = &APIKeyRepositoryMockGetByHashParams{ctx, hash}
+	for _, e := range mmGetByHash.expectations {
+		if minimock.Equal(e.params, mmGetByHash.defaultExpectation.params) {
+			mmGetByHash.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetByHash.defaultExpectation.params)
+		}
+	}
+
+	return mmGetByHash
+}
+
+// ExpectCtxParam1 sets up expected param ctx for APIKeyRepository.GetByHash
+func (mmGetByHash *mAPIKeyRepositoryMockGetByHash) ExpectCtxParam1(ctx context.Context) *mAPIKeyRepositoryMockGetByHash {
+	if mmGetByHash.mock.funcGetByHash != nil {
+		mmGetByHash.mock.t.Fatalf("APIKeyRepositoryMock.GetByHash mock is already set by Set")
+	}
+
+	if mmGetByHash.defaultExpectation == nil {
+		mmGetByHash.defaultExpectation = &APIKeyRepositoryMockGetByHashExpectation{}
+	}
+
+	if mmGetByHash.defaultExpectation.params != nil {
+		mmGetByHash.mock.t.Fatalf("APIKeyRepositoryMock.GetByHash mock is already set by Expect")
+	}
+
+	if mmGetByHash.defaultExpectation.paramPtrs == nil {
+		mmGetByHash.defaultExpectation.paramPtrs = &APIKeyRepositoryMockGetByHashParamPtrs{}
+	}
+	mmGetByHash.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetByHash
+}
+
+// ExpectHashParam2 sets up expected param hash for APIKeyRepository.GetByHash
+func (mmGetByHash *mAPIKeyRepositoryMockGetByHash) ExpectHashParam2(hash string) *mAPIKeyRepositoryMockGetByHash {
+	if mmGetByHash.mock.funcGetByHash != nil {
+		mmGetByHash.mock.t.Fatalf("APIKeyRepositoryMock.GetByHash mock is already set by Set")
+	}
+
+	if mmGetByHash.defaultExpectation == nil {
+		mmGetByHash.defaultExpectation = &APIKeyRepositoryMockGetByHashExpectation{}
+	}
+
+	if mmGetByHash.defaultExpectation.params != nil {
+		mmGetByHash.mock.t.Fatalf("APIKeyRepositoryMock.GetByHash mock is already set by Expect")
+	}
+
+	if mmGetByHash.defaultExpectation.paramPtrs == nil {
+		mmGetByHash.defaultExpectation.paramPtrs = &APIKeyRepositoryMockGetByHashParamPtrs{}
+	}
+	mmGetByHash.defaultExpectation.paramPtrs.hash = &hash
+
+	return mmGetByHash
+}
+
+// Inspect accepts an inspector function that has same arguments as the APIKeyRepository.GetByHash
+func (mmGetByHash *mAPIKeyRepositoryMockGetByHash) Inspect(f func(ctx context.Context, hash string)) *mAPIKeyRepositoryMockGetByHash {
+	if mmGetByHash.mock.inspectFuncGetByHash != nil {
+		mmGetByHash.mock.t.Fatalf("Inspect function is already set for APIKeyRepositoryMock.GetByHash")
+	}
+
+	mmGetByHash.mock.inspectFuncGetByHash = f
+
+	return mmGetByHash
+}
+
+// Return sets up results that will be returned by APIKeyRepository.GetByHash
+func (mmGetByHash *mAPIKeyRepositoryMockGetByHash) Return(ap1 *apiKeyModel.APIKey, err error) *APIKeyRepositoryMock {
+	if mmGetByHash.mock.funcGetByHash != nil {
+		mmGetByHash.mock.t.Fatalf("APIKeyRepositoryMock.GetByHash mock is already set by Set")
+	}
+
+	if mmGetByHash.defaultExpectation == nil {
+		mmGetByHash.defaultExpectation = &APIKeyRepositoryMockGetByHashExpectation{mock: mmGetByHash.mock}
+	}
+	mmGetByHash.defaultExpectation.results = &APIKeyRepositoryMockGetByHashResults{ap1, err}
+	return mmGetByHash.mock
+}
+
+// Set uses given function f to mock the APIKeyRepository.GetByHash method
+func (mmGetByHash *mAPIKeyRepositoryMockGetByHash) Set(f func(ctx context.Context, hash string) (ap1 *apiKeyModel.APIKey, err error)) *APIKeyRepositoryMock {
+	if mmGetByHash.defaultExpectation != nil {
+		mmGetByHash.mock.t.Fatalf("Default expectation is already set for the APIKeyRepository.GetByHash method")
+	}
+
+	if len(mmGetByHash.expectations) > 0 {
+		mmGetByHash.mock.t.Fatalf("Some expectations are already set for the APIKeyRepository.GetByHash method")
+	}
+
+	mmGetByHash.mock.funcGetByHash = f
+	return mmGetByHash.mock
+}
+
+// When sets expectation for the APIKeyRepository.GetByHash which will trigger the result defined by the following
+// Then helper
+func (mmGetByHash *mAPIKeyRepositoryMockGetByHash) When(ctx context.Context, hash string) *APIKeyRepositoryMockGetByHashExpectation {
+	if mmGetByHash.mock.funcGetByHash != nil {
+		mmGetByHash.mock.t.Fatalf("APIKeyRepositoryMock.GetByHash mock is already set by Set")
+	}
+
+	expectation := &APIKeyRepositoryMockGetByHashExpectation{
+		mock:   mmGetByHash.mock,
+		params: &APIKeyRepositoryMockGetByHashParams{ctx, hash},
+	}
+	mmGetByHash.expectations = append(mmGetByHash.expectations, expectation)
+	return expectation
+}
+
+// Then sets up APIKeyRepository.GetByHash return parameters for the expectation previously defined by the When method
+func (e *APIKeyRepositoryMockGetByHashExpectation) Then(ap1 *apiKeyModel.APIKey, err error) *APIKeyRepositoryMock {
+	e.results = &APIKeyRepositoryMockGetByHashResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times APIKeyRepository.GetByHash should be invoked
+func (mmGetByHash *mAPIKeyRepositoryMockGetByHash) Times(n uint64) *mAPIKeyRepositoryMockGetByHash {
+	if n == 0 {
+		mmGetByHash.mock.t.Fatalf("Times of APIKeyRepositoryMock.GetByHash mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetByHash.expectedInvocations, n)
+	return mmGetByHash
+}
+
+func (mmGetByHash *mAPIKeyRepositoryMockGetByHash) invocationsDone() bool {
+	if len(mmGetByHash.expectations) == 0 && mmGetByHash.defaultExpectation == nil && mmGetByHash.mock.funcGetByHash == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetByHash.mock.afterGetByHashCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetByHash.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetByHash implements repository.APIKeyRepository
+func (mmGetByHash *APIKeyRepositoryMock) GetByHash(ctx context.Context, hash string) (ap1 *apiKeyModel.APIKey, err error) {
+	mm_atomic.AddUint64(&mmGetByHash.beforeGetByHashCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetByHash.afterGetByHashCounter, 1)
+
+	if mmGetByHash.inspectFuncGetByHash != nil {
+		mmGetByHash.inspectFuncGetByHash(ctx, hash)
+	}
+
+	mm_params := APIKeyRepositoryMockGetByHashParams{ctx, hash}
+
+	// Record call args
+	mmGetByHash.GetByHashMock.mutex.Lock()
+	mmGetByHash.GetByHashMock.callArgs = append(mmGetByHash.GetByHashMock.callArgs, &mm_params)
+	mmGetByHash.GetByHashMock.mutex.Unlock()
+
+	for _, e := range mmGetByHash.GetByHashMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmGetByHash.GetByHashMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetByHash.GetByHashMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetByHash.GetByHashMock.defaultExpectation.params
+		mm_want_ptrs := mmGetByHash.GetByHashMock.defaultExpectation.paramPtrs
+
+		mm_got := APIKeyRepositoryMockGetByHashParams{ctx, hash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetByHash.t.Errorf("APIKeyRepositoryMock.GetByHash got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.hash != nil && !minimock.Equal(*mm_want_ptrs.hash, mm_got.hash) {
+				mmGetByHash.t.Errorf("APIKeyRepositoryMock.GetByHash got unexpected parameter hash, want: %#v, got: %#v%s\n", *mm_want_ptrs.hash, mm_got.hash, minimock.Diff(*mm_want_ptrs.hash, mm_got.hash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetByHash.t.Errorf("APIKeyRepositoryMock.GetByHash got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetByHash.GetByHashMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetByHash.t.Fatal("No results are set for the APIKeyRepositoryMock.GetByHash")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmGetByHash.funcGetByHash != nil {
+		return mmGetByHash.funcGetByHash(ctx, hash)
+	}
+	mmGetByHash.t.Fatalf("Unexpected call to APIKeyRepositoryMock.GetByHash. %v %v", ctx, hash)
+	return
+}
+
+// GetByHashAfterCounter returns a count of finished APIKeyRepositoryMock.GetByHash invocations
+func (mmGetByHash *APIKeyRepositoryMock) GetByHashAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByHash.afterGetByHashCounter)
+}
+
+// GetByHashBeforeCounter returns a count of APIKeyRepositoryMock.GetByHash invocations
+func (mmGetByHash *APIKeyRepositoryMock) GetByHashBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByHash.beforeGetByHashCounter)
+}
+
+// Calls returns a list of arguments used in each call to APIKeyRepositoryMock.GetByHash.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetByHash *mAPIKeyRepositoryMockGetByHash) Calls() []*APIKeyRepositoryMockGetByHashParams {
+	mmGetByHash.mutex.RLock()
+
+	argCopy := make([]*APIKeyRepositoryMockGetByHashParams, len(mmGetByHash.callArgs))
+	copy(argCopy, mmGetByHash.callArgs)
+
+	mmGetByHash.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetByHashDone returns true if the count of the GetByHash invocations corresponds
+// the number of defined expectations
+func (m *APIKeyRepositoryMock) MinimockGetByHashDone() bool {
+	if m.GetByHashMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetByHashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetByHashMock.invocationsDone()
+}
+
+// MinimockGetByHashInspect logs each unmet expectation
+func (m *APIKeyRepositoryMock) MinimockGetByHashInspect() {
+	for _, e := range m.GetByHashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to APIKeyRepositoryMock.GetByHash with params: %#v", *e.params)
+		}
+	}
+
+	afterGetByHashCounter := mm_atomic.LoadUint64(&m.afterGetByHashCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetByHashMock.defaultExpectation != nil && afterGetByHashCounter < 1 {
+		if m.GetByHashMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to APIKeyRepositoryMock.GetByHash")
+		} else {
+			m.t.Errorf("Expected call to APIKeyRepositoryMock.GetByHash with params: %#v", *m.GetByHashMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetByHash != nil && afterGetByHashCounter < 1 {
+		m.t.Error("Expected call to APIKeyRepositoryMock.GetByHash")
+	}
+
+	if !m.GetByHashMock.invocationsDone() && afterGetByHashCounter > 0 {
+		m.t.Errorf("Expected %d calls to APIKeyRepositoryMock.GetByHash but found %d calls",
+			mm_atomic.LoadUint64(&m.GetByHashMock.expectedInvocations), afterGetByHashCounter)
+	}
+}
+
+type mAPIKeyRepositoryMockList struct {
+	optional           bool
+	mock               *APIKeyRepositoryMock
+	defaultExpectation *APIKeyRepositoryMockListExpectation
+	expectations       []*APIKeyRepositoryMockListExpectation
+
+	callArgs []*APIKeyRepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// APIKeyRepositoryMockListExpectation specifies expectation struct of the APIKeyRepository.List
+type APIKeyRepositoryMockListExpectation struct {
+	mock      *APIKeyRepositoryMock
+	params    *APIKeyRepositoryMockListParams
+	paramPtrs *APIKeyRepositoryMockListParamPtrs
+	results   *APIKeyRepositoryMockListResults
+	Counter   uint64
+}
+
+// APIKeyRepositoryMockListParams contains parameters of the APIKeyRepository.List
+type APIKeyRepositoryMockListParams struct {
+	ctx    context.Context
+	limit  int64
+	offset int64
+}
+
+// APIKeyRepositoryMockListParamPtrs contains pointers to parameters of the APIKeyRepository.List
+type APIKeyRepositoryMockListParamPtrs struct {
+	ctx    *context.Context
+	limit  *int64
+	offset *int64
+}
+
+// APIKeyRepositoryMockListResults contains results of the APIKeyRepository.List
+type APIKeyRepositoryMockListResults struct {
+	apa1 []*apiKeyModel.APIKey
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mAPIKeyRepositoryMockList) Optional() *mAPIKeyRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for APIKeyRepository.List
+func (mmList *mAPIKeyRepositoryMockList) Expect(ctx context.Context, limit int64, offset int64) *mAPIKeyRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("APIKeyRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &APIKeyRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("APIKeyRepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &APIKeyRepositoryMockListParams{ctx, limit, offset}
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for APIKeyRepository.List
+func (mmList *mAPIKeyRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mAPIKeyRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("APIKeyRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &APIKeyRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("APIKeyRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &APIKeyRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmList
+}
+
+// ExpectLimitParam2 sets up expected param limit for APIKeyRepository.List
+func (mmList *mAPIKeyRepositoryMockList) ExpectLimitParam2(limit int64) *mAPIKeyRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("APIKeyRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &APIKeyRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("APIKeyRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &APIKeyRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.limit = &limit
+
+	return mmList
+}
+
+// ExpectOffsetParam3 sets up expected param offset for APIKeyRepository.List
+func (mmList *mAPIKeyRepositoryMockList) ExpectOffsetParam3(offset int64) *mAPIKeyRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("APIKeyRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &APIKeyRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("APIKeyRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &APIKeyRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.offset = &offset
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the APIKeyRepository.List
+func (mmList *mAPIKeyRepositoryMockList) Inspect(f func(ctx context.Context, limit int64, offset int64)) *mAPIKeyRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for APIKeyRepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by APIKeyRepository.List
+func (mmList *mAPIKeyRepositoryMockList) Return(apa1 []*apiKeyModel.APIKey, err error) *APIKeyRepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("APIKeyRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &APIKeyRepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &APIKeyRepositoryMockListResults{apa1, err}
+	return mmList.mock
+}
+
+// Set uses given function f to mock the APIKeyRepository.List method
+func (mmList *mAPIKeyRepositoryMockList) Set(f func(ctx context.Context, limit int64, offset int64) (apa1 []*apiKeyModel.APIKey, err error)) *APIKeyRepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the APIKeyRepository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the APIKeyRepository.List method")
+	}
+
+	mmList.mock.funcList = f
+	return mmList.mock
+}
+
+// When sets expectation for the APIKeyRepository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mAPIKeyRepositoryMockList) When(ctx context.Context, limit int64, offset int64) *APIKeyRepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("APIKeyRepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &APIKeyRepositoryMockListExpectation{
+		mock:   mmList.mock,
+		params: &APIKeyRepositoryMockListParams{ctx, limit, offset},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up APIKeyRepository.List return parameters for the expectation previously defined by the When method
+func (e *APIKeyRepositoryMockListExpectation) Then(apa1 []*apiKeyModel.APIKey, err error) *APIKeyRepositoryMock {
+	e.results = &APIKeyRepositoryMockListResults{apa1, err}
+	return e.mock
+}
+
+// Times sets number of times APIKeyRepository.List should be invoked
+func (mmList *mAPIKeyRepositoryMockList) Times(n uint64) *mAPIKeyRepositoryMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of APIKeyRepositoryMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	return mmList
+}
+
+func (mmList *mAPIKeyRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements repository.APIKeyRepository
+func (mmList *APIKeyRepositoryMock) List(ctx context.Context, limit int64, offset int64) (apa1 []*apiKeyModel.APIKey, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, limit, offset)
+	}
+
+	mm_params := APIKeyRepositoryMockListParams{ctx, limit, offset}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.apa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := APIKeyRepositoryMockListParams{ctx, limit, offset}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("APIKeyRepositoryMock.List got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmList.t.Errorf("APIKeyRepositoryMock.List got unexpected parameter limit, want: %#v, got: %#v%s\n", *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+			if mm_want_ptrs.offset != nil && !minimock.Equal(*mm_want_ptrs.offset, mm_got.offset) {
+				mmList.t.Errorf("APIKeyRepositoryMock.List got unexpected parameter offset, want: %#v, got: %#v%s\n", *mm_want_ptrs.offset, mm_got.offset, minimock.Diff(*mm_want_ptrs.offset, mm_got.offset))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("APIKeyRepositoryMock.List got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the APIKeyRepositoryMock.List")
+		}
+		return (*mm_results).apa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, limit, offset)
+	}
+	mmList.t.Fatalf("Unexpected call to APIKeyRepositoryMock.List. %v %v %v", ctx, limit, offset)
+	return
+}
+
+// ListAfterCounter returns a count of finished APIKeyRepositoryMock.List invocations
+func (mmList *APIKeyRepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of APIKeyRepositoryMock.List invocations
+func (mmList *APIKeyRepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to APIKeyRepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mAPIKeyRepositoryMockList) Calls() []*APIKeyRepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*APIKeyRepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *APIKeyRepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *APIKeyRepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to APIKeyRepositoryMock.List with params: %#v", *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to APIKeyRepositoryMock.List")
+		} else {
+			m.t.Errorf("Expected call to APIKeyRepositoryMock.List with params: %#v", *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Error("Expected call to APIKeyRepositoryMock.List")
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to APIKeyRepositoryMock.List but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), afterListCounter)
+	}
+}
+
+type mAPIKeyRepositoryMockRevoke struct {
+	optional           bool
+	mock               *APIKeyRepositoryMock
+	defaultExpectation *APIKeyRepositoryMockRevokeExpectation
+	expectations       []*APIKeyRepositoryMockRevokeExpectation
+
+	callArgs []*APIKeyRepositoryMockRevokeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// APIKeyRepositoryMockRevokeExpectation specifies expectation struct of the APIKeyRepository.Revoke
+type APIKeyRepositoryMockRevokeExpectation struct {
+	mock      *APIKeyRepositoryMock
+	params    *APIKeyRepositoryMockRevokeParams
+	paramPtrs *APIKeyRepositoryMockRevokeParamPtrs
+	results   *APIKeyRepositoryMockRevokeResults
+	Counter   uint64
+}
+
+// APIKeyRepositoryMockRevokeParams contains parameters of the APIKeyRepository.Revoke
+type APIKeyRepositoryMockRevokeParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// APIKeyRepositoryMockRevokeParamPtrs contains pointers to parameters of the APIKeyRepository.Revoke
+type APIKeyRepositoryMockRevokeParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// APIKeyRepositoryMockRevokeResults contains results of the APIKeyRepository.Revoke
+type APIKeyRepositoryMockRevokeResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevoke *mAPIKeyRepositoryMockRevoke) Optional() *mAPIKeyRepositoryMockRevoke {
+	mmRevoke.optional = true
+	return mmRevoke
+}
+
+// Expect sets up expected params for APIKeyRepository.Revoke
+func (mmRevoke *mAPIKeyRepositoryMockRevoke) Expect(ctx context.Context, id int64) *mAPIKeyRepositoryMockRevoke {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("APIKeyRepositoryMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &APIKeyRepositoryMockRevokeExpectation{}
+	}
+
+	if mmRevoke.defaultExpectation.paramPtrs != nil {
+		mmRevoke.mock.t.Fatalf("APIKeyRepositoryMock.Revoke mock is already set by ExpectParams functions")
+	}
+
+	mmRevoke.defaultExpectation.params = &APIKeyRepositoryMockRevokeParams{ctx, id}
+	for _, e := range mmRevoke.expectations {
+		if minimock.Equal(e.params, mmRevoke.defaultExpectation.params) {
+			mmRevoke.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevoke.defaultExpectation.params)
+		}
+	}
+
+	return mmRevoke
+}
+
+// ExpectCtxParam1 sets up expected param ctx for APIKeyRepository.Revoke
+func (mmRevoke *mAPIKeyRepositoryMockRevoke) ExpectCtxParam1(ctx context.Context) *mAPIKeyRepositoryMockRevoke {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("APIKeyRepositoryMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &APIKeyRepositoryMockRevokeExpectation{}
+	}
+
+	if mmRevoke.defaultExpectation.params != nil {
+		mmRevoke.mock.t.Fatalf("APIKeyRepositoryMock.Revoke mock is already set by Expect")
+	}
+
+	if mmRevoke.defaultExpectation.paramPtrs == nil {
+		mmRevoke.defaultExpectation.paramPtrs = &APIKeyRepositoryMockRevokeParamPtrs{}
+	}
+	mmRevoke.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRevoke
+}
+
+// ExpectIdParam2 sets up expected param id for APIKeyRepository.Revoke
+func (mmRevoke *mAPIKeyRepositoryMockRevoke) ExpectIdParam2(id int64) *mAPIKeyRepositoryMockRevoke {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("APIKeyRepositoryMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &APIKeyRepositoryMockRevokeExpectation{}
+	}
+
+	if mmRevoke.defaultExpectation.params != nil {
+		mmRevoke.mock.t.Fatalf("APIKeyRepositoryMock.Revoke mock is already set by Expect")
+	}
+
+	if mmRevoke.defaultExpectation.paramPtrs == nil {
+		mmRevoke.defaultExpectation.paramPtrs = &APIKeyRepositoryMockRevokeParamPtrs{}
+	}
+	mmRevoke.defaultExpectation.paramPtrs.id = &id
+
+	return mmRevoke
+}
+
+// Inspect accepts an inspector function that has same arguments as the APIKeyRepository.Revoke
+func (mmRevoke *mAPIKeyRepositoryMockRevoke) Inspect(f func(ctx context.Context, id int64)) *mAPIKeyRepositoryMockRevoke {
+	if mmRevoke.mock.inspectFuncRevoke != nil {
+		mmRevoke.mock.t.Fatalf("Inspect function is already set for APIKeyRepositoryMock.Revoke")
+	}
+
+	mmRevoke.mock.inspectFuncRevoke = f
+
+	return mmRevoke
+}
+
+// Return sets up results that will be returned by APIKeyRepository.Revoke
+func (mmRevoke *mAPIKeyRepositoryMockRevoke) Return(err error) *APIKeyRepositoryMock {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("APIKeyRepositoryMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &APIKeyRepositoryMockRevokeExpectation{mock: mmRevoke.mock}
+	}
+	mmRevoke.defaultExpectation.results = &APIKeyRepositoryMockRevokeResults{err}
+	return mmRevoke.mock
+}
+
+// Set uses given function f to mock the APIKeyRepository.Revoke method
+func (mmRevoke *mAPIKeyRepositoryMockRevoke) Set(f func(ctx context.Context, id int64) (err error)) *APIKeyRepositoryMock {
+	if mmRevoke.defaultExpectation != nil {
+		mmRevoke.mock.t.Fatalf("Default expectation is already set for the APIKeyRepository.Revoke method")
+	}
+
+	if len(mmRevoke.expectations) > 0 {
+		mmRevoke.mock.t.Fatalf("Some expectations are already set for the APIKeyRepository.Revoke method")
+	}
+
+	mmRevoke.mock.funcRevoke = f
+	return mmRevoke.mock
+}
+
+// When sets expectation for the APIKeyRepository.Revoke which will trigger the result defined by the following
+// Then helper
+func (mmRevoke *mAPIKeyRepositoryMockRevoke) When(ctx context.Context, id int64) *APIKeyRepositoryMockRevokeExpectation {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("APIKeyRepositoryMock.Revoke mock is already set by Set")
+	}
+
+	expectation := &APIKeyRepositoryMockRevokeExpectation{
+		mock:   mmRevoke.mock,
+		params: &APIKeyRepositoryMockRevokeParams{ctx, id},
+	}
+	mmRevoke.expectations = append(mmRevoke.expectations, expectation)
+	return expectation
+}
+
+// Then sets up APIKeyRepository.Revoke return parameters for the expectation previously defined by the When method
+func (e *APIKeyRepositoryMockRevokeExpectation) Then(err error) *APIKeyRepositoryMock {
+	e.results = &APIKeyRepositoryMockRevokeResults{err}
+	return e.mock
+}
+
+// Times sets number of times APIKeyRepository.Revoke should be invoked
+func (mmRevoke *mAPIKeyRepositoryMockRevoke) Times(n uint64) *mAPIKeyRepositoryMockRevoke {
+	if n == 0 {
+		mmRevoke.mock.t.Fatalf("Times of APIKeyRepositoryMock.Revoke mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevoke.expectedInvocations, n)
+	return mmRevoke
+}
+
+func (mmRevoke *mAPIKeyRepositoryMockRevoke) invocationsDone() bool {
+	if len(mmRevoke.expectations) == 0 && mmRevoke.defaultExpectation == nil && mmRevoke.mock.funcRevoke == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevoke.mock.afterRevokeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevoke.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Revoke implements repository.APIKeyRepository
+func (mmRevoke *APIKeyRepositoryMock) Revoke(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmRevoke.beforeRevokeCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevoke.afterRevokeCounter, 1)
+
+	if mmRevoke.inspectFuncRevoke != nil {
+		mmRevoke.inspectFuncRevoke(ctx, id)
+	}
+
+	mm_params := APIKeyRepositoryMockRevokeParams{ctx, id}
+
+	// Record call args
+	mmRevoke.RevokeMock.mutex.Lock()
+	mmRevoke.RevokeMock.callArgs = append(mmRevoke.RevokeMock.callArgs, &mm_params)
+	mmRevoke.RevokeMock.mutex.Unlock()
+
+	for _, e := range mmRevoke.RevokeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevoke.RevokeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevoke.RevokeMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevoke.RevokeMock.defaultExpectation.params
+		mm_want_ptrs := mmRevoke.RevokeMock.defaultExpectation.paramPtrs
+
+		mm_got := APIKeyRepositoryMockRevokeParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevoke.t.Errorf("APIKeyRepositoryMock.Revoke got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRevoke.t.Errorf("APIKeyRepositoryMock.Revoke got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevoke.t.Errorf("APIKeyRepositoryMock.Revoke got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevoke.RevokeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevoke.t.Fatal("No results are set for the APIKeyRepositoryMock.Revoke")
+		}
+		return (*mm_results).err
+	}
+	if mmRevoke.funcRevoke != nil {
+		return mmRevoke.funcRevoke(ctx, id)
+	}
+	mmRevoke.t.Fatalf("Unexpected call to APIKeyRepositoryMock.Revoke. %v %v", ctx, id)
+	return
+}
+
+// RevokeAfterCounter returns a count of finished APIKeyRepositoryMock.Revoke invocations
+func (mmRevoke *APIKeyRepositoryMock) RevokeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevoke.afterRevokeCounter)
+}
+
+// RevokeBeforeCounter returns a count of APIKeyRepositoryMock.Revoke invocations
+func (mmRevoke *APIKeyRepositoryMock) RevokeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevoke.beforeRevokeCounter)
+}
+
+// Calls returns a list of arguments used in each call to APIKeyRepositoryMock.Revoke.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevoke *mAPIKeyRepositoryMockRevoke) Calls() []*APIKeyRepositoryMockRevokeParams {
+	mmRevoke.mutex.RLock()
+
+	argCopy := make([]*APIKeyRepositoryMockRevokeParams, len(mmRevoke.callArgs))
+	copy(argCopy, mmRevoke.callArgs)
+
+	mmRevoke.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeDone returns true if the count of the Revoke invocations corresponds
+// the number of defined expectations
+func (m *APIKeyRepositoryMock) MinimockRevokeDone() bool {
+	if m.RevokeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeMock.invocationsDone()
+}
+
+// MinimockRevokeInspect logs each unmet expectation
+func (m *APIKeyRepositoryMock) MinimockRevokeInspect() {
+	for _, e := range m.RevokeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to APIKeyRepositoryMock.Revoke with params: %#v", *e.params)
+		}
+	}
+
+	afterRevokeCounter := mm_atomic.LoadUint64(&m.afterRevokeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeMock.defaultExpectation != nil && afterRevokeCounter < 1 {
+		if m.RevokeMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to APIKeyRepositoryMock.Revoke")
+		} else {
+			m.t.Errorf("Expected call to APIKeyRepositoryMock.Revoke with params: %#v", *m.RevokeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevoke != nil && afterRevokeCounter < 1 {
+		m.t.Error("Expected call to APIKeyRepositoryMock.Revoke")
+	}
+
+	if !m.RevokeMock.invocationsDone() && afterRevokeCounter > 0 {
+		m.t.Errorf("Expected %d calls to APIKeyRepositoryMock.Revoke but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeMock.expectedInvocations), afterRevokeCounter)
+	}
+}
+
+type mAPIKeyRepositoryMockTouchLastUsed struct {
+	optional           bool
+	mock               *APIKeyRepositoryMock
+	defaultExpectation *APIKeyRepositoryMockTouchLastUsedExpectation
+	expectations       []*APIKeyRepositoryMockTouchLastUsedExpectation
+
+	callArgs []*APIKeyRepositoryMockTouchLastUsedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// APIKeyRepositoryMockTouchLastUsedExpectation specifies expectation struct of the APIKeyRepository.TouchLastUsed
+type APIKeyRepositoryMockTouchLastUsedExpectation struct {
+	mock      *APIKeyRepositoryMock
+	params    *APIKeyRepositoryMockTouchLastUsedParams
+	paramPtrs *APIKeyRepositoryMockTouchLastUsedParamPtrs
+	results   *APIKeyRepositoryMockTouchLastUsedResults
+	Counter   uint64
+}
+
+// APIKeyRepositoryMockTouchLastUsedParams contains parameters of the APIKeyRepository.TouchLastUsed
+type APIKeyRepositoryMockTouchLastUsedParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// APIKeyRepositoryMockTouchLastUsedParamPtrs contains pointers to parameters of the APIKeyRepository.TouchLastUsed
+type APIKeyRepositoryMockTouchLastUsedParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// APIKeyRepositoryMockTouchLastUsedResults contains results of the APIKeyRepository.TouchLastUsed
+type APIKeyRepositoryMockTouchLastUsedResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmTouchLastUsed *mAPIKeyRepositoryMockTouchLastUsed) Optional() *mAPIKeyRepositoryMockTouchLastUsed {
+	mmTouchLastUsed.optional = true
+	return mmTouchLastUsed
+}
+
+// Expect sets up expected params for APIKeyRepository.TouchLastUsed
+func (mmTouchLastUsed *mAPIKeyRepositoryMockTouchLastUsed) Expect(ctx context.Context, id int64) *mAPIKeyRepositoryMockTouchLastUsed {
+	if mmTouchLastUsed.mock.funcTouchLastUsed != nil {
+		mmTouchLastUsed.mock.t.Fatalf("APIKeyRepositoryMock.TouchLastUsed mock is already set by Set")
+	}
+
+	if mmTouchLastUsed.defaultExpectation == nil {
+		mmTouchLastUsed.defaultExpectation = &APIKeyRepositoryMockTouchLastUsedExpectation{}
+	}
+
+	if mmTouchLastUsed.defaultExpectation.paramPtrs != nil {
+		mmTouchLastUsed.mock.t.Fatalf("APIKeyRepositoryMock.TouchLastUsed mock is already set by ExpectParams functions")
+	}
+
+	mmTouchLastUsed.defaultExpectation.params = &APIKeyRepositoryMockTouchLastUsedParams{ctx, id}
+	for _, e := range mmTouchLastUsed.expectations {
+		if minimock.Equal(e.params, mmTouchLastUsed.defaultExpectation.params) {
+			mmTouchLastUsed.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTouchLastUsed.defaultExpectation.params)
+		}
+	}
+
+	return mmTouchLastUsed
+}
+
+// ExpectCtxParam1 sets up expected param ctx for APIKeyRepository.TouchLastUsed
+func (mmTouchLastUsed *mAPIKeyRepositoryMockTouchLastUsed) ExpectCtxParam1(ctx context.Context) *mAPIKeyRepositoryMockTouchLastUsed {
+	if mmTouchLastUsed.mock.funcTouchLastUsed != nil {
+		mmTouchLastUsed.mock.t.Fatalf("APIKeyRepositoryMock.TouchLastUsed mock is already set by Set")
+	}
+
+	if mmTouchLastUsed.defaultExpectation == nil {
+		mmTouchLastUsed.defaultExpectation = &APIKeyRepositoryMockTouchLastUsedExpectation{}
+	}
+
+	if mmTouchLastUsed.defaultExpectation.params != nil {
+		mmTouchLastUsed.mock.t.Fatalf("APIKeyRepositoryMock.TouchLastUsed mock is already set by Expect")
+	}
+
+	if mmTouchLastUsed.defaultExpectation.paramPtrs == nil {
+		mmTouchLastUsed.defaultExpectation.paramPtrs = &APIKeyRepositoryMockTouchLastUsedParamPtrs{}
+	}
+	mmTouchLastUsed.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmTouchLastUsed
+}
+
+// ExpectIdParam2 sets up expected param id for APIKeyRepository.TouchLastUsed
+func (mmTouchLastUsed *mAPIKeyRepositoryMockTouchLastUsed) ExpectIdParam2(id int64) *mAPIKeyRepositoryMockTouchLastUsed {
+	if mmTouchLastUsed.mock.funcTouchLastUsed != nil {
+		mmTouchLastUsed.mock.t.Fatalf("APIKeyRepositoryMock.TouchLastUsed mock is already set by Set")
+	}
+
+	if mmTouchLastUsed.defaultExpectation == nil {
+		mmTouchLastUsed.defaultExpectation = &APIKeyRepositoryMockTouchLastUsedExpectation{}
+	}
+
+	if mmTouchLastUsed.defaultExpectation.params != nil {
+		mmTouchLastUsed.mock.t.Fatalf("APIKeyRepositoryMock.TouchLastUsed mock is already set by Expect")
+	}
+
+	if mmTouchLastUsed.defaultExpectation.paramPtrs == nil {
+		mmTouchLastUsed.defaultExpectation.paramPtrs = &APIKeyRepositoryMockTouchLastUsedParamPtrs{}
+	}
+	mmTouchLastUsed.defaultExpectation.paramPtrs.id = &id
+
+	return mmTouchLastUsed
+}
+
+// Inspect accepts an inspector function that has same arguments as the APIKeyRepository.TouchLastUsed
+func (mmTouchLastUsed *mAPIKeyRepositoryMockTouchLastUsed) Inspect(f func(ctx context.Context, id int64)) *mAPIKeyRepositoryMockTouchLastUsed {
+	if mmTouchLastUsed.mock.inspectFuncTouchLastUsed != nil {
+		mmTouchLastUsed.mock.t.Fatalf("Inspect function is already set for APIKeyRepositoryMock.TouchLastUsed")
+	}
+
+	mmTouchLastUsed.mock.inspectFuncTouchLastUsed = f
+
+	return mmTouchLastUsed
+}
+
+// Return sets up results that will be returned by APIKeyRepository.TouchLastUsed
+func (mmTouchLastUsed *mAPIKeyRepositoryMockTouchLastUsed) Return(err error) *APIKeyRepositoryMock {
+	if mmTouchLastUsed.mock.funcTouchLastUsed != nil {
+		mmTouchLastUsed.mock.t.Fatalf("APIKeyRepositoryMock.TouchLastUsed mock is already set by Set")
+	}
+
+	if mmTouchLastUsed.defaultExpectation == nil {
+		mmTouchLastUsed.defaultExpectation = &APIKeyRepositoryMockTouchLastUsedExpectation{mock: mmTouchLastUsed.mock}
+	}
+	mmTouchLastUsed.defaultExpectation.results = &APIKeyRepositoryMockTouchLastUsedResults{err}
+	return mmTouchLastUsed.mock
+}
+
+// Set uses given function f to mock the APIKeyRepository.TouchLastUsed method
+func (mmTouchLastUsed *mAPIKeyRepositoryMockTouchLastUsed) Set(f func(ctx context.Context, id int64) (err error)) *APIKeyRepositoryMock {
+	if mmTouchLastUsed.defaultExpectation != nil {
+		mmTouchLastUsed.mock.t.Fatalf("Default expectation is already set for the APIKeyRepository.TouchLastUsed method")
+	}
+
+	if len(mmTouchLastUsed.expectations) > 0 {
+		mmTouchLastUsed.mock.t.Fatalf("Some expectations are already set for the APIKeyRepository.TouchLastUsed method")
+	}
+
+	mmTouchLastUsed.mock.funcTouchLastUsed = f
+	return mmTouchLastUsed.mock
+}
+
+// When sets expectation for the APIKeyRepository.TouchLastUsed which will trigger the result defined by the following
+// Then helper
+func (mmTouchLastUsed *mAPIKeyRepositoryMockTouchLastUsed) When(ctx context.Context, id int64) *APIKeyRepositoryMockTouchLastUsedExpectation {
+	if mmTouchLastUsed.mock.funcTouchLastUsed != nil {
+		mmTouchLastUsed.mock.t.Fatalf("APIKeyRepositoryMock.TouchLastUsed mock is already set by Set")
+	}
+
+	expectation := &APIKeyRepositoryMockTouchLastUsedExpectation{
+		mock:   mmTouchLastUsed.mock,
+		params: &APIKeyRepositoryMockTouchLastUsedParams{ctx, id},
+	}
+	mmTouchLastUsed.expectations = append(mmTouchLastUsed.expectations, expectation)
+	return expectation
+}
+
+// Then sets up APIKeyRepository.TouchLastUsed return parameters for the expectation previously defined by the When method
+func (e *APIKeyRepositoryMockTouchLastUsedExpectation) Then(err error) *APIKeyRepositoryMock {
+	e.results = &APIKeyRepositoryMockTouchLastUsedResults{err}
+	return e.mock
+}
+
+// Times sets number of times APIKeyRepository.TouchLastUsed should be invoked
+func (mmTouchLastUsed *mAPIKeyRepositoryMockTouchLastUsed) Times(n uint64) *mAPIKeyRepositoryMockTouchLastUsed {
+	if n == 0 {
+		mmTouchLastUsed.mock.t.Fatalf("Times of APIKeyRepositoryMock.TouchLastUsed mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmTouchLastUsed.expectedInvocations, n)
+	return mmTouchLastUsed
+}
+
+func (mmTouchLastUsed *mAPIKeyRepositoryMockTouchLastUsed) invocationsDone() bool {
+	if len(mmTouchLastUsed.expectations) == 0 && mmTouchLastUsed.defaultExpectation == nil && mmTouchLastUsed.mock.funcTouchLastUsed == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmTouchLastUsed.mock.afterTouchLastUsedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTouchLastUsed.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// TouchLastUsed implements repository.APIKeyRepository
+func (mmTouchLastUsed *APIKeyRepositoryMock) TouchLastUsed(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmTouchLastUsed.beforeTouchLastUsedCounter, 1)
+	defer mm_atomic.AddUint64(&mmTouchLastUsed.afterTouchLastUsedCounter, 1)
+
+	if mmTouchLastUsed.inspectFuncTouchLastUsed != nil {
+		mmTouchLastUsed.inspectFuncTouchLastUsed(ctx, id)
+	}
+
+	mm_params := APIKeyRepositoryMockTouchLastUsedParams{ctx, id}
+
+	// Record call args
+	mmTouchLastUsed.TouchLastUsedMock.mutex.Lock()
+	mmTouchLastUsed.TouchLastUsedMock.callArgs = append(mmTouchLastUsed.TouchLastUsedMock.callArgs, &mm_params)
+	mmTouchLastUsed.TouchLastUsedMock.mutex.Unlock()
+
+	for _, e := range mmTouchLastUsed.TouchLastUsedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmTouchLastUsed.TouchLastUsedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTouchLastUsed.TouchLastUsedMock.defaultExpectation.Counter, 1)
+		mm_want := mmTouchLastUsed.TouchLastUsedMock.defaultExpectation.params
+		mm_want_ptrs := mmTouchLastUsed.TouchLastUsedMock.defaultExpectation.paramPtrs
+
+		mm_got := APIKeyRepositoryMockTouchLastUsedParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmTouchLastUsed.t.Errorf("APIKeyRepositoryMock.TouchLastUsed got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmTouchLastUsed.t.Errorf("APIKeyRepositoryMock.TouchLastUsed got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmTouchLastUsed.t.Errorf("APIKeyRepositoryMock.TouchLastUsed got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmTouchLastUsed.TouchLastUsedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmTouchLastUsed.t.Fatal("No results are set for the APIKeyRepositoryMock.TouchLastUsed")
+		}
+		return (*mm_results).err
+	}
+	if mmTouchLastUsed.funcTouchLastUsed != nil {
+		return mmTouchLastUsed.funcTouchLastUsed(ctx, id)
+	}
+	mmTouchLastUsed.t.Fatalf("Unexpected call to APIKeyRepositoryMock.TouchLastUsed. %v %v", ctx, id)
+	return
+}
+
+// TouchLastUsedAfterCounter returns a count of finished APIKeyRepositoryMock.TouchLastUsed invocations
+func (mmTouchLastUsed *APIKeyRepositoryMock) TouchLastUsedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTouchLastUsed.afterTouchLastUsedCounter)
+}
+
+// TouchLastUsedBeforeCounter returns a count of APIKeyRepositoryMock.TouchLastUsed invocations
+func (mmTouchLastUsed *APIKeyRepositoryMock) TouchLastUsedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTouchLastUsed.beforeTouchLastUsedCounter)
+}
+
+// Calls returns a list of arguments used in each call to APIKeyRepositoryMock.TouchLastUsed.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmTouchLastUsed *mAPIKeyRepositoryMockTouchLastUsed) Calls() []*APIKeyRepositoryMockTouchLastUsedParams {
+	mmTouchLastUsed.mutex.RLock()
+
+	argCopy := make([]*APIKeyRepositoryMockTouchLastUsedParams, len(mmTouchLastUsed.callArgs))
+	copy(argCopy, mmTouchLastUsed.callArgs)
+
+	mmTouchLastUsed.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockTouchLastUsedDone returns true if the count of the TouchLastUsed invocations corresponds
+// the number of defined expectations
+func (m *APIKeyRepositoryMock) MinimockTouchLastUsedDone() bool {
+	if m.TouchLastUsedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.TouchLastUsedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.TouchLastUsedMock.invocationsDone()
+}
+
+// MinimockTouchLastUsedInspect logs each unmet expectation
+func (m *APIKeyRepositoryMock) MinimockTouchLastUsedInspect() {
+	for _, e := range m.TouchLastUsedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to APIKeyRepositoryMock.TouchLastUsed with params: %#v", *e.params)
+		}
+	}
+
+	afterTouchLastUsedCounter := mm_atomic.LoadUint64(&m.afterTouchLastUsedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TouchLastUsedMock.defaultExpectation != nil && afterTouchLastUsedCounter < 1 {
+		if m.TouchLastUsedMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to APIKeyRepositoryMock.TouchLastUsed")
+		} else {
+			m.t.Errorf("Expected call to APIKeyRepositoryMock.TouchLastUsed with params: %#v", *m.TouchLastUsedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTouchLastUsed != nil && afterTouchLastUsedCounter < 1 {
+		m.t.Error("Expected call to APIKeyRepositoryMock.TouchLastUsed")
+	}
+
+	if !m.TouchLastUsedMock.invocationsDone() && afterTouchLastUsedCounter > 0 {
+		m.t.Errorf("Expected %d calls to APIKeyRepositoryMock.TouchLastUsed but found %d calls",
+			mm_atomic.LoadUint64(&m.TouchLastUsedMock.expectedInvocations), afterTouchLastUsedCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *APIKeyRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockGetByHashInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockRevokeInspect()
+
+			m.MinimockTouchLastUsedInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *APIKeyRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *APIKeyRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockGetByHashDone() &&
+		m.MinimockListDone() &&
+		m.MinimockRevokeDone() &&
+		m.MinimockTouchLastUsedDone()
+}
@@ -11,6 +11,7 @@ import (
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
+	chatModel "github.com/mikhailsoldatkin/chat-server/internal/service/chat/model"
 	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
 )
 
@@ -31,8 +32,32 @@ type ChatRepositoryMock struct {
 	beforeDeleteCounter uint64
 	DeleteMock          mChatRepositoryMockDelete
 
-	funcSendMessage          func(ctx context.Context, req *pb.SendMessageRequest) (err error)
-	inspectFuncSendMessage   func(ctx context.Context, req *pb.SendMessageRequest)
+	funcDeleteMessage          func(ctx context.Context, id int64) (err error)
+	inspectFuncDeleteMessage   func(ctx context.Context, id int64)
+	afterDeleteMessageCounter  uint64
+	beforeDeleteMessageCounter uint64
+	DeleteMessageMock          mChatRepositoryMockDeleteMessage
+
+	funcGetMessage          func(ctx context.Context, id int64) (mp1 *chatModel.Message, err error)
+	inspectFuncGetMessage   func(ctx context.Context, id int64)
+	afterGetMessageCounter  uint64
+	beforeGetMessageCounter uint64
+	GetMessageMock          mChatRepositoryMockGetMessage
+
+	funcIsUserInChat          func(ctx context.Context, userID int64, chatID int64) (err error)
+	inspectFuncIsUserInChat   func(ctx context.Context, userID int64, chatID int64)
+	afterIsUserInChatCounter  uint64
+	beforeIsUserInChatCounter uint64
+	IsUserInChatMock          mChatRepositoryMockIsUserInChat
+
+	funcRemoveUser          func(ctx context.Context, chatID int64, userID int64) (err error)
+	inspectFuncRemoveUser   func(ctx context.Context, chatID int64, userID int64)
+	afterRemoveUserCounter  uint64
+	beforeRemoveUserCounter uint64
+	RemoveUserMock          mChatRepositoryMockRemoveUser
+
+	funcSendMessage          func(ctx context.Context, req *pb.SendMessageRequest, senderType string) (i1 int64, err error)
+	inspectFuncSendMessage   func(ctx context.Context, req *pb.SendMessageRequest, senderType string)
 	afterSendMessageCounter  uint64
 	beforeSendMessageCounter uint64
 	SendMessageMock          mChatRepositoryMockSendMessage
@@ -52,6 +77,18 @@ func NewChatRepositoryMock(t minimock.Tester) *ChatRepositoryMock {
 	m.DeleteMock = mChatRepositoryMockDelete{mock: m}
 	m.DeleteMock.callArgs = []*ChatRepositoryMockDeleteParams{}
 
+	m.DeleteMessageMock = mChatRepositoryMockDeleteMessage{mock: m}
+	m.DeleteMessageMock.callArgs = []*ChatRepositoryMockDeleteMessageParams{}
+
+	m.GetMessageMock = mChatRepositoryMockGetMessage{mock: m}
+	m.GetMessageMock.callArgs = []*ChatRepositoryMockGetMessageParams{}
+
+	m.IsUserInChatMock = mChatRepositoryMockIsUserInChat{mock: m}
+	m.IsUserInChatMock.callArgs = []*ChatRepositoryMockIsUserInChatParams{}
+
+	m.RemoveUserMock = mChatRepositoryMockRemoveUser{mock: m}
+	m.RemoveUserMock.callArgs = []*ChatRepositoryMockRemoveUserParams{}
+
 	m.SendMessageMock = mChatRepositoryMockSendMessage{mock: m}
 	m.SendMessageMock.callArgs = []*ChatRepositoryMockSendMessageParams{}
 
@@ -701,41 +738,41 @@ func (m *ChatRepositoryMock) MinimockDeleteInspect() {
 	}
 }
 
-type mChatRepositoryMockSendMessage struct {
+type mChatRepositoryMockDeleteMessage struct {
 	optional           bool
 	mock               *ChatRepositoryMock
-	defaultExpectation *ChatRepositoryMockSendMessageExpectation
-	expectations       []*ChatRepositoryMockSendMessageExpectation
+	defaultExpectation *ChatRepositoryMockDeleteMessageExpectation
+	expectations       []*ChatRepositoryMockDeleteMessageExpectation
 
-	callArgs []*ChatRepositoryMockSendMessageParams
+	callArgs []*ChatRepositoryMockDeleteMessageParams
 	mutex    sync.RWMutex
 
 	expectedInvocations uint64
 }
 
-// ChatRepositoryMockSendMessageExpectation specifies expectation struct of the ChatRepository.SendMessage
-type ChatRepositoryMockSendMessageExpectation struct {
+// ChatRepositoryMockDeleteMessageExpectation specifies expectation struct of the ChatRepository.DeleteMessage
+type ChatRepositoryMockDeleteMessageExpectation struct {
 	mock      *ChatRepositoryMock
-	params    *ChatRepositoryMockSendMessageParams
-	paramPtrs *ChatRepositoryMockSendMessageParamPtrs
-	results   *ChatRepositoryMockSendMessageResults
+	params    *ChatRepositoryMockDeleteMessageParams
+	paramPtrs *ChatRepositoryMockDeleteMessageParamPtrs
+	results   *ChatRepositoryMockDeleteMessageResults
 	Counter   uint64
 }
 
-// ChatRepositoryMockSendMessageParams contains parameters of the ChatRepository.SendMessage
-type ChatRepositoryMockSendMessageParams struct {
+// ChatRepositoryMockDeleteMessageParams contains parameters of the ChatRepository.DeleteMessage
+type ChatRepositoryMockDeleteMessageParams struct {
 	ctx context.Context
-	req *pb.SendMessageRequest
+	id  int64
 }
 
-// ChatRepositoryMockSendMessageParamPtrs contains pointers to parameters of the ChatRepository.SendMessage
-type ChatRepositoryMockSendMessageParamPtrs struct {
+// ChatRepositoryMockDeleteMessageParamPtrs contains pointers to parameters of the ChatRepository.DeleteMessage
+type ChatRepositoryMockDeleteMessageParamPtrs struct {
 	ctx *context.Context
-	req **pb.SendMessageRequest
+	id  *int64
 }
 
-// ChatRepositoryMockSendMessageResults contains results of the ChatRepository.SendMessage
-type ChatRepositoryMockSendMessageResults struct {
+// ChatRepositoryMockDeleteMessageResults contains results of the ChatRepository.DeleteMessage
+type ChatRepositoryMockDeleteMessageResults struct {
 	err error
 }
 
@@ -744,290 +781,1664 @@ type ChatRepositoryMockSendMessageResults struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmSendMessage *mChatRepositoryMockSendMessage) Optional() *mChatRepositoryMockSendMessage {
-	mmSendMessage.optional = true
-	return mmSendMessage
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) Optional() *mChatRepositoryMockDeleteMessage {
+	mmDeleteMessage.optional = true
+	return mmDeleteMessage
 }
 
-// Expect sets up expected params for ChatRepository.SendMessage
-func (mmSendMessage *mChatRepositoryMockSendMessage) Expect(ctx context.Context, req *pb.SendMessageRequest) *mChatRepositoryMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+// Expect sets up expected params for ChatRepository.DeleteMessage
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) Expect(ctx context.Context, id int64) *mChatRepositoryMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatRepositoryMock.DeleteMessage mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{}
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatRepositoryMockDeleteMessageExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by ExpectParams functions")
+	if mmDeleteMessage.defaultExpectation.paramPtrs != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatRepositoryMock.DeleteMessage mock is already set by ExpectParams functions")
 	}
 
-	mmSendMessage.defaultExpectation.params = &ChatRepositoryMockSendMessageParams{ctx, req}
-	for _, e := range mmSendMessage.expectations {
-		if minimock.Equal(e.params, mmSendMessage.defaultExpectation.params) {
-			mmSendMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendMessage.defaultExpectation.params)
+	mmDeleteMessage.defaultExpectation.params = &ChatRepositoryMockDeleteMessageParams{ctx, id}
+	for _, e := range mmDeleteMessage.expectations {
+		if minimock.Equal(e.params, mmDeleteMessage.defaultExpectation.params) {
+			mmDeleteMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteMessage.defaultExpectation.params)
 		}
 	}
 
-	return mmSendMessage
+	return mmDeleteMessage
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatRepository.SendMessage
-func (mmSendMessage *mChatRepositoryMockSendMessage) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.DeleteMessage
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatRepositoryMock.DeleteMessage mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{}
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatRepositoryMockDeleteMessageExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.params != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Expect")
+	if mmDeleteMessage.defaultExpectation.params != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatRepositoryMock.DeleteMessage mock is already set by Expect")
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs == nil {
-		mmSendMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockSendMessageParamPtrs{}
+	if mmDeleteMessage.defaultExpectation.paramPtrs == nil {
+		mmDeleteMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockDeleteMessageParamPtrs{}
 	}
-	mmSendMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteMessage.defaultExpectation.paramPtrs.ctx = &ctx
 
-	return mmSendMessage
+	return mmDeleteMessage
 }
 
-// ExpectReqParam2 sets up expected param req for ChatRepository.SendMessage
-func (mmSendMessage *mChatRepositoryMockSendMessage) ExpectReqParam2(req *pb.SendMessageRequest) *mChatRepositoryMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+// ExpectIdParam2 sets up expected param id for ChatRepository.DeleteMessage
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) ExpectIdParam2(id int64) *mChatRepositoryMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatRepositoryMock.DeleteMessage mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{}
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatRepositoryMockDeleteMessageExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.params != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Expect")
+	if mmDeleteMessage.defaultExpectation.params != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatRepositoryMock.DeleteMessage mock is already set by Expect")
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs == nil {
-		mmSendMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockSendMessageParamPtrs{}
+	if mmDeleteMessage.defaultExpectation.paramPtrs == nil {
+		mmDeleteMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockDeleteMessageParamPtrs{}
 	}
-	mmSendMessage.defaultExpectation.paramPtrs.req = &req
+	mmDeleteMessage.defaultExpectation.paramPtrs.id = &id
 
-	return mmSendMessage
+	return mmDeleteMessage
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatRepository.SendMessage
-func (mmSendMessage *mChatRepositoryMockSendMessage) Inspect(f func(ctx context.Context, req *pb.SendMessageRequest)) *mChatRepositoryMockSendMessage {
-	if mmSendMessage.mock.inspectFuncSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.SendMessage")
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.DeleteMessage
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) Inspect(f func(ctx context.Context, id int64)) *mChatRepositoryMockDeleteMessage {
+	if mmDeleteMessage.mock.inspectFuncDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.DeleteMessage")
 	}
 
-	mmSendMessage.mock.inspectFuncSendMessage = f
+	mmDeleteMessage.mock.inspectFuncDeleteMessage = f
 
-	return mmSendMessage
+	return mmDeleteMessage
 }
 
-// Return sets up results that will be returned by ChatRepository.SendMessage
-func (mmSendMessage *mChatRepositoryMockSendMessage) Return(err error) *ChatRepositoryMock {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+// Return sets up results that will be returned by ChatRepository.DeleteMessage
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) Return(err error) *ChatRepositoryMock {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatRepositoryMock.DeleteMessage mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{mock: mmSendMessage.mock}
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatRepositoryMockDeleteMessageExpectation{mock: mmDeleteMessage.mock}
 	}
-	mmSendMessage.defaultExpectation.results = &ChatRepositoryMockSendMessageResults{err}
-	return mmSendMessage.mock
+	mmDeleteMessage.defaultExpectation.results = &ChatRepositoryMockDeleteMessageResults{err}
+	return mmDeleteMessage.mock
 }
 
-// Set uses given function f to mock the ChatRepository.SendMessage method
-func (mmSendMessage *mChatRepositoryMockSendMessage) Set(f func(ctx context.Context, req *pb.SendMessageRequest) (err error)) *ChatRepositoryMock {
-	if mmSendMessage.defaultExpectation != nil {
-		mmSendMessage.mock.t.Fatalf("Default expectation is already set for the ChatRepository.SendMessage method")
+// Set uses given function f to mock the ChatRepository.DeleteMessage method
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) Set(f func(ctx context.Context, id int64) (err error)) *ChatRepositoryMock {
+	if mmDeleteMessage.defaultExpectation != nil {
+		mmDeleteMessage.mock.t.Fatalf("Default expectation is already set for the ChatRepository.DeleteMessage method")
 	}
 
-	if len(mmSendMessage.expectations) > 0 {
-		mmSendMessage.mock.t.Fatalf("Some expectations are already set for the ChatRepository.SendMessage method")
+	if len(mmDeleteMessage.expectations) > 0 {
+		mmDeleteMessage.mock.t.Fatalf("Some expectations are already set for the ChatRepository.DeleteMessage method")
 	}
 
-	mmSendMessage.mock.funcSendMessage = f
-	return mmSendMessage.mock
+	mmDeleteMessage.mock.funcDeleteMessage = f
+	return mmDeleteMessage.mock
 }
 
-// When sets expectation for the ChatRepository.SendMessage which will trigger the result defined by the following
+// When sets expectation for the ChatRepository.DeleteMessage which will trigger the result defined by the following
 // Then helper
-func (mmSendMessage *mChatRepositoryMockSendMessage) When(ctx context.Context, req *pb.SendMessageRequest) *ChatRepositoryMockSendMessageExpectation {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) When(ctx context.Context, id int64) *ChatRepositoryMockDeleteMessageExpectation {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatRepositoryMock.DeleteMessage mock is already set by Set")
 	}
 
-	expectation := &ChatRepositoryMockSendMessageExpectation{
-		mock:   mmSendMessage.mock,
-		params: &ChatRepositoryMockSendMessageParams{ctx, req},
+	expectation := &ChatRepositoryMockDeleteMessageExpectation{
+		mock:   mmDeleteMessage.mock,
+		params: &ChatRepositoryMockDeleteMessageParams{ctx, id},
 	}
-	mmSendMessage.expectations = append(mmSendMessage.expectations, expectation)
+	mmDeleteMessage.expectations = append(mmDeleteMessage.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatRepository.SendMessage return parameters for the expectation previously defined by the When method
-func (e *ChatRepositoryMockSendMessageExpectation) Then(err error) *ChatRepositoryMock {
-	e.results = &ChatRepositoryMockSendMessageResults{err}
+// Then sets up ChatRepository.DeleteMessage return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockDeleteMessageExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockDeleteMessageResults{err}
 	return e.mock
 }
 
-// Times sets number of times ChatRepository.SendMessage should be invoked
-func (mmSendMessage *mChatRepositoryMockSendMessage) Times(n uint64) *mChatRepositoryMockSendMessage {
+// Times sets number of times ChatRepository.DeleteMessage should be invoked
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) Times(n uint64) *mChatRepositoryMockDeleteMessage {
 	if n == 0 {
-		mmSendMessage.mock.t.Fatalf("Times of ChatRepositoryMock.SendMessage mock can not be zero")
+		mmDeleteMessage.mock.t.Fatalf("Times of ChatRepositoryMock.DeleteMessage mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmSendMessage.expectedInvocations, n)
-	return mmSendMessage
+	mm_atomic.StoreUint64(&mmDeleteMessage.expectedInvocations, n)
+	return mmDeleteMessage
 }
 
-func (mmSendMessage *mChatRepositoryMockSendMessage) invocationsDone() bool {
-	if len(mmSendMessage.expectations) == 0 && mmSendMessage.defaultExpectation == nil && mmSendMessage.mock.funcSendMessage == nil {
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) invocationsDone() bool {
+	if len(mmDeleteMessage.expectations) == 0 && mmDeleteMessage.defaultExpectation == nil && mmDeleteMessage.mock.funcDeleteMessage == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmSendMessage.mock.afterSendMessageCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmSendMessage.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteMessage.mock.afterDeleteMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteMessage.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// SendMessage implements repository.ChatRepository
-func (mmSendMessage *ChatRepositoryMock) SendMessage(ctx context.Context, req *pb.SendMessageRequest) (err error) {
-	mm_atomic.AddUint64(&mmSendMessage.beforeSendMessageCounter, 1)
-	defer mm_atomic.AddUint64(&mmSendMessage.afterSendMessageCounter, 1)
+// DeleteMessage implements repository.ChatRepository
+func (mmDeleteMessage *ChatRepositoryMock) DeleteMessage(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteMessage.beforeDeleteMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteMessage.afterDeleteMessageCounter, 1)
 
-	if mmSendMessage.inspectFuncSendMessage != nil {
-		mmSendMessage.inspectFuncSendMessage(ctx, req)
+	if mmDeleteMessage.inspectFuncDeleteMessage != nil {
+		mmDeleteMessage.inspectFuncDeleteMessage(ctx, id)
 	}
 
-	mm_params := ChatRepositoryMockSendMessageParams{ctx, req}
+	mm_params := ChatRepositoryMockDeleteMessageParams{ctx, id}
 
 	// Record call args
-	mmSendMessage.SendMessageMock.mutex.Lock()
-	mmSendMessage.SendMessageMock.callArgs = append(mmSendMessage.SendMessageMock.callArgs, &mm_params)
-	mmSendMessage.SendMessageMock.mutex.Unlock()
+	mmDeleteMessage.DeleteMessageMock.mutex.Lock()
+	mmDeleteMessage.DeleteMessageMock.callArgs = append(mmDeleteMessage.DeleteMessageMock.callArgs, &mm_params)
+	mmDeleteMessage.DeleteMessageMock.mutex.Unlock()
 
-	for _, e := range mmSendMessage.SendMessageMock.expectations {
+	for _, e := range mmDeleteMessage.DeleteMessageMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmSendMessage.SendMessageMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmSendMessage.SendMessageMock.defaultExpectation.Counter, 1)
-		mm_want := mmSendMessage.SendMessageMock.defaultExpectation.params
-		mm_want_ptrs := mmSendMessage.SendMessageMock.defaultExpectation.paramPtrs
+	if mmDeleteMessage.DeleteMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteMessage.DeleteMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteMessage.DeleteMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteMessage.DeleteMessageMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatRepositoryMockSendMessageParams{ctx, req}
+		mm_got := ChatRepositoryMockDeleteMessageParams{ctx, id}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmSendMessage.t.Errorf("ChatRepositoryMock.SendMessage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmDeleteMessage.t.Errorf("ChatRepositoryMock.DeleteMessage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
-				mmSendMessage.t.Errorf("ChatRepositoryMock.SendMessage got unexpected parameter req, want: %#v, got: %#v%s\n", *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteMessage.t.Errorf("ChatRepositoryMock.DeleteMessage got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmSendMessage.t.Errorf("ChatRepositoryMock.SendMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmDeleteMessage.t.Errorf("ChatRepositoryMock.DeleteMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmSendMessage.SendMessageMock.defaultExpectation.results
+		mm_results := mmDeleteMessage.DeleteMessageMock.defaultExpectation.results
 		if mm_results == nil {
-			mmSendMessage.t.Fatal("No results are set for the ChatRepositoryMock.SendMessage")
+			mmDeleteMessage.t.Fatal("No results are set for the ChatRepositoryMock.DeleteMessage")
 		}
 		return (*mm_results).err
 	}
-	if mmSendMessage.funcSendMessage != nil {
-		return mmSendMessage.funcSendMessage(ctx, req)
+	if mmDeleteMessage.funcDeleteMessage != nil {
+		return mmDeleteMessage.funcDeleteMessage(ctx, id)
 	}
-	mmSendMessage.t.Fatalf("Unexpected call to ChatRepositoryMock.SendMessage. %v %v", ctx, req)
+	mmDeleteMessage.t.Fatalf("Unexpected call to ChatRepositoryMock.DeleteMessage. %v %v", ctx, id)
 	return
 }
 
-// SendMessageAfterCounter returns a count of finished ChatRepositoryMock.SendMessage invocations
-func (mmSendMessage *ChatRepositoryMock) SendMessageAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSendMessage.afterSendMessageCounter)
+// DeleteMessageAfterCounter returns a count of finished ChatRepositoryMock.DeleteMessage invocations
+func (mmDeleteMessage *ChatRepositoryMock) DeleteMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteMessage.afterDeleteMessageCounter)
 }
 
-// SendMessageBeforeCounter returns a count of ChatRepositoryMock.SendMessage invocations
-func (mmSendMessage *ChatRepositoryMock) SendMessageBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSendMessage.beforeSendMessageCounter)
+// DeleteMessageBeforeCounter returns a count of ChatRepositoryMock.DeleteMessage invocations
+func (mmDeleteMessage *ChatRepositoryMock) DeleteMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteMessage.beforeDeleteMessageCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatRepositoryMock.SendMessage.
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.DeleteMessage.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmSendMessage *mChatRepositoryMockSendMessage) Calls() []*ChatRepositoryMockSendMessageParams {
-	mmSendMessage.mutex.RLock()
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) Calls() []*ChatRepositoryMockDeleteMessageParams {
+	mmDeleteMessage.mutex.RLock()
 
-	argCopy := make([]*ChatRepositoryMockSendMessageParams, len(mmSendMessage.callArgs))
-	copy(argCopy, mmSendMessage.callArgs)
+	argCopy := make([]*ChatRepositoryMockDeleteMessageParams, len(mmDeleteMessage.callArgs))
+	copy(argCopy, mmDeleteMessage.callArgs)
 
-	mmSendMessage.mutex.RUnlock()
+	mmDeleteMessage.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockSendMessageDone returns true if the count of the SendMessage invocations corresponds
+// MinimockDeleteMessageDone returns true if the count of the DeleteMessage invocations corresponds
 // the number of defined expectations
-func (m *ChatRepositoryMock) MinimockSendMessageDone() bool {
-	if m.SendMessageMock.optional {
+func (m *ChatRepositoryMock) MinimockDeleteMessageDone() bool {
+	if m.DeleteMessageMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.SendMessageMock.expectations {
+	for _, e := range m.DeleteMessageMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.SendMessageMock.invocationsDone()
+	return m.DeleteMessageMock.invocationsDone()
 }
 
-// MinimockSendMessageInspect logs each unmet expectation
-func (m *ChatRepositoryMock) MinimockSendMessageInspect() {
-	for _, e := range m.SendMessageMock.expectations {
+// MinimockDeleteMessageInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockDeleteMessageInspect() {
+	for _, e := range m.DeleteMessageMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatRepositoryMock.SendMessage with params: %#v", *e.params)
+			m.t.Errorf("Expected call to ChatRepositoryMock.DeleteMessage with params: %#v", *e.params)
 		}
 	}
 
-	afterSendMessageCounter := mm_atomic.LoadUint64(&m.afterSendMessageCounter)
+	afterDeleteMessageCounter := mm_atomic.LoadUint64(&m.afterDeleteMessageCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SendMessageMock.defaultExpectation != nil && afterSendMessageCounter < 1 {
-		if m.SendMessageMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to ChatRepositoryMock.SendMessage")
+	if m.DeleteMessageMock.defaultExpectation != nil && afterDeleteMessageCounter < 1 {
+		if m.DeleteMessageMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatRepositoryMock.DeleteMessage")
 		} else {
-			m.t.Errorf("Expected call to ChatRepositoryMock.SendMessage with params: %#v", *m.SendMessageMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatRepositoryMock.DeleteMessage with params: %#v", *m.DeleteMessageMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSendMessage != nil && afterSendMessageCounter < 1 {
-		m.t.Error("Expected call to ChatRepositoryMock.SendMessage")
+	if m.funcDeleteMessage != nil && afterDeleteMessageCounter < 1 {
+		m.t.Error("Expected call to ChatRepositoryMock.DeleteMessage")
 	}
 
-	if !m.SendMessageMock.invocationsDone() && afterSendMessageCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatRepositoryMock.SendMessage but found %d calls",
-			mm_atomic.LoadUint64(&m.SendMessageMock.expectedInvocations), afterSendMessageCounter)
+	if !m.DeleteMessageMock.invocationsDone() && afterDeleteMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.DeleteMessage but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMessageMock.expectedInvocations), afterDeleteMessageCounter)
 	}
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *ChatRepositoryMock) MinimockFinish() {
-	m.finishOnce.Do(func() {
-		if !m.minimockDone() {
-			m.MinimockCreateInspect()
+type mChatRepositoryMockGetMessage struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockGetMessageExpectation
+	expectations       []*ChatRepositoryMockGetMessageExpectation
 
-			m.MinimockDeleteInspect()
+	callArgs []*ChatRepositoryMockGetMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatRepositoryMockGetMessageExpectation specifies expectation struct of the ChatRepository.GetMessage
+type ChatRepositoryMockGetMessageExpectation struct {
+	mock      *ChatRepositoryMock
+	params    *ChatRepositoryMockGetMessageParams
+	paramPtrs *ChatRepositoryMockGetMessageParamPtrs
+	results   *ChatRepositoryMockGetMessageResults
+	Counter   uint64
+}
+
+// ChatRepositoryMockGetMessageParams contains parameters of the ChatRepository.GetMessage
+type ChatRepositoryMockGetMessageParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// ChatRepositoryMockGetMessageParamPtrs contains pointers to parameters of the ChatRepository.GetMessage
+type ChatRepositoryMockGetMessageParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// ChatRepositoryMockGetMessageResults contains results of the ChatRepository.GetMessage
+type ChatRepositoryMockGetMessageResults struct {
+	mp1 *chatModel.Message
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetMessage *mChatRepositoryMockGetMessage) Optional() *mChatRepositoryMockGetMessage {
+	mmGetMessage.optional = true
+	return mmGetMessage
+}
+
+// Expect sets up expected params for ChatRepository.GetMessage
+func (mmGetMessage *mChatRepositoryMockGetMessage) Expect(ctx context.Context, id int64) *mChatRepositoryMockGetMessage {
+	if mmGetMessage.mock.funcGetMessage != nil {
+		mmGetMessage.mock.t.Fatalf("ChatRepositoryMock.GetMessage mock is already set by Set")
+	}
+
+	if mmGetMessage.defaultExpectation == nil {
+		mmGetMessage.defaultExpectation = &ChatRepositoryMockGetMessageExpectation{}
+	}
+
+	if mmGetMessage.defaultExpectation.paramPtrs != nil {
+		mmGetMessage.mock.t.Fatalf("ChatRepositoryMock.GetMessage mock is already set by ExpectParams functions")
+	}
+
+	mmGetMessage.defaultExpectation.params = &ChatRepositoryMockGetMessageParams{ctx, id}
+	for _, e := range mmGetMessage.expectations {
+		if minimock.Equal(e.params, mmGetMessage.defaultExpectation.params) {
+			mmGetMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmGetMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.GetMessage
+func (mmGetMessage *mChatRepositoryMockGetMessage) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockGetMessage {
+	if mmGetMessage.mock.funcGetMessage != nil {
+		mmGetMessage.mock.t.Fatalf("ChatRepositoryMock.GetMessage mock is already set by Set")
+	}
+
+	if mmGetMessage.defaultExpectation == nil {
+		mmGetMessage.defaultExpectation = &ChatRepositoryMockGetMessageExpectation{}
+	}
+
+	if mmGetMessage.defaultExpectation.params != nil {
+		mmGetMessage.mock.t.Fatalf("ChatRepositoryMock.GetMessage mock is already set by Expect")
+	}
+
+	if mmGetMessage.defaultExpectation.paramPtrs == nil {
+		mmGetMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockGetMessageParamPtrs{}
+	}
+	mmGetMessage.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetMessage
+}
+
+// ExpectIdParam2 sets up expected param id for ChatRepository.GetMessage
+func (mmGetMessage *mChatRepositoryMockGetMessage) ExpectIdParam2(id int64) *mChatRepositoryMockGetMessage {
+	if mmGetMessage.mock.funcGetMessage != nil {
+		mmGetMessage.mock.t.Fatalf("ChatRepositoryMock.GetMessage mock is already set by Set")
+	}
+
+	if mmGetMessage.defaultExpectation == nil {
+		mmGetMessage.defaultExpectation = &ChatRepositoryMockGetMessageExpectation{}
+	}
+
+	if mmGetMessage.defaultExpectation.params != nil {
+		mmGetMessage.mock.t.Fatalf("ChatRepositoryMock.GetMessage mock is already set by Expect")
+	}
+
+	if mmGetMessage.defaultExpectation.paramPtrs == nil {
+		mmGetMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockGetMessageParamPtrs{}
+	}
+	mmGetMessage.defaultExpectation.paramPtrs.id = &id
+
+	return mmGetMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.GetMessage
+func (mmGetMessage *mChatRepositoryMockGetMessage) Inspect(f func(ctx context.Context, id int64)) *mChatRepositoryMockGetMessage {
+	if mmGetMessage.mock.inspectFuncGetMessage != nil {
+		mmGetMessage.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.GetMessage")
+	}
+
+	mmGetMessage.mock.inspectFuncGetMessage = f
+
+	return mmGetMessage
+}
+
+// Return sets up results that will be returned by ChatRepository.GetMessage
+func (mmGetMessage *mChatRepositoryMockGetMessage) Return(mp1 *chatModel.Message, err error) *ChatRepositoryMock {
+	if mmGetMessage.mock.funcGetMessage != nil {
+		mmGetMessage.mock.t.Fatalf("ChatRepositoryMock.GetMessage mock is already set by Set")
+	}
+
+	if mmGetMessage.defaultExpectation == nil {
+		mmGetMessage.defaultExpectation = &ChatRepositoryMockGetMessageExpectation{mock: mmGetMessage.mock}
+	}
+	mmGetMessage.defaultExpectation.results = &ChatRepositoryMockGetMessageResults{mp1, err}
+	return mmGetMessage.mock
+}
+
+// Set uses given function f to mock the ChatRepository.GetMessage method
+func (mmGetMessage *mChatRepositoryMockGetMessage) Set(f func(ctx context.Context, id int64) (mp1 *chatModel.Message, err error)) *ChatRepositoryMock {
+	if mmGetMessage.defaultExpectation != nil {
+		mmGetMessage.mock.t.Fatalf("Default expectation is already set for the ChatRepository.GetMessage method")
+	}
+
+	if len(mmGetMessage.expectations) > 0 {
+		mmGetMessage.mock.t.Fatalf("Some expectations are already set for the ChatRepository.GetMessage method")
+	}
+
+	mmGetMessage.mock.funcGetMessage = f
+	return mmGetMessage.mock
+}
+
+// When sets expectation for the ChatRepository.GetMessage which will trigger the result defined by the following
+// Then helper
+func (mmGetMessage *mChatRepositoryMockGetMessage) When(ctx context.Context, id int64) *ChatRepositoryMockGetMessageExpectation {
+	if mmGetMessage.mock.funcGetMessage != nil {
+		mmGetMessage.mock.t.Fatalf("ChatRepositoryMock.GetMessage mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockGetMessageExpectation{
+		mock:   mmGetMessage.mock,
+		params: &ChatRepositoryMockGetMessageParams{ctx, id},
+	}
+	mmGetMessage.expectations = append(mmGetMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.GetMessage return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockGetMessageExpectation) Then(mp1 *chatModel.Message, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockGetMessageResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.GetMessage should be invoked
+func (mmGetMessage *mChatRepositoryMockGetMessage) Times(n uint64) *mChatRepositoryMockGetMessage {
+	if n == 0 {
+		mmGetMessage.mock.t.Fatalf("Times of ChatRepositoryMock.GetMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetMessage.expectedInvocations, n)
+	return mmGetMessage
+}
+
+func (mmGetMessage *mChatRepositoryMockGetMessage) invocationsDone() bool {
+	if len(mmGetMessage.expectations) == 0 && mmGetMessage.defaultExpectation == nil && mmGetMessage.mock.funcGetMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetMessage.mock.afterGetMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetMessage implements repository.ChatRepository
+func (mmGetMessage *ChatRepositoryMock) GetMessage(ctx context.Context, id int64) (mp1 *chatModel.Message, err error) {
+	mm_atomic.AddUint64(&mmGetMessage.beforeGetMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetMessage.afterGetMessageCounter, 1)
+
+	if mmGetMessage.inspectFuncGetMessage != nil {
+		mmGetMessage.inspectFuncGetMessage(ctx, id)
+	}
+
+	mm_params := ChatRepositoryMockGetMessageParams{ctx, id}
+
+	// Record call args
+	mmGetMessage.GetMessageMock.mutex.Lock()
+	mmGetMessage.GetMessageMock.callArgs = append(mmGetMessage.GetMessageMock.callArgs, &mm_params)
+	mmGetMessage.GetMessageMock.mutex.Unlock()
+
+	for _, e := range mmGetMessage.GetMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmGetMessage.GetMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetMessage.GetMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetMessage.GetMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmGetMessage.GetMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockGetMessageParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetMessage.t.Errorf("ChatRepositoryMock.GetMessage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetMessage.t.Errorf("ChatRepositoryMock.GetMessage got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetMessage.t.Errorf("ChatRepositoryMock.GetMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetMessage.GetMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetMessage.t.Fatal("No results are set for the ChatRepositoryMock.GetMessage")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmGetMessage.funcGetMessage != nil {
+		return mmGetMessage.funcGetMessage(ctx, id)
+	}
+	mmGetMessage.t.Fatalf("Unexpected call to ChatRepositoryMock.GetMessage. %v %v", ctx, id)
+	return
+}
+
+// GetMessageAfterCounter returns a count of finished ChatRepositoryMock.GetMessage invocations
+func (mmGetMessage *ChatRepositoryMock) GetMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMessage.afterGetMessageCounter)
+}
+
+// GetMessageBeforeCounter returns a count of ChatRepositoryMock.GetMessage invocations
+func (mmGetMessage *ChatRepositoryMock) GetMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMessage.beforeGetMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.GetMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetMessage *mChatRepositoryMockGetMessage) Calls() []*ChatRepositoryMockGetMessageParams {
+	mmGetMessage.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockGetMessageParams, len(mmGetMessage.callArgs))
+	copy(argCopy, mmGetMessage.callArgs)
+
+	mmGetMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetMessageDone returns true if the count of the GetMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockGetMessageDone() bool {
+	if m.GetMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMessageMock.invocationsDone()
+}
+
+// MinimockGetMessageInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockGetMessageInspect() {
+	for _, e := range m.GetMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetMessage with params: %#v", *e.params)
+		}
+	}
+
+	afterGetMessageCounter := mm_atomic.LoadUint64(&m.afterGetMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMessageMock.defaultExpectation != nil && afterGetMessageCounter < 1 {
+		if m.GetMessageMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatRepositoryMock.GetMessage")
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetMessage with params: %#v", *m.GetMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetMessage != nil && afterGetMessageCounter < 1 {
+		m.t.Error("Expected call to ChatRepositoryMock.GetMessage")
+	}
+
+	if !m.GetMessageMock.invocationsDone() && afterGetMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.GetMessage but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMessageMock.expectedInvocations), afterGetMessageCounter)
+	}
+}
+
+type mChatRepositoryMockIsUserInChat struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockIsUserInChatExpectation
+	expectations       []*ChatRepositoryMockIsUserInChatExpectation
+
+	callArgs []*ChatRepositoryMockIsUserInChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatRepositoryMockIsUserInChatExpectation specifies expectation struct of the ChatRepository.IsUserInChat
+type ChatRepositoryMockIsUserInChatExpectation struct {
+	mock      *ChatRepositoryMock
+	params    *ChatRepositoryMockIsUserInChatParams
+	paramPtrs *ChatRepositoryMockIsUserInChatParamPtrs
+	results   *ChatRepositoryMockIsUserInChatResults
+	Counter   uint64
+}
+
+// ChatRepositoryMockIsUserInChatParams contains parameters of the ChatRepository.IsUserInChat
+type ChatRepositoryMockIsUserInChatParams struct {
+	ctx    context.Context
+	userID int64
+	chatID int64
+}
+
+// ChatRepositoryMockIsUserInChatParamPtrs contains pointers to parameters of the ChatRepository.IsUserInChat
+type ChatRepositoryMockIsUserInChatParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	chatID *int64
+}
+
+// ChatRepositoryMockIsUserInChatResults contains results of the ChatRepository.IsUserInChat
+type ChatRepositoryMockIsUserInChatResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmIsUserInChat *mChatRepositoryMockIsUserInChat) Optional() *mChatRepositoryMockIsUserInChat {
+	mmIsUserInChat.optional = true
+	return mmIsUserInChat
+}
+
+// Expect sets up expected params for ChatRepository.IsUserInChat
+func (mmIsUserInChat *mChatRepositoryMockIsUserInChat) Expect(ctx context.Context, userID int64, chatID int64) *mChatRepositoryMockIsUserInChat {
+	if mmIsUserInChat.mock.funcIsUserInChat != nil {
+		mmIsUserInChat.mock.t.Fatalf("ChatRepositoryMock.IsUserInChat mock is already set by Set")
+	}
+
+	if mmIsUserInChat.defaultExpectation == nil {
+		mmIsUserInChat.defaultExpectation = &ChatRepositoryMockIsUserInChatExpectation{}
+	}
+
+	if mmIsUserInChat.defaultExpectation.paramPtrs != nil {
+		mmIsUserInChat.mock.t.Fatalf("ChatRepositoryMock.IsUserInChat mock is already set by ExpectParams functions")
+	}
+
+	mmIsUserInChat.defaultExpectation.params = &ChatRepositoryMockIsUserInChatParams{ctx, userID, chatID}
+	for _, e := range mmIsUserInChat.expectations {
+		if minimock.Equal(e.params, mmIsUserInChat.defaultExpectation.params) {
+			mmIsUserInChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIsUserInChat.defaultExpectation.params)
+		}
+	}
+
+	return mmIsUserInChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.IsUserInChat
+func (mmIsUserInChat *mChatRepositoryMockIsUserInChat) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockIsUserInChat {
+	if mmIsUserInChat.mock.funcIsUserInChat != nil {
+		mmIsUserInChat.mock.t.Fatalf("ChatRepositoryMock.IsUserInChat mock is already set by Set")
+	}
+
+	if mmIsUserInChat.defaultExpectation == nil {
+		mmIsUserInChat.defaultExpectation = &ChatRepositoryMockIsUserInChatExpectation{}
+	}
+
+	if mmIsUserInChat.defaultExpectation.params != nil {
+		mmIsUserInChat.mock.t.Fatalf("ChatRepositoryMock.IsUserInChat mock is already set by Expect")
+	}
+
+	if mmIsUserInChat.defaultExpectation.paramPtrs == nil {
+		mmIsUserInChat.defaultExpectation.paramPtrs = &ChatRepositoryMockIsUserInChatParamPtrs{}
+	}
+	mmIsUserInChat.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmIsUserInChat
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ChatRepository.IsUserInChat
+func (mmIsUserInChat *mChatRepositoryMockIsUserInChat) ExpectUserIDParam2(userID int64) *mChatRepositoryMockIsUserInChat {
+	if mmIsUserInChat.mock.funcIsUserInChat != nil {
+		mmIsUserInChat.mock.t.Fatalf("ChatRepositoryMock.IsUserInChat mock is already set by Set")
+	}
+
+	if mmIsUserInChat.defaultExpectation == nil {
+		mmIsUserInChat.defaultExpectation = &ChatRepositoryMockIsUserInChatExpectation{}
+	}
+
+	if mmIsUserInChat.defaultExpectation.params != nil {
+		mmIsUserInChat.mock.t.Fatalf("ChatRepositoryMock.IsUserInChat mock is already set by Expect")
+	}
+
+	if mmIsUserInChat.defaultExpectation.paramPtrs == nil {
+		mmIsUserInChat.defaultExpectation.paramPtrs = &ChatRepositoryMockIsUserInChatParamPtrs{}
+	}
+	mmIsUserInChat.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmIsUserInChat
+}
+
+// ExpectChatIDParam3 sets up expected param chatID for ChatRepository.IsUserInChat
+func (mmIsUserInChat *mChatRepositoryMockIsUserInChat) ExpectChatIDParam3(chatID int64) *mChatRepositoryMockIsUserInChat {
+	if mmIsUserInChat.mock.funcIsUserInChat != nil {
+		mmIsUserInChat.mock.t.Fatalf("ChatRepositoryMock.IsUserInChat mock is already set by Set")
+	}
+
+	if mmIsUserInChat.defaultExpectation == nil {
+		mmIsUserInChat.defaultExpectation = &ChatRepositoryMockIsUserInChatExpectation{}
+	}
+
+	if mmIsUserInChat.defaultExpectation.params != nil {
+		mmIsUserInChat.mock.t.Fatalf("ChatRepositoryMock.IsUserInChat mock is already set by Expect")
+	}
+
+	if mmIsUserInChat.defaultExpectation.paramPtrs == nil {
+		mmIsUserInChat.defaultExpectation.paramPtrs = &ChatRepositoryMockIsUserInChatParamPtrs{}
+	}
+	mmIsUserInChat.defaultExpectation.paramPtrs.chatID = &chatID
+
+	return mmIsUserInChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.IsUserInChat
+func (mmIsUserInChat *mChatRepositoryMockIsUserInChat) Inspect(f func(ctx context.Context, userID int64, chatID int64)) *mChatRepositoryMockIsUserInChat {
+	if mmIsUserInChat.mock.inspectFuncIsUserInChat != nil {
+		mmIsUserInChat.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.IsUserInChat")
+	}
+
+	mmIsUserInChat.mock.inspectFuncIsUserInChat = f
+
+	return mmIsUserInChat
+}
+
+// Return sets up results that will be returned by ChatRepository.IsUserInChat
+func (mmIsUserInChat *mChatRepositoryMockIsUserInChat) Return(err error) *ChatRepositoryMock {
+	if mmIsUserInChat.mock.funcIsUserInChat != nil {
+		mmIsUserInChat.mock.t.Fatalf("ChatRepositoryMock.IsUserInChat mock is already set by Set")
+	}
+
+	if mmIsUserInChat.defaultExpectation == nil {
+		mmIsUserInChat.defaultExpectation = &ChatRepositoryMockIsUserInChatExpectation{mock: mmIsUserInChat.mock}
+	}
+	mmIsUserInChat.defaultExpectation.results = &ChatRepositoryMockIsUserInChatResults{err}
+	return mmIsUserInChat.mock
+}
+
+// Set uses given function f to mock the ChatRepository.IsUserInChat method
+func (mmIsUserInChat *mChatRepositoryMockIsUserInChat) Set(f func(ctx context.Context, userID int64, chatID int64) (err error)) *ChatRepositoryMock {
+	if mmIsUserInChat.defaultExpectation != nil {
+		mmIsUserInChat.mock.t.Fatalf("Default expectation is already set for the ChatRepository.IsUserInChat method")
+	}
+
+	if len(mmIsUserInChat.expectations) > 0 {
+		mmIsUserInChat.mock.t.Fatalf("Some expectations are already set for the ChatRepository.IsUserInChat method")
+	}
+
+	mmIsUserInChat.mock.funcIsUserInChat = f
+	return mmIsUserInChat.mock
+}
+
+// When sets expectation for the ChatRepository.IsUserInChat which will trigger the result defined by the following
+// Then helper
+func (mmIsUserInChat *mChatRepositoryMockIsUserInChat) When(ctx context.Context, userID int64, chatID int64) *ChatRepositoryMockIsUserInChatExpectation {
+	if mmIsUserInChat.mock.funcIsUserInChat != nil {
+		mmIsUserInChat.mock.t.Fatalf("ChatRepositoryMock.IsUserInChat mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockIsUserInChatExpectation{
+		mock:   mmIsUserInChat.mock,
+		params: &ChatRepositoryMockIsUserInChatParams{ctx, userID, chatID},
+	}
+	mmIsUserInChat.expectations = append(mmIsUserInChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.IsUserInChat return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockIsUserInChatExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockIsUserInChatResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.IsUserInChat should be invoked
+func (mmIsUserInChat *mChatRepositoryMockIsUserInChat) Times(n uint64) *mChatRepositoryMockIsUserInChat {
+	if n == 0 {
+		mmIsUserInChat.mock.t.Fatalf("Times of ChatRepositoryMock.IsUserInChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmIsUserInChat.expectedInvocations, n)
+	return mmIsUserInChat
+}
+
+func (mmIsUserInChat *mChatRepositoryMockIsUserInChat) invocationsDone() bool {
+	if len(mmIsUserInChat.expectations) == 0 && mmIsUserInChat.defaultExpectation == nil && mmIsUserInChat.mock.funcIsUserInChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmIsUserInChat.mock.afterIsUserInChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmIsUserInChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// IsUserInChat implements repository.ChatRepository
+func (mmIsUserInChat *ChatRepositoryMock) IsUserInChat(ctx context.Context, userID int64, chatID int64) (err error) {
+	mm_atomic.AddUint64(&mmIsUserInChat.beforeIsUserInChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmIsUserInChat.afterIsUserInChatCounter, 1)
+
+	if mmIsUserInChat.inspectFuncIsUserInChat != nil {
+		mmIsUserInChat.inspectFuncIsUserInChat(ctx, userID, chatID)
+	}
+
+	mm_params := ChatRepositoryMockIsUserInChatParams{ctx, userID, chatID}
+
+	// Record call args
+	mmIsUserInChat.IsUserInChatMock.mutex.Lock()
+	mmIsUserInChat.IsUserInChatMock.callArgs = append(mmIsUserInChat.IsUserInChatMock.callArgs, &mm_params)
+	mmIsUserInChat.IsUserInChatMock.mutex.Unlock()
+
+	for _, e := range mmIsUserInChat.IsUserInChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmIsUserInChat.IsUserInChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIsUserInChat.IsUserInChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmIsUserInChat.IsUserInChatMock.defaultExpectation.params
+		mm_want_ptrs := mmIsUserInChat.IsUserInChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockIsUserInChatParams{ctx, userID, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmIsUserInChat.t.Errorf("ChatRepositoryMock.IsUserInChat got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmIsUserInChat.t.Errorf("ChatRepositoryMock.IsUserInChat got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmIsUserInChat.t.Errorf("ChatRepositoryMock.IsUserInChat got unexpected parameter chatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIsUserInChat.t.Errorf("ChatRepositoryMock.IsUserInChat got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIsUserInChat.IsUserInChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIsUserInChat.t.Fatal("No results are set for the ChatRepositoryMock.IsUserInChat")
+		}
+		return (*mm_results).err
+	}
+	if mmIsUserInChat.funcIsUserInChat != nil {
+		return mmIsUserInChat.funcIsUserInChat(ctx, userID, chatID)
+	}
+	mmIsUserInChat.t.Fatalf("Unexpected call to ChatRepositoryMock.IsUserInChat. %v %v %v", ctx, userID, chatID)
+	return
+}
+
+// IsUserInChatAfterCounter returns a count of finished ChatRepositoryMock.IsUserInChat invocations
+func (mmIsUserInChat *ChatRepositoryMock) IsUserInChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsUserInChat.afterIsUserInChatCounter)
+}
+
+// IsUserInChatBeforeCounter returns a count of ChatRepositoryMock.IsUserInChat invocations
+func (mmIsUserInChat *ChatRepositoryMock) IsUserInChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsUserInChat.beforeIsUserInChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.IsUserInChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIsUserInChat *mChatRepositoryMockIsUserInChat) Calls() []*ChatRepositoryMockIsUserInChatParams {
+	mmIsUserInChat.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockIsUserInChatParams, len(mmIsUserInChat.callArgs))
+	copy(argCopy, mmIsUserInChat.callArgs)
+
+	mmIsUserInChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIsUserInChatDone returns true if the count of the IsUserInChat invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockIsUserInChatDone() bool {
+	if m.IsUserInChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.IsUserInChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.IsUserInChatMock.invocationsDone()
+}
+
+// MinimockIsUserInChatInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockIsUserInChatInspect() {
+	for _, e := range m.IsUserInChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.IsUserInChat with params: %#v", *e.params)
+		}
+	}
+
+	afterIsUserInChatCounter := mm_atomic.LoadUint64(&m.afterIsUserInChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IsUserInChatMock.defaultExpectation != nil && afterIsUserInChatCounter < 1 {
+		if m.IsUserInChatMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatRepositoryMock.IsUserInChat")
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.IsUserInChat with params: %#v", *m.IsUserInChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIsUserInChat != nil && afterIsUserInChatCounter < 1 {
+		m.t.Error("Expected call to ChatRepositoryMock.IsUserInChat")
+	}
+
+	if !m.IsUserInChatMock.invocationsDone() && afterIsUserInChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.IsUserInChat but found %d calls",
+			mm_atomic.LoadUint64(&m.IsUserInChatMock.expectedInvocations), afterIsUserInChatCounter)
+	}
+}
+
+type mChatRepositoryMockRemoveUser struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockRemoveUserExpectation
+	expectations       []*ChatRepositoryMockRemoveUserExpectation
+
+	callArgs []*ChatRepositoryMockRemoveUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatRepositoryMockRemoveUserExpectation specifies expectation struct of the ChatRepository.RemoveUser
+type ChatRepositoryMockRemoveUserExpectation struct {
+	mock      *ChatRepositoryMock
+	params    *ChatRepositoryMockRemoveUserParams
+	paramPtrs *ChatRepositoryMockRemoveUserParamPtrs
+	results   *ChatRepositoryMockRemoveUserResults
+	Counter   uint64
+}
+
+// ChatRepositoryMockRemoveUserParams contains parameters of the ChatRepository.RemoveUser
+type ChatRepositoryMockRemoveUserParams struct {
+	ctx    context.Context
+	chatID int64
+	userID int64
+}
+
+// ChatRepositoryMockRemoveUserParamPtrs contains pointers to parameters of the ChatRepository.RemoveUser
+type ChatRepositoryMockRemoveUserParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+	userID *int64
+}
+
+// ChatRepositoryMockRemoveUserResults contains results of the ChatRepository.RemoveUser
+type ChatRepositoryMockRemoveUserResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveUser *mChatRepositoryMockRemoveUser) Optional() *mChatRepositoryMockRemoveUser {
+	mmRemoveUser.optional = true
+	return mmRemoveUser
+}
+
+// Expect sets up expected params for ChatRepository.RemoveUser
+func (mmRemoveUser *mChatRepositoryMockRemoveUser) Expect(ctx context.Context, chatID int64, userID int64) *mChatRepositoryMockRemoveUser {
+	if mmRemoveUser.mock.funcRemoveUser != nil {
+		mmRemoveUser.mock.t.Fatalf("ChatRepositoryMock.RemoveUser mock is already set by Set")
+	}
+
+	if mmRemoveUser.defaultExpectation == nil {
+		mmRemoveUser.defaultExpectation = &ChatRepositoryMockRemoveUserExpectation{}
+	}
+
+	if mmRemoveUser.defaultExpectation.paramPtrs != nil {
+		mmRemoveUser.mock.t.Fatalf("ChatRepositoryMock.RemoveUser mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveUser.defaultExpectation.params = &ChatRepositoryMockRemoveUserParams{ctx, chatID, userID}
+	for _, e := range mmRemoveUser.expectations {
+		if minimock.Equal(e.params, mmRemoveUser.defaultExpectation.params) {
+			mmRemoveUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveUser.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.RemoveUser
+func (mmRemoveUser *mChatRepositoryMockRemoveUser) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockRemoveUser {
+	if mmRemoveUser.mock.funcRemoveUser != nil {
+		mmRemoveUser.mock.t.Fatalf("ChatRepositoryMock.RemoveUser mock is already set by Set")
+	}
+
+	if mmRemoveUser.defaultExpectation == nil {
+		mmRemoveUser.defaultExpectation = &ChatRepositoryMockRemoveUserExpectation{}
+	}
+
+	if mmRemoveUser.defaultExpectation.params != nil {
+		mmRemoveUser.mock.t.Fatalf("ChatRepositoryMock.RemoveUser mock is already set by Expect")
+	}
+
+	if mmRemoveUser.defaultExpectation.paramPtrs == nil {
+		mmRemoveUser.defaultExpectation.paramPtrs = &ChatRepositoryMockRemoveUserParamPtrs{}
+	}
+	mmRemoveUser.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRemoveUser
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.RemoveUser
+func (mmRemoveUser *mChatRepositoryMockRemoveUser) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockRemoveUser {
+	if mmRemoveUser.mock.funcRemoveUser != nil {
+		mmRemoveUser.mock.t.Fatalf("ChatRepositoryMock.RemoveUser mock is already set by Set")
+	}
+
+	if mmRemoveUser.defaultExpectation == nil {
+		mmRemoveUser.defaultExpectation = &ChatRepositoryMockRemoveUserExpectation{}
+	}
+
+	if mmRemoveUser.defaultExpectation.params != nil {
+		mmRemoveUser.mock.t.Fatalf("ChatRepositoryMock.RemoveUser mock is already set by Expect")
+	}
+
+	if mmRemoveUser.defaultExpectation.paramPtrs == nil {
+		mmRemoveUser.defaultExpectation.paramPtrs = &ChatRepositoryMockRemoveUserParamPtrs{}
+	}
+	mmRemoveUser.defaultExpectation.paramPtrs.chatID = &chatID
+
+	return mmRemoveUser
+}
+
+// ExpectUserIDParam3 sets up expected param userID for ChatRepository.RemoveUser
+func (mmRemoveUser *mChatRepositoryMockRemoveUser) ExpectUserIDParam3(userID int64) *mChatRepositoryMockRemoveUser {
+	if mmRemoveUser.mock.funcRemoveUser != nil {
+		mmRemoveUser.mock.t.Fatalf("ChatRepositoryMock.RemoveUser mock is already set by Set")
+	}
+
+	if mmRemoveUser.defaultExpectation == nil {
+		mmRemoveUser.defaultExpectation = &ChatRepositoryMockRemoveUserExpectation{}
+	}
+
+	if mmRemoveUser.defaultExpectation.params != nil {
+		mmRemoveUser.mock.t.Fatalf("ChatRepositoryMock.RemoveUser mock is already set by Expect")
+	}
+
+	if mmRemoveUser.defaultExpectation.paramPtrs == nil {
+		mmRemoveUser.defaultExpectation.paramPtrs = &ChatRepositoryMockRemoveUserParamPtrs{}
+	}
+	mmRemoveUser.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmRemoveUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.RemoveUser
+func (mmRemoveUser *mChatRepositoryMockRemoveUser) Inspect(f func(ctx context.Context, chatID int64, userID int64)) *mChatRepositoryMockRemoveUser {
+	if mmRemoveUser.mock.inspectFuncRemoveUser != nil {
+		mmRemoveUser.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.RemoveUser")
+	}
+
+	mmRemoveUser.mock.inspectFuncRemoveUser = f
+
+	return mmRemoveUser
+}
+
+// Return sets up results that will be returned by ChatRepository.RemoveUser
+func (mmRemoveUser *mChatRepositoryMockRemoveUser) Return(err error) *ChatRepositoryMock {
+	if mmRemoveUser.mock.funcRemoveUser != nil {
+		mmRemoveUser.mock.t.Fatalf("ChatRepositoryMock.RemoveUser mock is already set by Set")
+	}
+
+	if mmRemoveUser.defaultExpectation == nil {
+		mmRemoveUser.defaultExpectation = &ChatRepositoryMockRemoveUserExpectation{mock: mmRemoveUser.mock}
+	}
+	mmRemoveUser.defaultExpectation.results = &ChatRepositoryMockRemoveUserResults{err}
+	return mmRemoveUser.mock
+}
+
+// Set uses given function f to mock the ChatRepository.RemoveUser method
+func (mmRemoveUser *mChatRepositoryMockRemoveUser) Set(f func(ctx context.Context, chatID int64, userID int64) (err error)) *ChatRepositoryMock {
+	if mmRemoveUser.defaultExpectation != nil {
+		mmRemoveUser.mock.t.Fatalf("Default expectation is already set for the ChatRepository.RemoveUser method")
+	}
+
+	if len(mmRemoveUser.expectations) > 0 {
+		mmRemoveUser.mock.t.Fatalf("Some expectations are already set for the ChatRepository.RemoveUser method")
+	}
+
+	mmRemoveUser.mock.funcRemoveUser = f
+	return mmRemoveUser.mock
+}
+
+// When sets expectation for the ChatRepository.RemoveUser which will trigger the result defined by the following
+// Then helper
+func (mmRemoveUser *mChatRepositoryMockRemoveUser) When(ctx context.Context, chatID int64, userID int64) *ChatRepositoryMockRemoveUserExpectation {
+	if mmRemoveUser.mock.funcRemoveUser != nil {
+		mmRemoveUser.mock.t.Fatalf("ChatRepositoryMock.RemoveUser mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockRemoveUserExpectation{
+		mock:   mmRemoveUser.mock,
+		params: &ChatRepositoryMockRemoveUserParams{ctx, chatID, userID},
+	}
+	mmRemoveUser.expectations = append(mmRemoveUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.RemoveUser return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockRemoveUserExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockRemoveUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.RemoveUser should be invoked
+func (mmRemoveUser *mChatRepositoryMockRemoveUser) Times(n uint64) *mChatRepositoryMockRemoveUser {
+	if n == 0 {
+		mmRemoveUser.mock.t.Fatalf("Times of ChatRepositoryMock.RemoveUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveUser.expectedInvocations, n)
+	return mmRemoveUser
+}
+
+func (mmRemoveUser *mChatRepositoryMockRemoveUser) invocationsDone() bool {
+	if len(mmRemoveUser.expectations) == 0 && mmRemoveUser.defaultExpectation == nil && mmRemoveUser.mock.funcRemoveUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveUser.mock.afterRemoveUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveUser implements repository.ChatRepository
+func (mmRemoveUser *ChatRepositoryMock) RemoveUser(ctx context.Context, chatID int64, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmRemoveUser.beforeRemoveUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveUser.afterRemoveUserCounter, 1)
+
+	if mmRemoveUser.inspectFuncRemoveUser != nil {
+		mmRemoveUser.inspectFuncRemoveUser(ctx, chatID, userID)
+	}
+
+	mm_params := ChatRepositoryMockRemoveUserParams{ctx, chatID, userID}
+
+	// Record call args
+	mmRemoveUser.RemoveUserMock.mutex.Lock()
+	mmRemoveUser.RemoveUserMock.callArgs = append(mmRemoveUser.RemoveUserMock.callArgs, &mm_params)
+	mmRemoveUser.RemoveUserMock.mutex.Unlock()
+
+	for _, e := range mmRemoveUser.RemoveUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveUser.RemoveUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveUser.RemoveUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveUser.RemoveUserMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveUser.RemoveUserMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockRemoveUserParams{ctx, chatID, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveUser.t.Errorf("ChatRepositoryMock.RemoveUser got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmRemoveUser.t.Errorf("ChatRepositoryMock.RemoveUser got unexpected parameter chatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRemoveUser.t.Errorf("ChatRepositoryMock.RemoveUser got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveUser.t.Errorf("ChatRepositoryMock.RemoveUser got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveUser.RemoveUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveUser.t.Fatal("No results are set for the ChatRepositoryMock.RemoveUser")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveUser.funcRemoveUser != nil {
+		return mmRemoveUser.funcRemoveUser(ctx, chatID, userID)
+	}
+	mmRemoveUser.t.Fatalf("Unexpected call to ChatRepositoryMock.RemoveUser. %v %v %v", ctx, chatID, userID)
+	return
+}
+
+// RemoveUserAfterCounter returns a count of finished ChatRepositoryMock.RemoveUser invocations
+func (mmRemoveUser *ChatRepositoryMock) RemoveUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveUser.afterRemoveUserCounter)
+}
+
+// RemoveUserBeforeCounter returns a count of ChatRepositoryMock.RemoveUser invocations
+func (mmRemoveUser *ChatRepositoryMock) RemoveUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveUser.beforeRemoveUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.RemoveUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveUser *mChatRepositoryMockRemoveUser) Calls() []*ChatRepositoryMockRemoveUserParams {
+	mmRemoveUser.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockRemoveUserParams, len(mmRemoveUser.callArgs))
+	copy(argCopy, mmRemoveUser.callArgs)
+
+	mmRemoveUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveUserDone returns true if the count of the RemoveUser invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockRemoveUserDone() bool {
+	if m.RemoveUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveUserMock.invocationsDone()
+}
+
+// MinimockRemoveUserInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockRemoveUserInspect() {
+	for _, e := range m.RemoveUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.RemoveUser with params: %#v", *e.params)
+		}
+	}
+
+	afterRemoveUserCounter := mm_atomic.LoadUint64(&m.afterRemoveUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveUserMock.defaultExpectation != nil && afterRemoveUserCounter < 1 {
+		if m.RemoveUserMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatRepositoryMock.RemoveUser")
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.RemoveUser with params: %#v", *m.RemoveUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveUser != nil && afterRemoveUserCounter < 1 {
+		m.t.Error("Expected call to ChatRepositoryMock.RemoveUser")
+	}
+
+	if !m.RemoveUserMock.invocationsDone() && afterRemoveUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.RemoveUser but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveUserMock.expectedInvocations), afterRemoveUserCounter)
+	}
+}
+
+type mChatRepositoryMockSendMessage struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockSendMessageExpectation
+	expectations       []*ChatRepositoryMockSendMessageExpectation
+
+	callArgs []*ChatRepositoryMockSendMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatRepositoryMockSendMessageExpectation specifies expectation struct of the ChatRepository.SendMessage
+type ChatRepositoryMockSendMessageExpectation struct {
+	mock      *ChatRepositoryMock
+	params    *ChatRepositoryMockSendMessageParams
+	paramPtrs *ChatRepositoryMockSendMessageParamPtrs
+	results   *ChatRepositoryMockSendMessageResults
+	Counter   uint64
+}
+
+// ChatRepositoryMockSendMessageParams contains parameters of the ChatRepository.SendMessage
+type ChatRepositoryMockSendMessageParams struct {
+	ctx        context.Context
+	req        *pb.SendMessageRequest
+	senderType string
+}
+
+// ChatRepositoryMockSendMessageParamPtrs contains pointers to parameters of the ChatRepository.SendMessage
+type ChatRepositoryMockSendMessageParamPtrs struct {
+	ctx        *context.Context
+	req        **pb.SendMessageRequest
+	senderType *string
+}
+
+// ChatRepositoryMockSendMessageResults contains results of the ChatRepository.SendMessage
+type ChatRepositoryMockSendMessageResults struct {
+	i1  int64
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSendMessage *mChatRepositoryMockSendMessage) Optional() *mChatRepositoryMockSendMessage {
+	mmSendMessage.optional = true
+	return mmSendMessage
+}
+
+// Expect sets up expected params for ChatRepository.SendMessage
+func (mmSendMessage *mChatRepositoryMockSendMessage) Expect(ctx context.Context, req *pb.SendMessageRequest, senderType string) *mChatRepositoryMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs != nil {
+		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by ExpectParams functions")
+	}
+
+	mmSendMessage.defaultExpectation.params = &ChatRepositoryMockSendMessageParams{ctx, req, senderType}
+	for _, e := range mmSendMessage.expectations {
+		if minimock.Equal(e.params, mmSendMessage.defaultExpectation.params) {
+			mmSendMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmSendMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.SendMessage
+func (mmSendMessage *mChatRepositoryMockSendMessage) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.params != nil {
+		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Expect")
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs == nil {
+		mmSendMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockSendMessageParamPtrs{}
+	}
+	mmSendMessage.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSendMessage
+}
+
+// ExpectReqParam2 sets up expected param req for ChatRepository.SendMessage
+func (mmSendMessage *mChatRepositoryMockSendMessage) ExpectReqParam2(req *pb.SendMessageRequest) *mChatRepositoryMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.params != nil {
+		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Expect")
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs == nil {
+		mmSendMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockSendMessageParamPtrs{}
+	}
+	mmSendMessage.defaultExpectation.paramPtrs.req = &req
+
+	return mmSendMessage
+}
+
+// ExpectSenderTypeParam3 sets up expected param senderType for ChatRepository.SendMessage
+func (mmSendMessage *mChatRepositoryMockSendMessage) ExpectSenderTypeParam3(senderType string) *mChatRepositoryMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.params != nil {
+		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Expect")
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs == nil {
+		mmSendMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockSendMessageParamPtrs{}
+	}
+	mmSendMessage.defaultExpectation.paramPtrs.senderType = &senderType
+
+	return mmSendMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.SendMessage
+func (mmSendMessage *mChatRepositoryMockSendMessage) Inspect(f func(ctx context.Context, req *pb.SendMessageRequest, senderType string)) *mChatRepositoryMockSendMessage {
+	if mmSendMessage.mock.inspectFuncSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.SendMessage")
+	}
+
+	mmSendMessage.mock.inspectFuncSendMessage = f
+
+	return mmSendMessage
+}
+
+// Return sets up results that will be returned by ChatRepository.SendMessage
+func (mmSendMessage *mChatRepositoryMockSendMessage) Return(i1 int64, err error) *ChatRepositoryMock {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{mock: mmSendMessage.mock}
+	}
+	mmSendMessage.defaultExpectation.results = &ChatRepositoryMockSendMessageResults{i1, err}
+	return mmSendMessage.mock
+}
+
+// Set uses given function f to mock the ChatRepository.SendMessage method
+func (mmSendMessage *mChatRepositoryMockSendMessage) Set(f func(ctx context.Context, req *pb.SendMessageRequest, senderType string) (i1 int64, err error)) *ChatRepositoryMock {
+	if mmSendMessage.defaultExpectation != nil {
+		mmSendMessage.mock.t.Fatalf("Default expectation is already set for the ChatRepository.SendMessage method")
+	}
+
+	if len(mmSendMessage.expectations) > 0 {
+		mmSendMessage.mock.t.Fatalf("Some expectations are already set for the ChatRepository.SendMessage method")
+	}
+
+	mmSendMessage.mock.funcSendMessage = f
+	return mmSendMessage.mock
+}
+
+// When sets expectation for the ChatRepository.SendMessage which will trigger the result defined by the following
+// Then helper
+func (mmSendMessage *mChatRepositoryMockSendMessage) When(ctx context.Context, req *pb.SendMessageRequest, senderType string) *ChatRepositoryMockSendMessageExpectation {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockSendMessageExpectation{
+		mock:   mmSendMessage.mock,
+		params: &ChatRepositoryMockSendMessageParams{ctx, req, senderType},
+	}
+	mmSendMessage.expectations = append(mmSendMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.SendMessage return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockSendMessageExpectation) Then(i1 int64, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockSendMessageResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.SendMessage should be invoked
+func (mmSendMessage *mChatRepositoryMockSendMessage) Times(n uint64) *mChatRepositoryMockSendMessage {
+	if n == 0 {
+		mmSendMessage.mock.t.Fatalf("Times of ChatRepositoryMock.SendMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSendMessage.expectedInvocations, n)
+	return mmSendMessage
+}
+
+func (mmSendMessage *mChatRepositoryMockSendMessage) invocationsDone() bool {
+	if len(mmSendMessage.expectations) == 0 && mmSendMessage.defaultExpectation == nil && mmSendMessage.mock.funcSendMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSendMessage.mock.afterSendMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSendMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SendMessage implements repository.ChatRepository
+func (mmSendMessage *ChatRepositoryMock) SendMessage(ctx context.Context, req *pb.SendMessageRequest, senderType string) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmSendMessage.beforeSendMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmSendMessage.afterSendMessageCounter, 1)
+
+	if mmSendMessage.inspectFuncSendMessage != nil {
+		mmSendMessage.inspectFuncSendMessage(ctx, req, senderType)
+	}
+
+	mm_params := ChatRepositoryMockSendMessageParams{ctx, req, senderType}
+
+	// Record call args
+	mmSendMessage.SendMessageMock.mutex.Lock()
+	mmSendMessage.SendMessageMock.callArgs = append(mmSendMessage.SendMessageMock.callArgs, &mm_params)
+	mmSendMessage.SendMessageMock.mutex.Unlock()
+
+	for _, e := range mmSendMessage.SendMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmSendMessage.SendMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSendMessage.SendMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmSendMessage.SendMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmSendMessage.SendMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockSendMessageParams{ctx, req, senderType}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSendMessage.t.Errorf("ChatRepositoryMock.SendMessage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmSendMessage.t.Errorf("ChatRepositoryMock.SendMessage got unexpected parameter req, want: %#v, got: %#v%s\n", *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+			if mm_want_ptrs.senderType != nil && !minimock.Equal(*mm_want_ptrs.senderType, mm_got.senderType) {
+				mmSendMessage.t.Errorf("ChatRepositoryMock.SendMessage got unexpected parameter senderType, want: %#v, got: %#v%s\n", *mm_want_ptrs.senderType, mm_got.senderType, minimock.Diff(*mm_want_ptrs.senderType, mm_got.senderType))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSendMessage.t.Errorf("ChatRepositoryMock.SendMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSendMessage.SendMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSendMessage.t.Fatal("No results are set for the ChatRepositoryMock.SendMessage")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmSendMessage.funcSendMessage != nil {
+		return mmSendMessage.funcSendMessage(ctx, req, senderType)
+	}
+	mmSendMessage.t.Fatalf("Unexpected call to ChatRepositoryMock.SendMessage. %v %v %v", ctx, req, senderType)
+	return
+}
+
+// SendMessageAfterCounter returns a count of finished ChatRepositoryMock.SendMessage invocations
+func (mmSendMessage *ChatRepositoryMock) SendMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendMessage.afterSendMessageCounter)
+}
+
+// SendMessageBeforeCounter returns a count of ChatRepositoryMock.SendMessage invocations
+func (mmSendMessage *ChatRepositoryMock) SendMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendMessage.beforeSendMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.SendMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSendMessage *mChatRepositoryMockSendMessage) Calls() []*ChatRepositoryMockSendMessageParams {
+	mmSendMessage.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockSendMessageParams, len(mmSendMessage.callArgs))
+	copy(argCopy, mmSendMessage.callArgs)
+
+	mmSendMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSendMessageDone returns true if the count of the SendMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockSendMessageDone() bool {
+	if m.SendMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SendMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SendMessageMock.invocationsDone()
+}
+
+// MinimockSendMessageInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockSendMessageInspect() {
+	for _, e := range m.SendMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.SendMessage with params: %#v", *e.params)
+		}
+	}
+
+	afterSendMessageCounter := mm_atomic.LoadUint64(&m.afterSendMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SendMessageMock.defaultExpectation != nil && afterSendMessageCounter < 1 {
+		if m.SendMessageMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatRepositoryMock.SendMessage")
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.SendMessage with params: %#v", *m.SendMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSendMessage != nil && afterSendMessageCounter < 1 {
+		m.t.Error("Expected call to ChatRepositoryMock.SendMessage")
+	}
+
+	if !m.SendMessageMock.invocationsDone() && afterSendMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.SendMessage but found %d calls",
+			mm_atomic.LoadUint64(&m.SendMessageMock.expectedInvocations), afterSendMessageCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ChatRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockDeleteInspect()
+
+			m.MinimockDeleteMessageInspect()
+
+			m.MinimockGetMessageInspect()
+
+			m.MinimockIsUserInChatInspect()
+
+			m.MinimockRemoveUserInspect()
 
 			m.MinimockSendMessageInspect()
 		}
@@ -1055,5 +2466,9 @@ func (m *ChatRepositoryMock) minimockDone() bool {
 	return done &&
 		m.MinimockCreateDone() &&
 		m.MinimockDeleteDone() &&
+		m.MinimockDeleteMessageDone() &&
+		m.MinimockGetMessageDone() &&
+		m.MinimockIsUserInChatDone() &&
+		m.MinimockRemoveUserDone() &&
 		m.MinimockSendMessageDone()
 }
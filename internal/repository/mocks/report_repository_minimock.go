@@ -0,0 +1,1561 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/mikhailsoldatkin/chat-server/internal/repository.ReportRepository -o report_repository_minimock.go -n ReportRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	reportModel "github.com/mikhailsoldatkin/chat-server/internal/service/report/model"
+)
+
+// ReportRepositoryMock implements repository.ReportRepository
+type ReportRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGet          func(ctx context.Context, id int64) (rp1 *reportModel.Report, err error)
+	inspectFuncGet   func(ctx context.Context, id int64)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mReportRepositoryMockGet
+
+	funcList          func(ctx context.Context, status string, limit int64, offset int64) (rpa1 []*reportModel.Report, err error)
+	inspectFuncList   func(ctx context.Context, status string, limit int64, offset int64)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mReportRepositoryMockList
+
+	funcReport          func(ctx context.Context, report *reportModel.Report, reporterID int64, reason string) (i1 int64, err error)
+	inspectFuncReport   func(ctx context.Context, report *reportModel.Report, reporterID int64, reason string)
+	afterReportCounter  uint64
+	beforeReportCounter uint64
+	ReportMock          mReportRepositoryMockReport
+
+	funcResolve          func(ctx context.Context, id int64, action string, resolvedBy string) (err error)
+	inspectFuncResolve   func(ctx context.Context, id int64, action string, resolvedBy string)
+	afterResolveCounter  uint64
+	beforeResolveCounter uint64
+	ResolveMock          mReportRepositoryMockResolve
+}
+
+// NewReportRepositoryMock returns a mock for repository.ReportRepository
+func NewReportRepositoryMock(t minimock.Tester) *ReportRepositoryMock {
+	m := &ReportRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetMock = mReportRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*ReportRepositoryMockGetParams{}
+
+	m.ListMock = mReportRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*ReportRepositoryMockListParams{}
+
+	m.ReportMock = mReportRepositoryMockReport{mock: m}
+	m.ReportMock.callArgs = []*ReportRepositoryMockReportParams{}
+
+	m.ResolveMock = mReportRepositoryMockResolve{mock: m}
+	m.ResolveMock.callArgs = []*ReportRepositoryMockResolveParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mReportRepositoryMockGet struct {
+	optional           bool
+	mock               *ReportRepositoryMock
+	defaultExpectation *ReportRepositoryMockGetExpectation
+	expectations       []*ReportRepositoryMockGetExpectation
+
+	callArgs []*ReportRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ReportRepositoryMockGetExpectation specifies expectation struct of the ReportRepository.Get
+type ReportRepositoryMockGetExpectation struct {
+	mock      *ReportRepositoryMock
+	params    *ReportRepositoryMockGetParams
+	paramPtrs *ReportRepositoryMockGetParamPtrs
+	results   *ReportRepositoryMockGetResults
+	Counter   uint64
+}
+
+// ReportRepositoryMockGetParams contains parameters of the ReportRepository.Get
+type ReportRepositoryMockGetParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// ReportRepositoryMockGetParamPtrs contains pointers to parameters of the ReportRepository.Get
+type ReportRepositoryMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// ReportRepositoryMockGetResults contains results of the ReportRepository.Get
+type ReportRepositoryMockGetResults struct {
+	rp1 *reportModel.Report
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mReportRepositoryMockGet) Optional() *mReportRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for ReportRepository.Get
+func (mmGet *mReportRepositoryMockGet) Expect(ctx context.Context, id int64) *mReportRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ReportRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ReportRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("ReportRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &ReportRepositoryMockGetParams{ctx, id}
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReportRepository.Get
+func (mmGet *mReportRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mReportRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ReportRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ReportRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("ReportRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &ReportRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for ReportRepository.Get
+func (mmGet *mReportRepositoryMockGet) ExpectIdParam2(id int64) *mReportRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ReportRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ReportRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("ReportRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &ReportRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReportRepository.Get
+func (mmGet *mReportRepositoryMockGet) Inspect(f func(ctx context.Context, id int64)) *mReportRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for ReportRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by ReportRepository.Get
+func (mmGet *mReportRepositoryMockGet) Return(rp1 *reportModel.Report, err error) *ReportRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ReportRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ReportRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &ReportRepositoryMockGetResults{rp1, err}
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the ReportRepository.Get method
+func (mmGet *mReportRepositoryMockGet) Set(f func(ctx context.Context, id int64) (rp1 *reportModel.Report, err error)) *ReportRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the ReportRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the ReportRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	return mmGet.mock
+}
+
+// When sets expectation for the ReportRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mReportRepositoryMockGet) When(ctx context.Context, id int64) *ReportRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ReportRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &ReportRepositoryMockGetExpectation{
+		mock:   mmGet.mock,
+		params: &ReportRepositoryMockGetParams{ctx, id},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReportRepository.Get return parameters for the expectation previously defined by the When method
+func (e *ReportRepositoryMockGetExpectation) Then(rp1 *reportModel.Report, err error) *ReportRepositoryMock {
+	e.results = &ReportRepositoryMockGetResults{rp1, err}
+	return e.mock
+}
+
+// Times sets number of times ReportRepository.Get should be invoked
+func (mmGet *mReportRepositoryMockGet) Times(n uint64) *mReportRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of ReportRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	return mmGet
+}
+
+func (mmGet *mReportRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements repository.ReportRepository
+func (mmGet *ReportRepositoryMock) Get(ctx context.Context, id int64) (rp1 *reportModel.Report, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := ReportRepositoryMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := ReportRepositoryMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("ReportRepositoryMock.Get got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("ReportRepositoryMock.Get got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("ReportRepositoryMock.Get got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the ReportRepositoryMock.Get")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to ReportRepositoryMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished ReportRepositoryMock.Get invocations
+func (mmGet *ReportRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of ReportRepositoryMock.Get invocations
+func (mmGet *ReportRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReportRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mReportRepositoryMockGet) Calls() []*ReportRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*ReportRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *ReportRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *ReportRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReportRepositoryMock.Get with params: %#v", *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ReportRepositoryMock.Get")
+		} else {
+			m.t.Errorf("Expected call to ReportRepositoryMock.Get with params: %#v", *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Error("Expected call to ReportRepositoryMock.Get")
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReportRepositoryMock.Get but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), afterGetCounter)
+	}
+}
+
+type mReportRepositoryMockList struct {
+	optional           bool
+	mock               *ReportRepositoryMock
+	defaultExpectation *ReportRepositoryMockListExpectation
+	expectations       []*ReportRepositoryMockListExpectation
+
+	callArgs []*ReportRepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ReportRepositoryMockListExpectation specifies expectation struct of the ReportRepository.List
+type ReportRepositoryMockListExpectation struct {
+	mock      *ReportRepositoryMock
+	params    *ReportRepositoryMockListParams
+	paramPtrs *ReportRepositoryMockListParamPtrs
+	results   *ReportRepositoryMockListResults
+	Counter   uint64
+}
+
+// ReportRepositoryMockListParams contains parameters of the ReportRepository.List
+type ReportRepositoryMockListParams struct {
+	ctx    context.Context
+	status string
+	limit  int64
+	offset int64
+}
+
+// ReportRepositoryMockListParamPtrs contains pointers to parameters of the ReportRepository.List
+type ReportRepositoryMockListParamPtrs struct {
+	ctx    *context.Context
+	status *string
+	limit  *int64
+	offset *int64
+}
+
+// ReportRepositoryMockListResults contains results of the ReportRepository.List
+type ReportRepositoryMockListResults struct {
+	rpa1 []*reportModel.Report
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mReportRepositoryMockList) Optional() *mReportRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for ReportRepository.List
+func (mmList *mReportRepositoryMockList) Expect(ctx context.Context, status string, limit int64, offset int64) *mReportRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ReportRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &ReportRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("ReportRepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &ReportRepositoryMockListParams{ctx, status, limit, offset}
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReportRepository.List
+func (mmList *mReportRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mReportRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ReportRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &ReportRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("ReportRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &ReportRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmList
+}
+
+// ExpectStatusParam2 sets up expected param status for ReportRepository.List
+func (mmList *mReportRepositoryMockList) ExpectStatusParam2(status string) *mReportRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ReportRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &ReportRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("ReportRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &ReportRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.status = &status
+
+	return mmList
+}
+
+// ExpectLimitParam3 sets up expected param limit for ReportRepository.List
+func (mmList *mReportRepositoryMockList) ExpectLimitParam3(limit int64) *mReportRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ReportRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &ReportRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("ReportRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &ReportRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.limit = &limit
+
+	return mmList
+}
+
+// ExpectOffsetParam4 sets up expected param offset for ReportRepository.List
+func (mmList *mReportRepositoryMockList) ExpectOffsetParam4(offset int64) *mReportRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ReportRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &ReportRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("ReportRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &ReportRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.offset = &offset
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReportRepository.List
+func (mmList *mReportRepositoryMockList) Inspect(f func(ctx context.Context, status string, limit int64, offset int64)) *mReportRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for ReportRepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by ReportRepository.List
+func (mmList *mReportRepositoryMockList) Return(rpa1 []*reportModel.Report, err error) *ReportRepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ReportRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &ReportRepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &ReportRepositoryMockListResults{rpa1, err}
+	return mmList.mock
+}
+
+// Set uses given function f to mock the ReportRepository.List method
+func (mmList *mReportRepositoryMockList) Set(f func(ctx context.Context, status string, limit int64, offset int64) (rpa1 []*reportModel.Report, err error)) *ReportRepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the ReportRepository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the ReportRepository.List method")
+	}
+
+	mmList.mock.funcList = f
+	return mmList.mock
+}
+
+// When sets expectation for the ReportRepository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mReportRepositoryMockList) When(ctx context.Context, status string, limit int64, offset int64) *ReportRepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ReportRepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &ReportRepositoryMockListExpectation{
+		mock:   mmList.mock,
+		params: &ReportRepositoryMockListParams{ctx, status, limit, offset},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReportRepository.List return parameters for the expectation previously defined by the When method
+func (e *ReportRepositoryMockListExpectation) Then(rpa1 []*reportModel.Report, err error) *ReportRepositoryMock {
+	e.results = &ReportRepositoryMockListResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ReportRepository.List should be invoked
+func (mmList *mReportRepositoryMockList) Times(n uint64) *mReportRepositoryMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of ReportRepositoryMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	return mmList
+}
+
+func (mmList *mReportRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements repository.ReportRepository
+func (mmList *ReportRepositoryMock) List(ctx context.Context, status string, limit int64, offset int64) (rpa1 []*reportModel.Report, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, status, limit, offset)
+	}
+
+	mm_params := ReportRepositoryMockListParams{ctx, status, limit, offset}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := ReportRepositoryMockListParams{ctx, status, limit, offset}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("ReportRepositoryMock.List got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.status != nil && !minimock.Equal(*mm_want_ptrs.status, mm_got.status) {
+				mmList.t.Errorf("ReportRepositoryMock.List got unexpected parameter status, want: %#v, got: %#v%s\n", *mm_want_ptrs.status, mm_got.status, minimock.Diff(*mm_want_ptrs.status, mm_got.status))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmList.t.Errorf("ReportRepositoryMock.List got unexpected parameter limit, want: %#v, got: %#v%s\n", *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+			if mm_want_ptrs.offset != nil && !minimock.Equal(*mm_want_ptrs.offset, mm_got.offset) {
+				mmList.t.Errorf("ReportRepositoryMock.List got unexpected parameter offset, want: %#v, got: %#v%s\n", *mm_want_ptrs.offset, mm_got.offset, minimock.Diff(*mm_want_ptrs.offset, mm_got.offset))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("ReportRepositoryMock.List got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the ReportRepositoryMock.List")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, status, limit, offset)
+	}
+	mmList.t.Fatalf("Unexpected call to ReportRepositoryMock.List. %v %v %v %v", ctx, status, limit, offset)
+	return
+}
+
+// ListAfterCounter returns a count of finished ReportRepositoryMock.List invocations
+func (mmList *ReportRepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of ReportRepositoryMock.List invocations
+func (mmList *ReportRepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReportRepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mReportRepositoryMockList) Calls() []*ReportRepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*ReportRepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *ReportRepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *ReportRepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReportRepositoryMock.List with params: %#v", *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ReportRepositoryMock.List")
+		} else {
+			m.t.Errorf("Expected call to ReportRepositoryMock.List with params: %#v", *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Error("Expected call to ReportRepositoryMock.List")
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReportRepositoryMock.List but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), afterListCounter)
+	}
+}
+
+type mReportRepositoryMockReport struct {
+	optional           bool
+	mock               *ReportRepositoryMock
+	defaultExpectation *ReportRepositoryMockReportExpectation
+	expectations       []*ReportRepositoryMockReportExpectation
+
+	callArgs []*ReportRepositoryMockReportParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ReportRepositoryMockReportExpectation specifies expectation struct of the ReportRepository.Report
+type ReportRepositoryMockReportExpectation struct {
+	mock      *ReportRepositoryMock
+	params    *ReportRepositoryMockReportParams
+	paramPtrs *ReportRepositoryMockReportParamPtrs
+	results   *ReportRepositoryMockReportResults
+	Counter   uint64
+}
+
+// ReportRepositoryMockReportParams contains parameters of the ReportRepository.Report
+type ReportRepositoryMockReportParams struct {
+	ctx        context.Context
+	report     *reportModel.Report
+	reporterID int64
+	reason     string
+}
+
+// ReportRepositoryMockReportParamPtrs contains pointers to parameters of the ReportRepository.Report
+type ReportRepositoryMockReportParamPtrs struct {
+	ctx        *context.Context
+	report     **reportModel.Report
+	reporterID *int64
+	reason     *string
+}
+
+// ReportRepositoryMockReportResults contains results of the ReportRepository.Report
+type ReportRepositoryMockReportResults struct {
+	i1  int64
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReport *mReportRepositoryMockReport) Optional() *mReportRepositoryMockReport {
+	mmReport.optional = true
+	return mmReport
+}
+
+// Expect sets up expected params for ReportRepository.Report
+func (mmReport *mReportRepositoryMockReport) Expect(ctx context.Context, report *reportModel.Report, reporterID int64, reason string) *mReportRepositoryMockReport {
+	if mmReport.mock.funcReport != nil {
+		mmReport.mock.t.Fatalf("ReportRepositoryMock.Report mock is already set by Set")
+	}
+
+	if mmReport.defaultExpectation == nil {
+		mmReport.defaultExpectation = &ReportRepositoryMockReportExpectation{}
+	}
+
+	if mmReport.defaultExpectation.paramPtrs != nil {
+		mmReport.mock.t.Fatalf("ReportRepositoryMock.Report mock is already set by ExpectParams functions")
+	}
+
+	mmReport.defaultExpectation.params = &ReportRepositoryMockReportParams{ctx, report, reporterID, reason}
+	for _, e := range mmReport.expectations {
+		if minimock.Equal(e.params, mmReport.defaultExpectation.params) {
+			mmReport.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReport.defaultExpectation.params)
+		}
+	}
+
+	return mmReport
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReportRepository.Report
+func (mmReport *mReportRepositoryMockReport) ExpectCtxParam1(ctx context.Context) *mReportRepositoryMockReport {
+	if mmReport.mock.funcReport != nil {
+		mmReport.mock.t.Fatalf("ReportRepositoryMock.Report mock is already set by Set")
+	}
+
+	if mmReport.defaultExpectation == nil {
+		mmReport.defaultExpectation = &ReportRepositoryMockReportExpectation{}
+	}
+
+	if mmReport.defaultExpectation.params != nil {
+		mmReport.mock.t.Fatalf("ReportRepositoryMock.Report mock is already set by Expect")
+	}
+
+	if mmReport.defaultExpectation.paramPtrs == nil {
+		mmReport.defaultExpectation.paramPtrs = &ReportRepositoryMockReportParamPtrs{}
+	}
+	mmReport.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmReport
+}
+
+// ExpectReportParam2 sets up expected param report for ReportRepository.Report
+func (mmReport *mReportRepositoryMockReport) ExpectReportParam2(report *reportModel.Report) *mReportRepositoryMockReport {
+	if mmReport.mock.funcReport != nil {
+		mmReport.mock.t.Fatalf("ReportRepositoryMock.Report mock is already set by Set")
+	}
+
+	if mmReport.defaultExpectation == nil {
+		mmReport.defaultExpectation = &ReportRepositoryMockReportExpectation{}
+	}
+
+	if mmReport.defaultExpectation.params != nil {
+		mmReport.mock.t.Fatalf("ReportRepositoryMock.Report mock is already set by Expect")
+	}
+
+	if mmReport.defaultExpectation.paramPtrs == nil {
+		mmReport.defaultExpectation.paramPtrs = &ReportRepositoryMockReportParamPtrs{}
+	}
+	mmReport.defaultExpectation.paramPtrs.report = &report
+
+	return mmReport
+}
+
+// ExpectReporterIDParam3 sets up expected param reporterID for ReportRepository.Report
+func (mmReport *mReportRepositoryMockReport) ExpectReporterIDParam3(reporterID int64) *mReportRepositoryMockReport {
+	if mmReport.mock.funcReport != nil {
+		mmReport.mock.t.Fatalf("ReportRepositoryMock.Report mock is already set by Set")
+	}
+
+	if mmReport.defaultExpectation == nil {
+		mmReport.defaultExpectation = &ReportRepositoryMockReportExpectation{}
+	}
+
+	if mmReport.defaultExpectation.params != nil {
+		mmReport.mock.t.Fatalf("ReportRepositoryMock.Report mock is already set by Expect")
+	}
+
+	if mmReport.defaultExpectation.paramPtrs == nil {
+		mmReport.defaultExpectation.paramPtrs = &ReportRepositoryMockReportParamPtrs{}
+	}
+	mmReport.defaultExpectation.paramPtrs.reporterID = &reporterID
+
+	return mmReport
+}
+
+// ExpectReasonParam4 sets up expected param reason for ReportRepository.Report
+func (mmReport *mReportRepositoryMockReport) ExpectReasonParam4(reason string) *mReportRepositoryMockReport {
+	if mmReport.mock.funcReport != nil {
+		mmReport.mock.t.Fatalf("ReportRepositoryMock.Report mock is already set by Set")
+	}
+
+	if mmReport.defaultExpectation == nil {
+		mmReport.defaultExpectation = &ReportRepositoryMockReportExpectation{}
+	}
+
+	if mmReport.defaultExpectation.params != nil {
+		mmReport.mock.t.Fatalf("ReportRepositoryMock.Report mock is already set by Expect")
+	}
+
+	if mmReport.defaultExpectation.paramPtrs == nil {
+		mmReport.defaultExpectation.paramPtrs = &ReportRepositoryMockReportParamPtrs{}
+	}
+	mmReport.defaultExpectation.paramPtrs.reason = &reason
+
+	return mmReport
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReportRepository.Report
+func (mmReport *mReportRepositoryMockReport) Inspect(f func(ctx context.Context, report *reportModel.Report, reporterID int64, reason string)) *mReportRepositoryMockReport {
+	if mmReport.mock.inspectFuncReport != nil {
+		mmReport.mock.t.Fatalf("Inspect function is already set for ReportRepositoryMock.Report")
+	}
+
+	mmReport.mock.inspectFuncReport = f
+
+	return mmReport
+}
+
+// Return sets up results that will be returned by ReportRepository.Report
+func (mmReport *mReportRepositoryMockReport) Return(i1 int64, err error) *ReportRepositoryMock {
+	if mmReport.mock.funcReport != nil {
+		mmReport.mock.t.Fatalf("ReportRepositoryMock.Report mock is already set by Set")
+	}
+
+	if mmReport.defaultExpectation == nil {
+		mmReport.defaultExpectation = &ReportRepositoryMockReportExpectation{mock: mmReport.mock}
+	}
+	mmReport.defaultExpectation.results = &ReportRepositoryMockReportResults{i1, err}
+	return mmReport.mock
+}
+
+// Set uses given function f to mock the ReportRepository.Report method
+func (mmReport *mReportRepositoryMockReport) Set(f func(ctx context.Context, report *reportModel.Report, reporterID int64, reason string) (i1 int64, err error)) *ReportRepositoryMock {
+	if mmReport.defaultExpectation != nil {
+		mmReport.mock.t.Fatalf("Default expectation is already set for the ReportRepository.Report method")
+	}
+
+	if len(mmReport.expectations) > 0 {
+		mmReport.mock.t.Fatalf("Some expectations are already set for the ReportRepository.Report method")
+	}
+
+	mmReport.mock.funcReport = f
+	return mmReport.mock
+}
+
+// When sets expectation for the ReportRepository.Report which will trigger the result defined by the following
+// Then helper
+func (mmReport *mReportRepositoryMockReport) When(ctx context.Context, report *reportModel.Report, reporterID int64, reason string) *ReportRepositoryMockReportExpectation {
+	if mmReport.mock.funcReport != nil {
+		mmReport.mock.t.Fatalf("ReportRepositoryMock.Report mock is already set by Set")
+	}
+
+	expectation := &ReportRepositoryMockReportExpectation{
+		mock:   mmReport.mock,
+		params: &ReportRepositoryMockReportParams{ctx, report, reporterID, reason},
+	}
+	mmReport.expectations = append(mmReport.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReportRepository.Report return parameters for the expectation previously defined by the When method
+func (e *ReportRepositoryMockReportExpectation) Then(i1 int64, err error) *ReportRepositoryMock {
+	e.results = &ReportRepositoryMockReportResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ReportRepository.Report should be invoked
+func (mmReport *mReportRepositoryMockReport) Times(n uint64) *mReportRepositoryMockReport {
+	if n == 0 {
+		mmReport.mock.t.Fatalf("Times of ReportRepositoryMock.Report mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReport.expectedInvocations, n)
+	return mmReport
+}
+
+func (mmReport *mReportRepositoryMockReport) invocationsDone() bool {
+	if len(mmReport.expectations) == 0 && mmReport.defaultExpectation == nil && mmReport.mock.funcReport == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReport.mock.afterReportCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReport.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Report implements repository.ReportRepository
+func (mmReport *ReportRepositoryMock) Report(ctx context.Context, report *reportModel.Report, reporterID int64, reason string) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmReport.beforeReportCounter, 1)
+	defer mm_atomic.AddUint64(&mmReport.afterReportCounter, 1)
+
+	if mmReport.inspectFuncReport != nil {
+		mmReport.inspectFuncReport(ctx, report, reporterID, reason)
+	}
+
+	mm_params := ReportRepositoryMockReportParams{ctx, report, reporterID, reason}
+
+	// Record call args
+	mmReport.ReportMock.mutex.Lock()
+	mmReport.ReportMock.callArgs = append(mmReport.ReportMock.callArgs, &mm_params)
+	mmReport.ReportMock.mutex.Unlock()
+
+	for _, e := range mmReport.ReportMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmReport.ReportMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReport.ReportMock.defaultExpectation.Counter, 1)
+		mm_want := mmReport.ReportMock.defaultExpectation.params
+		mm_want_ptrs := mmReport.ReportMock.defaultExpectation.paramPtrs
+
+		mm_got := ReportRepositoryMockReportParams{ctx, report, reporterID, reason}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReport.t.Errorf("ReportRepositoryMock.Report got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.report != nil && !minimock.Equal(*mm_want_ptrs.report, mm_got.report) {
+				mmReport.t.Errorf("ReportRepositoryMock.Report got unexpected parameter report, want: %#v, got: %#v%s\n", *mm_want_ptrs.report, mm_got.report, minimock.Diff(*mm_want_ptrs.report, mm_got.report))
+			}
+
+			if mm_want_ptrs.reporterID != nil && !minimock.Equal(*mm_want_ptrs.reporterID, mm_got.reporterID) {
+				mmReport.t.Errorf("ReportRepositoryMock.Report got unexpected parameter reporterID, want: %#v, got: %#v%s\n", *mm_want_ptrs.reporterID, mm_got.reporterID, minimock.Diff(*mm_want_ptrs.reporterID, mm_got.reporterID))
+			}
+
+			if mm_want_ptrs.reason != nil && !minimock.Equal(*mm_want_ptrs.reason, mm_got.reason) {
+				mmReport.t.Errorf("ReportRepositoryMock.Report got unexpected parameter reason, want: %#v, got: %#v%s\n", *mm_want_ptrs.reason, mm_got.reason, minimock.Diff(*mm_want_ptrs.reason, mm_got.reason))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReport.t.Errorf("ReportRepositoryMock.Report got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReport.ReportMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReport.t.Fatal("No results are set for the ReportRepositoryMock.Report")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmReport.funcReport != nil {
+		return mmReport.funcReport(ctx, report, reporterID, reason)
+	}
+	mmReport.t.Fatalf("Unexpected call to ReportRepositoryMock.Report. %v %v %v %v", ctx, report, reporterID, reason)
+	return
+}
+
+// ReportAfterCounter returns a count of finished ReportRepositoryMock.Report invocations
+func (mmReport *ReportRepositoryMock) ReportAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReport.afterReportCounter)
+}
+
+// ReportBeforeCounter returns a count of ReportRepositoryMock.Report invocations
+func (mmReport *ReportRepositoryMock) ReportBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReport.beforeReportCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReportRepositoryMock.Report.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReport *mReportRepositoryMockReport) Calls() []*ReportRepositoryMockReportParams {
+	mmReport.mutex.RLock()
+
+	argCopy := make([]*ReportRepositoryMockReportParams, len(mmReport.callArgs))
+	copy(argCopy, mmReport.callArgs)
+
+	mmReport.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReportDone returns true if the count of the Report invocations corresponds
+// the number of defined expectations
+func (m *ReportRepositoryMock) MinimockReportDone() bool {
+	if m.ReportMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReportMock.invocationsDone()
+}
+
+// MinimockReportInspect logs each unmet expectation
+func (m *ReportRepositoryMock) MinimockReportInspect() {
+	for _, e := range m.ReportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReportRepositoryMock.Report with params: %#v", *e.params)
+		}
+	}
+
+	afterReportCounter := mm_atomic.LoadUint64(&m.afterReportCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReportMock.defaultExpectation != nil && afterReportCounter < 1 {
+		if m.ReportMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ReportRepositoryMock.Report")
+		} else {
+			m.t.Errorf("Expected call to ReportRepositoryMock.Report with params: %#v", *m.ReportMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReport != nil && afterReportCounter < 1 {
+		m.t.Error("Expected call to ReportRepositoryMock.Report")
+	}
+
+	if !m.ReportMock.invocationsDone() && afterReportCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReportRepositoryMock.Report but found %d calls",
+			mm_atomic.LoadUint64(&m.ReportMock.expectedInvocations), afterReportCounter)
+	}
+}
+
+type mReportRepositoryMockResolve struct {
+	optional           bool
+	mock               *ReportRepositoryMock
+	defaultExpectation *ReportRepositoryMockResolveExpectation
+	expectations       []*ReportRepositoryMockResolveExpectation
+
+	callArgs []*ReportRepositoryMockResolveParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ReportRepositoryMockResolveExpectation specifies expectation struct of the ReportRepository.Resolve
+type ReportRepositoryMockResolveExpectation struct {
+	mock      *ReportRepositoryMock
+	params    *ReportRepositoryMockResolveParams
+	paramPtrs *ReportRepositoryMockResolveParamPtrs
+	results   *ReportRepositoryMockResolveResults
+	Counter   uint64
+}
+
+// ReportRepositoryMockResolveParams contains parameters of the ReportRepository.Resolve
+type ReportRepositoryMockResolveParams struct {
+	ctx        context.Context
+	id         int64
+	action     string
+	resolvedBy string
+}
+
+// ReportRepositoryMockResolveParamPtrs contains pointers to parameters of the ReportRepository.Resolve
+type ReportRepositoryMockResolveParamPtrs struct {
+	ctx        *context.Context
+	id         *int64
+	action     *string
+	resolvedBy *string
+}
+
+// ReportRepositoryMockResolveResults contains results of the ReportRepository.Resolve
+type ReportRepositoryMockResolveResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmResolve *mReportRepositoryMockResolve) Optional() *mReportRepositoryMockResolve {
+	mmResolve.optional = true
+	return mmResolve
+}
+
+// Expect sets up expected params for ReportRepository.Resolve
+func (mmResolve *mReportRepositoryMockResolve) Expect(ctx context.Context, id int64, action string, resolvedBy string) *mReportRepositoryMockResolve {
+	if mmResolve.mock.funcResolve != nil {
+		mmResolve.mock.t.Fatalf("ReportRepositoryMock.Resolve mock is already set by Set")
+	}
+
+	if mmResolve.defaultExpectation == nil {
+		mmResolve.defaultExpectation = &ReportRepositoryMockResolveExpectation{}
+	}
+
+	if mmResolve.defaultExpectation.paramPtrs != nil {
+		mmResolve.mock.t.Fatalf("ReportRepositoryMock.Resolve mock is already set by ExpectParams functions")
+	}
+
+	mmResolve.defaultExpectation.params = &ReportRepositoryMockResolveParams{ctx, id, action, resolvedBy}
+	for _, e := range mmResolve.expectations {
+		if minimock.Equal(e.params, mmResolve.defaultExpectation.params) {
+			mmResolve.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmResolve.defaultExpectation.params)
+		}
+	}
+
+	return mmResolve
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReportRepository.Resolve
+func (mmResolve *mReportRepositoryMockResolve) ExpectCtxParam1(ctx context.Context) *mReportRepositoryMockResolve {
+	if mmResolve.mock.funcResolve != nil {
+		mmResolve.mock.t.Fatalf("ReportRepositoryMock.Resolve mock is already set by Set")
+	}
+
+	if mmResolve.defaultExpectation == nil {
+		mmResolve.defaultExpectation = &ReportRepositoryMockResolveExpectation{}
+	}
+
+	if mmResolve.defaultExpectation.params != nil {
+		mmResolve.mock.t.Fatalf("ReportRepositoryMock.Resolve mock is already set by Expect")
+	}
+
+	if mmResolve.defaultExpectation.paramPtrs == nil {
+		mmResolve.defaultExpectation.paramPtrs = &ReportRepositoryMockResolveParamPtrs{}
+	}
+	mmResolve.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmResolve
+}
+
+// ExpectIdParam2 sets up expected param id for ReportRepository.Resolve
+func (mmResolve *mReportRepositoryMockResolve) ExpectIdParam2(id int64) *mReportRepositoryMockResolve {
+	if mmResolve.mock.funcResolve != nil {
+		mmResolve.mock.t.Fatalf("ReportRepositoryMock.Resolve mock is already set by Set")
+	}
+
+	if mmResolve.defaultExpectation == nil {
+		mmResolve.defaultExpectation = &ReportRepositoryMockResolveExpectation{}
+	}
+
+	if mmResolve.defaultExpectation.params != nil {
+		mmResolve.mock.t.Fatalf("ReportRepositoryMock.Resolve mock is already set by Expect")
+	}
+
+	if mmResolve.defaultExpectation.paramPtrs == nil {
+		mmResolve.defaultExpectation.paramPtrs = &ReportRepositoryMockResolveParamPtrs{}
+	}
+	mmResolve.defaultExpectation.paramPtrs.id = &id
+
+	return mmResolve
+}
+
+// ExpectActionParam3 sets up expected param action for ReportRepository.Resolve
+func (mmResolve *mReportRepositoryMockResolve) ExpectActionParam3(action string) *mReportRepositoryMockResolve {
+	if mmResolve.mock.funcResolve != nil {
+		mmResolve.mock.t.Fatalf("ReportRepositoryMock.Resolve mock is already set by Set")
+	}
+
+	if mmResolve.defaultExpectation == nil {
+		mmResolve.defaultExpectation = &ReportRepositoryMockResolveExpectation{}
+	}
+
+	if mmResolve.defaultExpectation.params != nil {
+		mmResolve.mock.t.Fatalf("ReportRepositoryMock.Resolve mock is already set by Expect")
+	}
+
+	if mmResolve.defaultExpectation.paramPtrs == nil {
+		mmResolve.defaultExpectation.paramPtrs = &ReportRepositoryMockResolveParamPtrs{}
+	}
+	mmResolve.defaultExpectation.paramPtrs.action = &action
+
+	return mmResolve
+}
+
+// ExpectResolvedByParam4 sets up expected param resolvedBy for ReportRepository.Resolve
+func (mmResolve *mReportRepositoryMockResolve) ExpectResolvedByParam4(resolvedBy string) *mReportRepositoryMockResolve {
+	if mmResolve.mock.funcResolve != nil {
+		mmResolve.mock.t.Fatalf("ReportRepositoryMock.Resolve mock is already set by Set")
+	}
+
+	if mmResolve.defaultExpectation == nil {
+		mmResolve.defaultExpectation = &ReportRepositoryMockResolveExpectation{}
+	}
+
+	if mmResolve.defaultExpectation.params != nil {
+		mmResolve.mock.t.Fatalf("ReportRepositoryMock.Resolve mock is already set by Expect")
+	}
+
+	if mmResolve.defaultExpectation.paramPtrs == nil {
+		mmResolve.defaultExpectation.paramPtrs = &ReportRepositoryMockResolveParamPtrs{}
+	}
+	mmResolve.defaultExpectation.paramPtrs.resolvedBy = &resolvedBy
+
+	return mmResolve
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReportRepository.Resolve
+func (mmResolve *mReportRepositoryMockResolve) Inspect(f func(ctx context.Context, id int64, action string, resolvedBy string)) *mReportRepositoryMockResolve {
+	if mmResolve.mock.inspectFuncResolve != nil {
+		mmResolve.mock.t.Fatalf("Inspect function is already set for ReportRepositoryMock.Resolve")
+	}
+
+	mmResolve.mock.inspectFuncResolve = f
+
+	return mmResolve
+}
+
+// Return sets up results that will be returned by ReportRepository.Resolve
+func (mmResolve *mReportRepositoryMockResolve) Return(err error) *ReportRepositoryMock {
+	if mmResolve.mock.funcResolve != nil {
+		mmResolve.mock.t.Fatalf("ReportRepositoryMock.Resolve mock is already set by Set")
+	}
+
+	if mmResolve.defaultExpectation == nil {
+		mmResolve.defaultExpectation = &ReportRepositoryMockResolveExpectation{mock: mmResolve.mock}
+	}
+	mmResolve.defaultExpectation.results = &ReportRepositoryMockResolveResults{err}
+	return mmResolve.mock
+}
+
+// Set uses given function f to mock the ReportRepository.Resolve method
+func (mmResolve *mReportRepositoryMockResolve) Set(f func(ctx context.Context, id int64, action string, resolvedBy string) (err error)) *ReportRepositoryMock {
+	if mmResolve.defaultExpectation != nil {
+		mmResolve.mock.t.Fatalf("Default expectation is already set for the ReportRepository.Resolve method")
+	}
+
+	if len(mmResolve.expectations) > 0 {
+		mmResolve.mock.t.Fatalf("Some expectations are already set for the ReportRepository.Resolve method")
+	}
+
+	mmResolve.mock.funcResolve = f
+	return mmResolve.mock
+}
+
+// When sets expectation for the ReportRepository.Resolve which will trigger the result defined by the following
+// Then helper
+func (mmResolve *mReportRepositoryMockResolve) When(ctx context.Context, id int64, action string, resolvedBy string) *ReportRepositoryMockResolveExpectation {
+	if mmResolve.mock.funcResolve != nil {
+		mmResolve.mock.t.Fatalf("ReportRepositoryMock.Resolve mock is already set by Set")
+	}
+
+	expectation := &ReportRepositoryMockResolveExpectation{
+		mock:   mmResolve.mock,
+		params: &ReportRepositoryMockResolveParams{ctx, id, action, resolvedBy},
+	}
+	mmResolve.expectations = append(mmResolve.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReportRepository.Resolve return parameters for the expectation previously defined by the When method
+func (e *ReportRepositoryMockResolveExpectation) Then(err error) *ReportRepositoryMock {
+	e.results = &ReportRepositoryMockResolveResults{err}
+	return e.mock
+}
+
+// Times sets number of times ReportRepository.Resolve should be invoked
+func (mmResolve *mReportRepositoryMockResolve) Times(n uint64) *mReportRepositoryMockResolve {
+	if n == 0 {
+		mmResolve.mock.t.Fatalf("Times of ReportRepositoryMock.Resolve mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmResolve.expectedInvocations, n)
+	return mmResolve
+}
+
+func (mmResolve *mReportRepositoryMockResolve) invocationsDone() bool {
+	if len(mmResolve.expectations) == 0 && mmResolve.defaultExpectation == nil && mmResolve.mock.funcResolve == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmResolve.mock.afterResolveCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmResolve.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Resolve implements repository.ReportRepository
+func (mmResolve *ReportRepositoryMock) Resolve(ctx context.Context, id int64, action string, resolvedBy string) (err error) {
+	mm_atomic.AddUint64(&mmResolve.beforeResolveCounter, 1)
+	defer mm_atomic.AddUint64(&mmResolve.afterResolveCounter, 1)
+
+	if mmResolve.inspectFuncResolve != nil {
+		mmResolve.inspectFuncResolve(ctx, id, action, resolvedBy)
+	}
+
+	mm_params := ReportRepositoryMockResolveParams{ctx, id, action, resolvedBy}
+
+	// Record call args
+	mmResolve.ResolveMock.mutex.Lock()
+	mmResolve.ResolveMock.callArgs = append(mmResolve.ResolveMock.callArgs, &mm_params)
+	mmResolve.ResolveMock.mutex.Unlock()
+
+	for _, e := range mmResolve.ResolveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmResolve.ResolveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmResolve.ResolveMock.defaultExpectation.Counter, 1)
+		mm_want := mmResolve.ResolveMock.defaultExpectation.params
+		mm_want_ptrs := mmResolve.ResolveMock.defaultExpectation.paramPtrs
+
+		mm_got := ReportRepositoryMockResolveParams{ctx, id, action, resolvedBy}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmResolve.t.Errorf("ReportRepositoryMock.Resolve got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmResolve.t.Errorf("ReportRepositoryMock.Resolve got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.action != nil && !minimock.Equal(*mm_want_ptrs.action, mm_got.action) {
+				mmResolve.t.Errorf("ReportRepositoryMock.Resolve got unexpected parameter action, want: %#v, got: %#v%s\n", *mm_want_ptrs.action, mm_got.action, minimock.Diff(*mm_want_ptrs.action, mm_got.action))
+			}
+
+			if mm_want_ptrs.resolvedBy != nil && !minimock.Equal(*mm_want_ptrs.resolvedBy, mm_got.resolvedBy) {
+				mmResolve.t.Errorf("ReportRepositoryMock.Resolve got unexpected parameter resolvedBy, want: %#v, got: %#v%s\n", *mm_want_ptrs.resolvedBy, mm_got.resolvedBy, minimock.Diff(*mm_want_ptrs.resolvedBy, mm_got.resolvedBy))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmResolve.t.Errorf("ReportRepositoryMock.Resolve got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmResolve.ResolveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmResolve.t.Fatal("No results are set for the ReportRepositoryMock.Resolve")
+		}
+		return (*mm_results).err
+	}
+	if mmResolve.funcResolve != nil {
+		return mmResolve.funcResolve(ctx, id, action, resolvedBy)
+	}
+	mmResolve.t.Fatalf("Unexpected call to ReportRepositoryMock.Resolve. %v %v %v %v", ctx, id, action, resolvedBy)
+	return
+}
+
+// ResolveAfterCounter returns a count of finished ReportRepositoryMock.Resolve invocations
+func (mmResolve *ReportRepositoryMock) ResolveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResolve.afterResolveCounter)
+}
+
+// ResolveBeforeCounter returns a count of ReportRepositoryMock.Resolve invocations
+func (mmResolve *ReportRepositoryMock) ResolveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResolve.beforeResolveCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReportRepositoryMock.Resolve.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmResolve *mReportRepositoryMockResolve) Calls() []*ReportRepositoryMockResolveParams {
+	mmResolve.mutex.RLock()
+
+	argCopy := make([]*ReportRepositoryMockResolveParams, len(mmResolve.callArgs))
+	copy(argCopy, mmResolve.callArgs)
+
+	mmResolve.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockResolveDone returns true if the count of the Resolve invocations corresponds
+// the number of defined expectations
+func (m *ReportRepositoryMock) MinimockResolveDone() bool {
+	if m.ResolveMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ResolveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ResolveMock.invocationsDone()
+}
+
+// MinimockResolveInspect logs each unmet expectation
+func (m *ReportRepositoryMock) MinimockResolveInspect() {
+	for _, e := range m.ResolveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReportRepositoryMock.Resolve with params: %#v", *e.params)
+		}
+	}
+
+	afterResolveCounter := mm_atomic.LoadUint64(&m.afterResolveCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ResolveMock.defaultExpectation != nil && afterResolveCounter < 1 {
+		if m.ResolveMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ReportRepositoryMock.Resolve")
+		} else {
+			m.t.Errorf("Expected call to ReportRepositoryMock.Resolve with params: %#v", *m.ResolveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcResolve != nil && afterResolveCounter < 1 {
+		m.t.Error("Expected call to ReportRepositoryMock.Resolve")
+	}
+
+	if !m.ResolveMock.invocationsDone() && afterResolveCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReportRepositoryMock.Resolve but found %d calls",
+			mm_atomic.LoadUint64(&m.ResolveMock.expectedInvocations), afterResolveCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ReportRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockReportInspect()
+
+			m.MinimockResolveInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ReportRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ReportRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetDone() &&
+		m.MinimockListDone() &&
+		m.MinimockReportDone() &&
+		m.MinimockResolveDone()
+}
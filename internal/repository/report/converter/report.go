@@ -0,0 +1,69 @@
+package converter
+
+import (
+	modelRepo "github.com/mikhailsoldatkin/chat-server/internal/repository/report/model"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/report/model"
+)
+
+// FromServiceToRepo converter from service Report model to Postgres repository Report model.
+func FromServiceToRepo(report *model.Report) *modelRepo.Report {
+	return &modelRepo.Report{
+		ID:         report.ID,
+		MessageID:  report.MessageID,
+		ChatID:     report.ChatID,
+		OffenderID: report.OffenderID,
+		Text:       report.Text,
+		Status:     report.Status,
+		CreatedAt:  report.CreatedAt,
+		ResolvedAt: report.ResolvedAt,
+	}
+}
+
+// FromRepoToService converter from Postgres repository Report model to service Report model.
+func FromRepoToService(report *modelRepo.Report, reasons []*modelRepo.Reason) *model.Report {
+	res := &model.Report{
+		ID:           report.ID,
+		MessageID:    report.MessageID,
+		ChatID:       report.ChatID,
+		OffenderID:   report.OffenderID,
+		Text:         report.Text,
+		Status:       report.Status,
+		ReportsCount: report.ReportsCount,
+		CreatedAt:    report.CreatedAt,
+		ResolvedAt:   report.ResolvedAt,
+		Reasons:      make([]*model.Reason, 0, len(reasons)),
+	}
+
+	if report.Action != nil {
+		res.Action = *report.Action
+	}
+	if report.ResolvedBy != nil {
+		res.ResolvedBy = *report.ResolvedBy
+	}
+
+	for _, reason := range reasons {
+		res.Reasons = append(res.Reasons, &model.Reason{
+			ReporterID: reason.ReporterID,
+			Reason:     reason.Reason,
+			CreatedAt:  reason.CreatedAt,
+		})
+	}
+
+	return res
+}
+
+// FromRepoToServiceList converts list of Postgres repository Report models to list of service Report models,
+// attaching reasons grouped by report ID.
+func FromRepoToServiceList(reports []*modelRepo.Report, reasons []*modelRepo.Reason) []*model.Report {
+	byReport := make(map[int64][]*modelRepo.Reason, len(reports))
+	for _, reason := range reasons {
+		byReport[reason.ReportID] = append(byReport[reason.ReportID], reason)
+	}
+
+	res := make([]*model.Report, len(reports))
+	for i, report := range reports {
+		res[i] = FromRepoToService(report, byReport[report.ID])
+	}
+
+	return res
+}
@@ -0,0 +1,160 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/repository"
+	modelRepo "github.com/mikhailsoldatkin/chat-server/internal/repository/report/model"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/report/model"
+)
+
+var _ repository.ReportRepository = (*memoryRepo)(nil)
+
+type memoryRepo struct {
+	mu sync.RWMutex
+	// reports are ordered by ID, which is also the creation order.
+	reports []*model.Report
+}
+
+// NewMemoryRepository creates a new instance of the reports repository keeping the reports in memory, for running
+// the server without a database. It is safe for concurrent use, the reports are lost on restart.
+func NewMemoryRepository() repository.ReportRepository {
+	return &memoryRepo{}
+}
+
+// Report adds a member's complaint to the open report on the message of the given report, creating the report
+// if needed. A member can complain about a message only once while the report is open.
+func (r *memoryRepo) Report(_ context.Context, newReport *model.Report, reporterID int64, reason string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var report *model.Report
+	for _, rep := range r.reports {
+		if rep.MessageID == newReport.MessageID && rep.Status == modelRepo.StatusOpen {
+			report = rep
+			break
+		}
+	}
+
+	if report == nil {
+		report = &model.Report{
+			ID:         int64(len(r.reports)) + 1,
+			MessageID:  newReport.MessageID,
+			ChatID:     newReport.ChatID,
+			OffenderID: newReport.OffenderID,
+			Text:       newReport.Text,
+			Status:     modelRepo.StatusOpen,
+			CreatedAt:  time.Now(),
+		}
+		r.reports = append(r.reports, report)
+	}
+
+	for _, rsn := range report.Reasons {
+		if rsn.ReporterID == reporterID {
+			return 0, customerrors.NewAlreadyExistsError(
+				reportEntity,
+				fmt.Sprintf("from user %d on message %d", reporterID, newReport.MessageID),
+			)
+		}
+	}
+
+	report.Reasons = append(report.Reasons, &model.Reason{
+		ReporterID: reporterID,
+		Reason:     reason,
+		CreatedAt:  time.Now(),
+	})
+	report.ReportsCount = int64(len(report.Reasons))
+
+	return report.ID, nil
+}
+
+// Get retrieves a report with its reasons by ID.
+func (r *memoryRepo) Get(_ context.Context, id int64) (*model.Report, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	report, ok := r.get(id)
+	if !ok {
+		return nil, customerrors.NewNotFoundError(reportEntity, id)
+	}
+
+	return clone(report), nil
+}
+
+// List retrieves reports with the given status, or all reports if status is empty, oldest first.
+func (r *memoryRepo) List(_ context.Context, status string, limit, offset int64) ([]*model.Report, error) {
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res := make([]*model.Report, 0, limit)
+	for _, report := range r.reports {
+		if status != "" && report.Status != status {
+			continue
+		}
+		if offset > 0 {
+			offset--
+			continue
+		}
+		if int64(len(res)) == limit {
+			break
+		}
+		res = append(res, clone(report))
+	}
+
+	return res, nil
+}
+
+// Resolve marks an open report as resolved with the given action and the moderator who took it.
+func (r *memoryRepo) Resolve(_ context.Context, id int64, action string, resolvedBy string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	report, ok := r.get(id)
+	if !ok || report.Status != modelRepo.StatusOpen {
+		return customerrors.NewReportResolvedError(id)
+	}
+
+	now := time.Now()
+	report.Status = modelRepo.StatusResolved
+	report.Action = action
+	report.ResolvedBy = resolvedBy
+	report.ResolvedAt = &now
+
+	return nil
+}
+
+// get returns the stored report by ID, the caller must hold the lock.
+func (r *memoryRepo) get(id int64) (*model.Report, bool) {
+	if id < 1 || id > int64(len(r.reports)) {
+		return nil, false
+	}
+
+	return r.reports[id-1], true
+}
+
+// clone returns a copy of the report not sharing memory with it.
+func clone(report *model.Report) *model.Report {
+	res := *report
+	res.Reasons = make([]*model.Reason, len(report.Reasons))
+	for i, reason := range report.Reasons {
+		rsn := *reason
+		res.Reasons[i] = &rsn
+	}
+	if report.ResolvedAt != nil {
+		resolvedAt := *report.ResolvedAt
+		res.ResolvedAt = &resolvedAt
+	}
+
+	return &res
+}
@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+// Report statuses stored in the status column.
+const (
+	StatusOpen     = "OPEN"
+	StatusResolved = "RESOLVED"
+)
+
+// Report represents a report entity in the Postgres database.
+type Report struct {
+	ID           int64      `db:"id"`
+	MessageID    int64      `db:"message_id"`
+	ChatID       int64      `db:"chat_id"`
+	OffenderID   int64      `db:"offender_id"`
+	Text         string     `db:"text"`
+	Status       string     `db:"status"`
+	ReportsCount int64      `db:"reports_count"`
+	Action       *string    `db:"action"`
+	ResolvedBy   *string    `db:"resolved_by"`
+	CreatedAt    time.Time  `db:"created_at"`
+	ResolvedAt   *time.Time `db:"resolved_at"`
+}
+
+// Reason represents a report reason entity in the Postgres database.
+type Reason struct {
+	ReportID   int64     `db:"report_id"`
+	ReporterID int64     `db:"reporter_id"`
+	Reason     string    `db:"reason"`
+	CreatedAt  time.Time `db:"created_at"`
+}
@@ -0,0 +1,279 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/replica"
+	"github.com/mikhailsoldatkin/chat-server/internal/repository"
+	"github.com/mikhailsoldatkin/chat-server/internal/repository/report/converter"
+	modelRepo "github.com/mikhailsoldatkin/chat-server/internal/repository/report/model"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/report/model"
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+)
+
+const (
+	tableReports       = "reports"
+	tableReportReasons = "report_reasons"
+	columnID           = "id"
+	columnMessageID    = "message_id"
+	columnChatID       = "chat_id"
+	columnOffenderID   = "offender_id"
+	columnText         = "text"
+	columnStatus       = "status"
+	columnAction       = "action"
+	columnResolvedBy   = "resolved_by"
+	columnCreatedAt    = "created_at"
+	columnUpdatedAt    = "updated_at"
+	columnResolvedAt   = "resolved_at"
+	columnReportID     = "report_id"
+	columnReporterID   = "reporter_id"
+	columnReason       = "reason"
+	columnReportsCount = "reports_count"
+	reportEntity       = "report"
+
+	defaultPageSize = 10
+)
+
+var _ repository.ReportRepository = (*repo)(nil)
+
+type repo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of the reports Postgres repository.
+func NewRepository(db db.Client) repository.ReportRepository {
+	return &repo{db: db}
+}
+
+// selectReports returns a builder selecting reports along with the number of their reasons.
+func selectReports() sq.SelectBuilder {
+	reportsCount := fmt.Sprintf(
+		"(SELECT COUNT(*) FROM %[1]s WHERE %[1]s.%[2]s = %[3]s.%[4]s) AS %[5]s",
+		tableReportReasons, columnReportID, tableReports, columnID, columnReportsCount,
+	)
+
+	return sq.Select(
+		columnID,
+		columnMessageID,
+		columnChatID,
+		columnOffenderID,
+		columnText,
+		columnStatus,
+		columnAction,
+		columnResolvedBy,
+		columnCreatedAt,
+		columnResolvedAt,
+		reportsCount,
+	).
+		From(tableReports).
+		PlaceholderFormat(sq.Dollar)
+}
+
+// Report adds a member's complaint to the open report on the message of the given report, creating the report
+// if needed. A member can complain about a message only once while the report is open.
+// The message is copied into the report, so it stays available after the message is deleted.
+func (r *repo) Report(ctx context.Context, report *model.Report, reporterID int64, reason string) (int64, error) {
+	rep := converter.FromServiceToRepo(report)
+
+	reportBuilder := sq.Insert(tableReports).
+		PlaceholderFormat(sq.Dollar).
+		Columns(columnMessageID, columnChatID, columnOffenderID, columnText).
+		Values(rep.MessageID, rep.ChatID, rep.OffenderID, rep.Text).
+		Suffix(fmt.Sprintf(
+			"ON CONFLICT (%s) WHERE %s = '%s' DO UPDATE SET %s = NOW() RETURNING id",
+			columnMessageID, columnStatus, modelRepo.StatusOpen, columnUpdatedAt,
+		))
+
+	reportQuery, reportArgs, err := reportBuilder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	qReport := db.Query{
+		Name:     "report_repository.Report",
+		QueryRaw: reportQuery,
+	}
+
+	var reportID int64
+	err = r.db.DB().ScanOneContext(ctx, &reportID, qReport, reportArgs...)
+	if err != nil {
+		return 0, err
+	}
+
+	reasonBuilder := sq.Insert(tableReportReasons).
+		PlaceholderFormat(sq.Dollar).
+		Columns(columnReportID, columnReporterID, columnReason).
+		Values(reportID, reporterID, reason).
+		Suffix("ON CONFLICT DO NOTHING")
+
+	reasonQuery, reasonArgs, err := reasonBuilder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	qReason := db.Query{
+		Name:     "report_repository.ReportReason",
+		QueryRaw: reasonQuery,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, qReason, reasonArgs...)
+	if err != nil {
+		return 0, err
+	}
+
+	if res.RowsAffected() == 0 {
+		return 0, customerrors.NewAlreadyExistsError(
+			reportEntity,
+			fmt.Sprintf("from user %d on message %d", reporterID, rep.MessageID),
+		)
+	}
+
+	return reportID, nil
+}
+
+// Get retrieves a report with its reasons by ID from the database.
+func (r *repo) Get(ctx context.Context, id int64) (*model.Report, error) {
+	query, args, err := selectReports().Where(sq.Eq{columnID: id}).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "report_repository.Get",
+		QueryRaw: query,
+	}
+
+	var report modelRepo.Report
+	err = r.db.DB().ScanOneContext(ctx, &report, q, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, customerrors.NewNotFoundError(reportEntity, id)
+		}
+		return nil, err
+	}
+
+	reasons, err := r.reasons(ctx, []int64{id})
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromRepoToService(&report, reasons), nil
+}
+
+// List retrieves reports with the given status, or all reports if status is empty, oldest first. Served by a replica if any.
+func (r *repo) List(ctx context.Context, status string, limit, offset int64) ([]*model.Report, error) {
+	ctx = replica.ReadOnly(ctx)
+
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	builder := selectReports().
+		OrderBy(columnCreatedAt, columnID).
+		Limit(uint64(limit)).
+		Offset(uint64(offset))
+
+	if status != "" {
+		builder = builder.Where(sq.Eq{columnStatus: status})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "report_repository.List",
+		QueryRaw: query,
+	}
+
+	var reports []*modelRepo.Report
+	err = r.db.DB().ScanAllContext(ctx, &reports, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, len(reports))
+	for i, report := range reports {
+		ids[i] = report.ID
+	}
+
+	reasons, err := r.reasons(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromRepoToServiceList(reports, reasons), nil
+}
+
+// Resolve marks an open report as resolved with the given action and the moderator who took it.
+func (r *repo) Resolve(ctx context.Context, id int64, action string, resolvedBy string) error {
+	builder := sq.Update(tableReports).
+		PlaceholderFormat(sq.Dollar).
+		Set(columnStatus, modelRepo.StatusResolved).
+		Set(columnAction, action).
+		Set(columnResolvedBy, resolvedBy).
+		Set(columnResolvedAt, sq.Expr("NOW()")).
+		Set(columnUpdatedAt, sq.Expr("NOW()")).
+		Where(sq.Eq{columnID: id, columnStatus: modelRepo.StatusOpen})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "report_repository.Resolve",
+		QueryRaw: query,
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return customerrors.NewReportResolvedError(id)
+	}
+
+	return nil
+}
+
+// reasons retrieves reasons of the given reports.
+func (r *repo) reasons(ctx context.Context, reportIDs []int64) ([]*modelRepo.Reason, error) {
+	if len(reportIDs) == 0 {
+		return nil, nil
+	}
+
+	builder := sq.Select(columnReportID, columnReporterID, columnReason, columnCreatedAt).
+		From(tableReportReasons).
+		Where(sq.Eq{columnReportID: reportIDs}).
+		OrderBy(columnCreatedAt).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "report_repository.Reasons",
+		QueryRaw: query,
+	}
+
+	var reasons []*modelRepo.Reason
+	err = r.db.DB().ScanAllContext(ctx, &reasons, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return reasons, nil
+}
@@ -0,0 +1,72 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/repository/report"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/report/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryRepository(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx  = context.Background()
+		repo = report.NewMemoryRepository()
+
+		msg = &model.Report{
+			MessageID:  gofakeit.Int64(),
+			ChatID:     gofakeit.Int64(),
+			OffenderID: gofakeit.Int64(),
+			Text:       gofakeit.Sentence(5),
+		}
+		reporter      = gofakeit.Int64()
+		otherReporter = reporter + 1
+		moderator     = gofakeit.Username()
+	)
+
+	id, err := repo.Report(ctx, msg, reporter, "spam")
+	require.NoError(t, err)
+
+	sameID, err := repo.Report(ctx, msg, otherReporter, "abuse")
+	require.NoError(t, err)
+	require.Equal(t, id, sameID, "complaints about a message are aggregated in its open report")
+
+	_, err = repo.Report(ctx, msg, reporter, "spam")
+	require.Equal(t, customerrors.NewAlreadyExistsError(
+		"report", fmt.Sprintf("from user %d on message %d", reporter, msg.MessageID),
+	), err)
+
+	got, err := repo.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, model.StatusOpen, got.Status)
+	require.Equal(t, msg.Text, got.Text)
+	require.Equal(t, msg.OffenderID, got.OffenderID)
+	require.Equal(t, int64(2), got.ReportsCount)
+	require.Len(t, got.Reasons, 2)
+
+	require.NoError(t, repo.Resolve(ctx, id, model.ActionDismiss, moderator))
+	require.Equal(t, customerrors.NewReportResolvedError(id), repo.Resolve(ctx, id, model.ActionDismiss, moderator))
+
+	newID, err := repo.Report(ctx, msg, reporter, "spam")
+	require.NoError(t, err)
+	require.NotEqual(t, id, newID, "a resolved report is not reopened")
+
+	open, err := repo.List(ctx, model.StatusOpen, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, open, 1)
+	require.Equal(t, newID, open[0].ID)
+
+	all, err := repo.List(ctx, "", 1, 1)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	require.Equal(t, newID, all[0].ID)
+
+	_, err = repo.Get(ctx, newID+1)
+	require.Equal(t, customerrors.NewNotFoundError("report", newID+1), err)
+}
@@ -3,6 +3,9 @@ package repository
 import (
 	"context"
 
+	apiKeyModel "github.com/mikhailsoldatkin/chat-server/internal/service/apikey/model"
+	chatModel "github.com/mikhailsoldatkin/chat-server/internal/service/chat/model"
+	reportModel "github.com/mikhailsoldatkin/chat-server/internal/service/report/model"
 	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
 )
 
@@ -10,5 +13,26 @@ import (
 type ChatRepository interface {
 	Create(ctx context.Context, users []int64) (int64, error)
 	Delete(ctx context.Context, id int64) error
-	SendMessage(ctx context.Context, req *pb.SendMessageRequest) error
+	SendMessage(ctx context.Context, req *pb.SendMessageRequest, senderType string) (int64, error)
+	GetMessage(ctx context.Context, id int64) (*chatModel.Message, error)
+	DeleteMessage(ctx context.Context, id int64) error
+	IsUserInChat(ctx context.Context, userID int64, chatID int64) error
+	RemoveUser(ctx context.Context, chatID int64, userID int64) error
+}
+
+// ReportRepository defines the interface for abuse reports database operations.
+type ReportRepository interface {
+	Report(ctx context.Context, report *reportModel.Report, reporterID int64, reason string) (int64, error)
+	Get(ctx context.Context, id int64) (*reportModel.Report, error)
+	List(ctx context.Context, status string, limit, offset int64) ([]*reportModel.Report, error)
+	Resolve(ctx context.Context, id int64, action string, resolvedBy string) error
+}
+
+// APIKeyRepository defines the interface for API keys database operations.
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *apiKeyModel.APIKey) (int64, error)
+	GetByHash(ctx context.Context, hash string) (*apiKeyModel.APIKey, error)
+	List(ctx context.Context, limit, offset int64) ([]*apiKeyModel.APIKey, error)
+	Revoke(ctx context.Context, id int64) error
+	TouchLastUsed(ctx context.Context, id int64) error
 }
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+)
+
+type noOpTxManager struct{}
+
+// NewNoOpTxManager creates a transaction manager running handlers without a transaction, for the in-memory
+// repositories whose operations are atomic on their own.
+func NewNoOpTxManager() db.TxManager {
+	return noOpTxManager{}
+}
+
+func (noOpTxManager) ReadCommitted(ctx context.Context, f db.Handler) error {
+	return f(ctx)
+}
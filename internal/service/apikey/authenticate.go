@@ -0,0 +1,35 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/logger"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/apikey/model"
+	"go.uber.org/zap"
+)
+
+// Authenticate looks up the API key and checks that it may be used to call the method on the chat with chatID,
+// zero if the request isn't addressed to a chat. The use of the key is recorded.
+func (s *serv) Authenticate(ctx context.Context, secret, method string, chatID int64) (*model.APIKey, error) {
+	key, err := s.apiKeyRepository.GetByHash(ctx, hashKey(secret))
+	if err != nil {
+		return nil, err
+	}
+
+	if !key.Active(time.Now()) {
+		return nil, customerrors.NewUnauthenticatedError("invalid API key")
+	}
+
+	if !key.Allows(method, chatID) {
+		return nil, customerrors.NewPermissionDeniedError("API key is not allowed to call the method")
+	}
+
+	// the last use is informational, failing to record it must not fail the request
+	if err = s.apiKeyRepository.TouchLastUsed(ctx, key.ID); err != nil {
+		logger.Warn("failed to record API key use", zap.Int64("key_id", key.ID), zap.Error(err))
+	}
+
+	return key, nil
+}
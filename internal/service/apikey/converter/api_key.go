@@ -0,0 +1,60 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/service/apikey/model"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FromServiceToProtobuf converter from service APIKey model to protobuf APIKey model.
+func FromServiceToProtobuf(key *model.APIKey) *pb.APIKey {
+	return &pb.APIKey{
+		Id:         key.ID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		UserId:     key.UserID,
+		ChatIds:    key.ChatIDs,
+		Methods:    key.Methods,
+		CreatedBy:  key.CreatedBy,
+		CreatedAt:  timestamppb.New(key.CreatedAt),
+		ExpiresAt:  toTimestamp(key.ExpiresAt),
+		LastUsedAt: toTimestamp(key.LastUsedAt),
+		RevokedAt:  toTimestamp(key.RevokedAt),
+	}
+}
+
+// FromServiceToProtobufList converts a list of service APIKey models to a list of protobuf APIKey models.
+func FromServiceToProtobufList(keys []*model.APIKey) []*pb.APIKey {
+	res := make([]*pb.APIKey, len(keys))
+	for i, key := range keys {
+		res[i] = FromServiceToProtobuf(key)
+	}
+	return res
+}
+
+// FromProtobufToService converts a protobuf CreateAPIKeyRequest to a service APIKey model issued by createdBy.
+func FromProtobufToService(req *pb.CreateAPIKeyRequest, createdBy string) *model.APIKey {
+	key := &model.APIKey{
+		Name:      req.GetName(),
+		UserID:    req.GetUserId(),
+		ChatIDs:   req.GetChatIds(),
+		Methods:   req.GetMethods(),
+		CreatedBy: createdBy,
+	}
+
+	if req.GetExpiresAt() != nil {
+		expiresAt := req.GetExpiresAt().AsTime()
+		key.ExpiresAt = &expiresAt
+	}
+
+	return key
+}
+
+func toTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,62 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/apikey/model"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+)
+
+// managementMethods can't be granted to API keys, so a leaked key can't be used to issue new keys.
+var managementMethods = map[string]struct{}{
+	pb.ChatV1_CreateAPIKey_FullMethodName: {},
+	pb.ChatV1_ListAPIKeys_FullMethodName:  {},
+	pb.ChatV1_RevokeAPIKey_FullMethodName: {},
+}
+
+// CreateAPIKey issues a new API key and returns its ID and the key itself.
+// The key is only returned once, the server keeps its hash.
+func (s *serv) CreateAPIKey(ctx context.Context, key *model.APIKey) (int64, string, error) {
+	for _, method := range key.Methods {
+		if err := validateMethod(method); err != nil {
+			return 0, "", err
+		}
+	}
+
+	secret, err := generateKey()
+	if err != nil {
+		return 0, "", err
+	}
+
+	k := *key
+	k.Prefix = secret[:prefixLen]
+	k.Hash = hashKey(secret)
+
+	id, err := s.apiKeyRepository.Create(ctx, &k)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return id, secret, nil
+}
+
+// validateMethod checks that the method is a chat API method that may be granted to API keys.
+func validateMethod(method string) error {
+	if _, ok := managementMethods[method]; ok {
+		return customerrors.NewFailedPreconditionError(fmt.Sprintf("method %s can't be granted to API keys", method))
+	}
+
+	name, ok := strings.CutPrefix(method, "/"+pb.ChatV1_ServiceDesc.ServiceName+"/")
+	if ok {
+		for _, m := range pb.ChatV1_ServiceDesc.Methods {
+			if m.MethodName == name {
+				return nil
+			}
+		}
+	}
+
+	return customerrors.NewFailedPreconditionError(fmt.Sprintf("unknown method %s", method))
+}
@@ -0,0 +1,33 @@
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+const (
+	// keyPrefix marks the API keys issued by the chat server to make leaked keys easy to recognize.
+	keyPrefix = "ck_"
+	keyBytes  = 32
+	// prefixLen is the length of the key start stored in clear text to tell the keys apart.
+	prefixLen = len(keyPrefix) + 8
+)
+
+// generateKey returns a new random API key.
+func generateKey() (string, error) {
+	b := make([]byte, keyBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return keyPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashKey returns the hash the API key is stored and looked up by.
+// Keys are random and long enough for a plain SHA-256 to be safe, unlike passwords.
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
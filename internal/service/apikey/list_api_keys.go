@@ -0,0 +1,17 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/service/apikey/model"
+)
+
+// ListAPIKeys retrieves API keys based on the provided limit and offset.
+func (s *serv) ListAPIKeys(ctx context.Context, limit, offset int64) ([]*model.APIKey, error) {
+	keys, err := s.apiKeyRepository.List(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
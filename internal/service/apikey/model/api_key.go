@@ -0,0 +1,47 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+// APIKey represents a business logic model of a credential bots and integrations call the API with.
+// The key itself is never stored, only its hash and its prefix to recognize it by.
+// Calls are limited to Methods and, unless ChatIDs is empty, to requests addressed to one of the chats.
+type APIKey struct {
+	ID         int64
+	Name       string
+	Prefix     string
+	Hash       string
+	UserID     int64
+	ChatIDs    []int64
+	Methods    []string
+	CreatedBy  string
+	CreatedAt  time.Time
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
+
+// Active reports whether the key is neither revoked nor expired at the moment.
+func (k *APIKey) Active(now time.Time) bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+
+	return k.ExpiresAt == nil || now.Before(*k.ExpiresAt)
+}
+
+// Allows reports whether the key may be used to call the method on the chat with chatID, zero if the request
+// isn't addressed to a chat.
+func (k *APIKey) Allows(method string, chatID int64) bool {
+	if !slices.Contains(k.Methods, method) {
+		return false
+	}
+
+	if len(k.ChatIDs) == 0 {
+		return true
+	}
+
+	return chatID != 0 && slices.Contains(k.ChatIDs, chatID)
+}
@@ -0,0 +1,8 @@
+package apikey
+
+import "context"
+
+// RevokeAPIKey revokes an API key, requests made with it are rejected from then on.
+func (s *serv) RevokeAPIKey(ctx context.Context, id int64) error {
+	return s.apiKeyRepository.Revoke(ctx, id)
+}
@@ -0,0 +1,33 @@
+package apikey
+
+import (
+	"github.com/mikhailsoldatkin/chat-server/internal/repository"
+	"github.com/mikhailsoldatkin/chat-server/internal/service"
+)
+
+var _ service.APIKeyService = (*serv)(nil)
+
+type serv struct {
+	apiKeyRepository repository.APIKeyRepository
+}
+
+// NewService creates a new instance of the API keys service.
+func NewService(apiKeyRepository repository.APIKeyRepository) service.APIKeyService {
+	return &serv{
+		apiKeyRepository: apiKeyRepository,
+	}
+}
+
+// NewMockService creates a new mock instance of the API keys service.
+func NewMockService(deps ...any) service.APIKeyService {
+	srv := serv{}
+
+	for _, v := range deps {
+		switch s := v.(type) {
+		case repository.APIKeyRepository:
+			srv.apiKeyRepository = s
+		}
+	}
+
+	return &srv
+}
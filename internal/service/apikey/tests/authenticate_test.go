@@ -0,0 +1,143 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/repository"
+	repoMocks "github.com/mikhailsoldatkin/chat-server/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/apikey"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/apikey/model"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthenticate(t *testing.T) {
+	t.Parallel()
+	type apiKeyRepoMockFunc func(mc *minimock.Controller) repository.APIKeyRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		secret = "ck_" + gofakeit.LetterN(43)
+		hash   = hashKey(secret)
+		chatID = gofakeit.Int64()
+		method = pb.ChatV1_SendMessage_FullMethodName
+
+		past   = time.Now().Add(-time.Hour)
+		future = time.Now().Add(time.Hour)
+
+		key = &model.APIKey{
+			ID:        gofakeit.Int64(),
+			UserID:    gofakeit.Int64(),
+			ChatIDs:   []int64{chatID},
+			Methods:   []string{method},
+			ExpiresAt: &future,
+		}
+		revokedKey = &model.APIKey{ID: key.ID, ChatIDs: key.ChatIDs, Methods: key.Methods, RevokedAt: &past}
+		expiredKey = &model.APIKey{ID: key.ID, ChatIDs: key.ChatIDs, Methods: key.Methods, ExpiresAt: &past}
+
+		errInvalid = customerrors.NewUnauthenticatedError("invalid API key")
+		errDenied  = customerrors.NewPermissionDeniedError("API key is not allowed to call the method")
+	)
+
+	tests := []struct {
+		name           string
+		method         string
+		chatID         int64
+		want           *model.APIKey
+		err            error
+		apiKeyRepoMock apiKeyRepoMockFunc
+	}{
+		{
+			name:   "success case",
+			method: method,
+			chatID: chatID,
+			want:   key,
+			err:    nil,
+			apiKeyRepoMock: func(mc *minimock.Controller) repository.APIKeyRepository {
+				mock := repoMocks.NewAPIKeyRepositoryMock(mc)
+				mock.GetByHashMock.Expect(ctx, hash).Return(key, nil)
+				mock.TouchLastUsedMock.Expect(ctx, key.ID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:   "unknown key",
+			method: method,
+			chatID: chatID,
+			want:   nil,
+			err:    errInvalid,
+			apiKeyRepoMock: func(mc *minimock.Controller) repository.APIKeyRepository {
+				mock := repoMocks.NewAPIKeyRepositoryMock(mc)
+				mock.GetByHashMock.Expect(ctx, hash).Return(nil, errInvalid)
+				return mock
+			},
+		},
+		{
+			name:   "revoked key",
+			method: method,
+			chatID: chatID,
+			want:   nil,
+			err:    errInvalid,
+			apiKeyRepoMock: func(mc *minimock.Controller) repository.APIKeyRepository {
+				mock := repoMocks.NewAPIKeyRepositoryMock(mc)
+				mock.GetByHashMock.Expect(ctx, hash).Return(revokedKey, nil)
+				return mock
+			},
+		},
+		{
+			name:   "expired key",
+			method: method,
+			chatID: chatID,
+			want:   nil,
+			err:    errInvalid,
+			apiKeyRepoMock: func(mc *minimock.Controller) repository.APIKeyRepository {
+				mock := repoMocks.NewAPIKeyRepositoryMock(mc)
+				mock.GetByHashMock.Expect(ctx, hash).Return(expiredKey, nil)
+				return mock
+			},
+		},
+		{
+			name:   "method not allowed",
+			method: pb.ChatV1_Delete_FullMethodName,
+			chatID: chatID,
+			want:   nil,
+			err:    errDenied,
+			apiKeyRepoMock: func(mc *minimock.Controller) repository.APIKeyRepository {
+				mock := repoMocks.NewAPIKeyRepositoryMock(mc)
+				mock.GetByHashMock.Expect(ctx, hash).Return(key, nil)
+				return mock
+			},
+		},
+		{
+			name:   "chat not allowed",
+			method: method,
+			chatID: chatID + 1,
+			want:   nil,
+			err:    errDenied,
+			apiKeyRepoMock: func(mc *minimock.Controller) repository.APIKeyRepository {
+				mock := repoMocks.NewAPIKeyRepositoryMock(mc)
+				mock.GetByHashMock.Expect(ctx, hash).Return(key, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := apikey.NewMockService(tt.apiKeyRepoMock(mc))
+
+			got, err := service.Authenticate(ctx, secret, tt.method, tt.chatID)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
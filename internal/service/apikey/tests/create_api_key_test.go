@@ -0,0 +1,123 @@
+package tests
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/repository"
+	repoMocks "github.com/mikhailsoldatkin/chat-server/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/apikey"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/apikey/model"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAPIKey(t *testing.T) {
+	t.Parallel()
+	type apiKeyRepoMockFunc func(mc *minimock.Controller) repository.APIKeyRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		id     = gofakeit.Int64()
+		userID = gofakeit.Int64()
+
+		wantErr = fmt.Errorf("repository error")
+	)
+
+	newKey := func(methods ...string) *model.APIKey {
+		return &model.APIKey{
+			Name:      gofakeit.AppName(),
+			UserID:    userID,
+			ChatIDs:   []int64{gofakeit.Int64()},
+			Methods:   methods,
+			CreatedBy: gofakeit.Username(),
+		}
+	}
+
+	tests := []struct {
+		name           string
+		key            *model.APIKey
+		want           int64
+		err            error
+		apiKeyRepoMock apiKeyRepoMockFunc
+	}{
+		{
+			name: "success case",
+			key:  newKey(pb.ChatV1_SendMessage_FullMethodName),
+			want: id,
+			err:  nil,
+			apiKeyRepoMock: func(mc *minimock.Controller) repository.APIKeyRepository {
+				mock := repoMocks.NewAPIKeyRepositoryMock(mc)
+				mock.CreateMock.Set(func(_ context.Context, key *model.APIKey) (int64, error) {
+					require.Equal(t, userID, key.UserID)
+					require.True(t, strings.HasPrefix(key.Prefix, "ck_"))
+					require.Len(t, key.Hash, sha256.Size*2)
+					return id, nil
+				})
+				return mock
+			},
+		},
+		{
+			name: "repository error",
+			key:  newKey(pb.ChatV1_SendMessage_FullMethodName),
+			want: 0,
+			err:  wantErr,
+			apiKeyRepoMock: func(mc *minimock.Controller) repository.APIKeyRepository {
+				mock := repoMocks.NewAPIKeyRepositoryMock(mc)
+				mock.CreateMock.Return(0, wantErr)
+				return mock
+			},
+		},
+		{
+			name: "unknown method",
+			key:  newKey("/chat_v1.ChatV1/Unknown"),
+			want: 0,
+			err:  customerrors.NewFailedPreconditionError("unknown method /chat_v1.ChatV1/Unknown"),
+			apiKeyRepoMock: func(mc *minimock.Controller) repository.APIKeyRepository {
+				return repoMocks.NewAPIKeyRepositoryMock(mc)
+			},
+		},
+		{
+			name: "key management method",
+			key:  newKey(pb.ChatV1_SendMessage_FullMethodName, pb.ChatV1_CreateAPIKey_FullMethodName),
+			want: 0,
+			err: customerrors.NewFailedPreconditionError(
+				fmt.Sprintf("method %s can't be granted to API keys", pb.ChatV1_CreateAPIKey_FullMethodName),
+			),
+			apiKeyRepoMock: func(mc *minimock.Controller) repository.APIKeyRepository {
+				return repoMocks.NewAPIKeyRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := apikey.NewMockService(tt.apiKeyRepoMock(mc))
+
+			gotID, secret, err := service.CreateAPIKey(ctx, tt.key)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, gotID)
+			if err == nil {
+				require.True(t, strings.HasPrefix(secret, "ck_"))
+				require.Empty(t, tt.key.Hash, "the given key must not be modified")
+			}
+		})
+	}
+}
+
+// hashKey returns the hash of the key as stored by the API keys service.
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
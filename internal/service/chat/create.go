@@ -2,6 +2,8 @@ package chat
 
 import (
 	"context"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/metric"
 )
 
 // Create creates a new chat in the system with provided users.
@@ -20,5 +22,7 @@ func (s *serv) Create(ctx context.Context, users []int64) (int64, error) {
 		return 0, err
 	}
 
+	metric.IncChatsCreated()
+
 	return id, nil
 }
@@ -4,11 +4,14 @@ import (
 	"context"
 )
 
-// Delete removes a chat from the system by ID.
+// Delete removes a chat from the system by ID, cancelling the subscriptions to it.
 func (s *serv) Delete(ctx context.Context, id int64) error {
 	err := s.chatRepository.Delete(ctx, id)
 	if err != nil {
 		return err
 	}
+
+	s.broker.Unsubscribe(id)
+
 	return nil
 }
@@ -1,5 +1,7 @@
 package model
 
+import "time"
+
 // Chat represents a business logic chat model.
 type Chat struct {
 	ID int64
@@ -10,3 +12,41 @@ type ChatUser struct {
 	ChatID int64
 	UserID int64
 }
+
+// Message sender types.
+const (
+	SenderUser = "user"
+	SenderBot  = "bot"
+)
+
+// Message represents a business logic chat message model.
+// SenderType tells whether the message was sent by a user or by a bot with an API key.
+type Message struct {
+	ID         int64
+	ChatID     int64
+	FromUser   int64
+	SenderType string
+	Text       string
+	Timestamp  time.Time
+}
+
+// Event types delivered to chat subscribers.
+const (
+	EventMessage = "message"
+	EventTyping  = "typing"
+	EventRead    = "read"
+	// EventUnsubscribed is delivered to a subscriber no longer receiving events of the chat because it
+	// was deleted or the subscriber was removed from it.
+	EventUnsubscribed = "unsubscribed"
+)
+
+// Event represents something that happened in a chat and is delivered to the chat subscribers.
+// Message is set for message events, MessageID for read events.
+type Event struct {
+	Type      string
+	ChatID    int64
+	UserID    int64
+	MessageID int64
+	Message   *Message
+	Timestamp time.Time
+}
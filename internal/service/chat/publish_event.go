@@ -0,0 +1,30 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/chat/model"
+)
+
+// PublishEvent delivers a typing or read event of a chat member to the chat subscribers.
+// Message events are published by SendMessage only.
+func (s *serv) PublishEvent(ctx context.Context, event *model.Event) error {
+	if event.Type != model.EventTyping && event.Type != model.EventRead {
+		return customerrors.NewInvalidArgumentError("only typing and read events can be published")
+	}
+
+	err := s.chatRepository.IsUserInChat(ctx, event.UserID, event.ChatID)
+	if err != nil {
+		return err
+	}
+
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	s.broker.Publish(event)
+
+	return nil
+}
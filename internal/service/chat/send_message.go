@@ -2,14 +2,43 @@ package chat
 
 import (
 	"context"
+	"time"
 
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/metric"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/chat/model"
+	"github.com/mikhailsoldatkin/chat-server/internal/utils"
 	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+	"google.golang.org/protobuf/proto"
 )
 
-// SendMessage handles sending a message to a chat from a chat.
-func (s *serv) SendMessage(ctx context.Context, req *pb.SendMessageRequest) error {
-	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
-		errTx := s.chatRepository.SendMessage(ctx, req)
+// SendMessage handles sending a message to a chat from a chat and returns the message ID.
+// The message text is run through the moderation filters first and may be rejected or rewritten.
+// The stored message is delivered to the chat subscribers.
+// Bots calling with an API key may only send messages as the user the key is issued for.
+func (s *serv) SendMessage(ctx context.Context, req *pb.SendMessageRequest) (int64, error) {
+	senderType := model.SenderUser
+	if bot, ok := utils.BotFromContext(ctx); ok {
+		if req.GetFromUser() != bot.UserID {
+			return 0, customerrors.NewPermissionDeniedError("API key is not allowed to send messages as another user")
+		}
+		senderType = model.SenderBot
+	}
+
+	text, err := s.moderator.Moderate(ctx, req.GetChatId(), req.GetFromUser(), req.GetText())
+	if err != nil {
+		return 0, err
+	}
+
+	if text != req.GetText() {
+		req = proto.Clone(req).(*pb.SendMessageRequest)
+		req.Text = text
+	}
+
+	var id int64
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		id, errTx = s.chatRepository.SendMessage(ctx, req, senderType)
 		if errTx != nil {
 			return errTx
 		}
@@ -18,8 +47,26 @@ func (s *serv) SendMessage(ctx context.Context, req *pb.SendMessageRequest) erro
 	})
 
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	return nil
+	metric.IncMessagesSent()
+
+	now := time.Now()
+	s.broker.Publish(&model.Event{
+		Type:   model.EventMessage,
+		ChatID: req.GetChatId(),
+		UserID: req.GetFromUser(),
+		Message: &model.Message{
+			ID:         id,
+			ChatID:     req.GetChatId(),
+			FromUser:   req.GetFromUser(),
+			Text:       req.GetText(),
+			SenderType: senderType,
+			Timestamp:  now,
+		},
+		Timestamp: now,
+	})
+
+	return id, nil
 }
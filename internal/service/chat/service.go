@@ -3,6 +3,8 @@ package chat
 import (
 	"context"
 
+	"github.com/mikhailsoldatkin/chat-server/internal/broker"
+	"github.com/mikhailsoldatkin/chat-server/internal/moderation"
 	"github.com/mikhailsoldatkin/chat-server/internal/repository"
 	"github.com/mikhailsoldatkin/chat-server/internal/service"
 	"github.com/mikhailsoldatkin/platform_common/pkg/db"
@@ -13,13 +15,22 @@ var _ service.ChatService = (*serv)(nil)
 type serv struct {
 	chatRepository repository.ChatRepository
 	txManager      db.TxManager
+	moderator      moderation.Moderator
+	broker         broker.Broker
 }
 
 // NewService creates a new instance of the chat service.
-func NewService(chatRepository repository.ChatRepository, txManager db.TxManager) service.ChatService {
+func NewService(
+	chatRepository repository.ChatRepository,
+	txManager db.TxManager,
+	moderator moderation.Moderator,
+	broker broker.Broker,
+) service.ChatService {
 	return &serv{
 		chatRepository: chatRepository,
 		txManager:      txManager,
+		moderator:      moderator,
+		broker:         broker,
 	}
 }
 
@@ -34,13 +45,18 @@ func (noOpTxManager) ReadCommitted(ctx context.Context, f db.Handler) error {
 func NewMockService(deps ...any) service.ChatService {
 	srv := serv{
 		txManager: noOpTxManager{},
+		moderator: moderation.NewModerator(),
+		broker:    broker.NewBroker(),
 	}
 
 	for _, v := range deps {
 		switch s := v.(type) {
 		case repository.ChatRepository:
 			srv.chatRepository = s
-
+		case moderation.Moderator:
+			srv.moderator = s
+		case broker.Broker:
+			srv.broker = s
 		}
 	}
 
@@ -0,0 +1,21 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/broker"
+)
+
+// Subscribe subscribes to the chats on behalf of the user, who must be a member of every chat.
+func (s *serv) Subscribe(ctx context.Context, sub broker.Subscription, userID int64, chatIDs []int64) error {
+	for _, chatID := range chatIDs {
+		err := s.chatRepository.IsUserInChat(ctx, userID, chatID)
+		if err != nil {
+			return err
+		}
+	}
+
+	sub.Subscribe(chatIDs...)
+
+	return nil
+}
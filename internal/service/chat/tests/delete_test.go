@@ -7,6 +7,7 @@ import (
 
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/chat-server/internal/broker"
 	"github.com/mikhailsoldatkin/chat-server/internal/repository"
 	repoMocks "github.com/mikhailsoldatkin/chat-server/internal/repository/mocks"
 	"github.com/mikhailsoldatkin/chat-server/internal/service/chat"
@@ -69,11 +70,17 @@ func TestDelete(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
+			b := broker.NewBroker()
+			sub := b.NewSubscription(gofakeit.Int64(), 1)
+			defer sub.Close()
+			sub.Subscribe(tt.args.req)
+
 			chatRepoMock := tt.chatRepoMock(mc)
-			service := chat.NewMockService(chatRepoMock)
+			service := chat.NewMockService(chatRepoMock, b)
 
 			repoErr := service.Delete(tt.args.ctx, tt.args.req)
 			require.Equal(t, tt.err, repoErr)
+			require.Equal(t, tt.err == nil, len(sub.Events()) == 1, "the subscriptions to the deleted chat are cancelled")
 		})
 	}
 }
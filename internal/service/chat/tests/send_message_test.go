@@ -7,9 +7,13 @@ import (
 
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/moderation"
 	"github.com/mikhailsoldatkin/chat-server/internal/repository"
 	repoMocks "github.com/mikhailsoldatkin/chat-server/internal/repository/mocks"
 	"github.com/mikhailsoldatkin/chat-server/internal/service/chat"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/chat/model"
+	"github.com/mikhailsoldatkin/chat-server/internal/utils"
 	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
 	"github.com/stretchr/testify/require"
 )
@@ -27,6 +31,7 @@ func TestSendMessage(t *testing.T) {
 		ctx = context.Background()
 		mc  = minimock.NewController(t)
 
+		id     = gofakeit.Int64()
 		chatID = gofakeit.Int64()
 		userID = gofakeit.Int64()
 		msg    = gofakeit.BeerName()
@@ -37,11 +42,15 @@ func TestSendMessage(t *testing.T) {
 			Text:     msg,
 		}
 		wantErr = fmt.Errorf("repository error")
+
+		botCtx      = utils.ContextWithBot(ctx, &utils.Bot{KeyID: gofakeit.Int64(), UserID: userID})
+		otherBotCtx = utils.ContextWithBot(ctx, &utils.Bot{KeyID: gofakeit.Int64(), UserID: userID + 1})
 	)
 
 	tests := []struct {
 		name         string
 		args         args
+		want         int64
 		err          error
 		chatRepoMock chatRepoMockFunc
 	}{
@@ -51,10 +60,11 @@ func TestSendMessage(t *testing.T) {
 				ctx: ctx,
 				req: req,
 			},
-			err: nil,
+			want: id,
+			err:  nil,
 			chatRepoMock: func(mc *minimock.Controller) repository.ChatRepository {
 				mock := repoMocks.NewChatRepositoryMock(mc)
-				mock.SendMessageMock.Expect(ctx, req).Return(nil)
+				mock.SendMessageMock.Expect(ctx, req, model.SenderUser).Return(id, nil)
 				return mock
 			},
 		},
@@ -64,13 +74,40 @@ func TestSendMessage(t *testing.T) {
 				ctx: ctx,
 				req: req,
 			},
-			err: wantErr,
+			want: 0,
+			err:  wantErr,
 			chatRepoMock: func(mc *minimock.Controller) repository.ChatRepository {
 				mock := repoMocks.NewChatRepositoryMock(mc)
-				mock.SendMessageMock.Expect(ctx, req).Return(wantErr)
+				mock.SendMessageMock.Expect(ctx, req, model.SenderUser).Return(0, wantErr)
 				return mock
 			},
 		},
+		{
+			name: "bot case",
+			args: args{
+				ctx: botCtx,
+				req: req,
+			},
+			want: id,
+			err:  nil,
+			chatRepoMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.SendMessageMock.Expect(botCtx, req, model.SenderBot).Return(id, nil)
+				return mock
+			},
+		},
+		{
+			name: "bot sending as another user",
+			args: args{
+				ctx: otherBotCtx,
+				req: req,
+			},
+			want: 0,
+			err:  customerrors.NewPermissionDeniedError("API key is not allowed to send messages as another user"),
+			chatRepoMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return repoMocks.NewChatRepositoryMock(mc)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -80,8 +117,83 @@ func TestSendMessage(t *testing.T) {
 			chatRepoMock := tt.chatRepoMock(mc)
 			service := chat.NewMockService(chatRepoMock)
 
-			repoErr := service.SendMessage(tt.args.ctx, tt.args.req)
+			resp, repoErr := service.SendMessage(tt.args.ctx, tt.args.req)
 			require.Equal(t, tt.err, repoErr)
+			require.Equal(t, tt.want, resp)
+		})
+	}
+}
+
+func TestSendMessageModeration(t *testing.T) {
+	t.Parallel()
+	type chatRepoMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+		userID = gofakeit.Int64()
+
+		maskedReq = &pb.SendMessageRequest{
+			ChatId:   chatID,
+			FromUser: userID,
+			Text:     "what a Darn day",
+		}
+		rejectedReq = &pb.SendMessageRequest{
+			ChatId:   chatID,
+			FromUser: userID,
+			Text:     gofakeit.LetterN(20),
+		}
+	)
+
+	bannedWords, err := moderation.NewBannedWordsFilter("profanity", []string{"darn"}, "mask")
+	require.NoError(t, err)
+
+	moderator := moderation.NewModerator(
+		moderation.NewMaxLengthFilter("max_length", 16),
+		bannedWords,
+	)
+
+	tests := []struct {
+		name         string
+		req          *pb.SendMessageRequest
+		err          error
+		chatRepoMock chatRepoMockFunc
+	}{
+		{
+			name: "text masked",
+			req:  maskedReq,
+			err:  nil,
+			chatRepoMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.SendMessageMock.Set(func(_ context.Context, req *pb.SendMessageRequest, _ string) (int64, error) {
+					require.Equal(t, "what a **** day", req.GetText())
+					require.Equal(t, chatID, req.GetChatId())
+					return gofakeit.Int64(), nil
+				})
+				return mock
+			},
+		},
+		{
+			name: "text rejected",
+			req:  rejectedReq,
+			err:  customerrors.NewModerationError("max_length", "message is longer than 16 characters"),
+			chatRepoMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return repoMocks.NewChatRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepoMock := tt.chatRepoMock(mc)
+			service := chat.NewMockService(chatRepoMock, moderator)
+
+			_, err := service.SendMessage(ctx, tt.req)
+			require.Equal(t, tt.err, err)
 		})
 	}
 }
@@ -0,0 +1,188 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/chat-server/internal/broker"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/repository"
+	repoMocks "github.com/mikhailsoldatkin/chat-server/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/chat"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/chat/model"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscribe(t *testing.T) {
+	t.Parallel()
+	type chatRepoMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		userID  = gofakeit.Int64()
+		chatID  = gofakeit.Int64()
+		otherID = chatID + 1
+
+		notMemberErr = customerrors.NewUserNotInChatError(userID, otherID)
+	)
+
+	tests := []struct {
+		name         string
+		chatIDs      []int64
+		err          error
+		chatRepoMock chatRepoMockFunc
+	}{
+		{
+			name:    "success case",
+			chatIDs: []int64{chatID},
+			err:     nil,
+			chatRepoMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsUserInChatMock.Expect(ctx, userID, chatID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:    "not a member of one of the chats",
+			chatIDs: []int64{chatID, otherID},
+			err:     notMemberErr,
+			chatRepoMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsUserInChatMock.Set(func(_ context.Context, _ int64, id int64) error {
+					if id == otherID {
+						return notMemberErr
+					}
+					return nil
+				})
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := broker.NewBroker()
+			service := chat.NewMockService(tt.chatRepoMock(mc), b)
+
+			sub := b.NewSubscription(userID, 1)
+			defer sub.Close()
+
+			err := service.Subscribe(ctx, sub, userID, tt.chatIDs)
+			require.Equal(t, tt.err, err)
+
+			b.Publish(&model.Event{Type: model.EventTyping, ChatID: chatID})
+			require.Equal(t, tt.err == nil, len(sub.Events()) == 1)
+		})
+	}
+}
+
+func TestSendMessagePublishesEvent(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		id  = gofakeit.Int64()
+		req = &pb.SendMessageRequest{
+			ChatId:   gofakeit.Int64(),
+			FromUser: gofakeit.Int64(),
+			Text:     gofakeit.Sentence(5),
+		}
+	)
+
+	chatRepoMock := repoMocks.NewChatRepositoryMock(mc)
+	chatRepoMock.SendMessageMock.Expect(ctx, req, model.SenderUser).Return(id, nil)
+
+	b := broker.NewBroker()
+	sub := b.NewSubscription(req.GetFromUser(), 1)
+	defer sub.Close()
+	sub.Subscribe(req.GetChatId())
+
+	service := chat.NewMockService(chatRepoMock, b)
+
+	_, err := service.SendMessage(ctx, req)
+	require.NoError(t, err)
+
+	event := <-sub.Events()
+	require.Equal(t, model.EventMessage, event.Type)
+	require.Equal(t, id, event.Message.ID)
+	require.Equal(t, req.GetText(), event.Message.Text)
+	require.Equal(t, model.SenderUser, event.Message.SenderType)
+	require.Equal(t, req.GetFromUser(), event.UserID)
+}
+
+func TestPublishEvent(t *testing.T) {
+	t.Parallel()
+	type chatRepoMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		userID = gofakeit.Int64()
+		chatID = gofakeit.Int64()
+
+		notMemberErr = customerrors.NewUserNotInChatError(userID, chatID)
+	)
+
+	tests := []struct {
+		name         string
+		event        *model.Event
+		err          error
+		chatRepoMock chatRepoMockFunc
+	}{
+		{
+			name:  "typing",
+			event: &model.Event{Type: model.EventTyping, ChatID: chatID, UserID: userID},
+			err:   nil,
+			chatRepoMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsUserInChatMock.Expect(ctx, userID, chatID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:  "not a member",
+			event: &model.Event{Type: model.EventRead, ChatID: chatID, UserID: userID, MessageID: gofakeit.Int64()},
+			err:   notMemberErr,
+			chatRepoMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsUserInChatMock.Expect(ctx, userID, chatID).Return(notMemberErr)
+				return mock
+			},
+		},
+		{
+			name:  "message event",
+			event: &model.Event{Type: model.EventMessage, ChatID: chatID, UserID: userID},
+			err:   customerrors.NewInvalidArgumentError("only typing and read events can be published"),
+			chatRepoMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return repoMocks.NewChatRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := broker.NewBroker()
+			sub := b.NewSubscription(userID, 1)
+			defer sub.Close()
+			sub.Subscribe(chatID)
+
+			service := chat.NewMockService(tt.chatRepoMock(mc), b)
+
+			err := service.PublishEvent(ctx, tt.event)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.err == nil, len(sub.Events()) == 1)
+		})
+	}
+}
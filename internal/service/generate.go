@@ -2,3 +2,5 @@ package service
 
 //go:generate sh -c "rm -rf mocks && mkdir -p mocks"
 //go:generate minimock -i ChatService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i ReportService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i APIKeyService -o ./mocks/ -s "_minimock.go"
@@ -0,0 +1,1478 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/mikhailsoldatkin/chat-server/internal/service.APIKeyService -o api_key_service_minimock.go -n APIKeyServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	apiKeyModel "github.com/mikhailsoldatkin/chat-server/internal/service/apikey/model"
+)
+
+// APIKeyServiceMock implements service.APIKeyService
+type APIKeyServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAuthenticate          func(ctx context.Context, secret string, method string, chatID int64) (ap1 *apiKeyModel.APIKey, err error)
+	inspectFuncAuthenticate   func(ctx context.Context, secret string, method string, chatID int64)
+	afterAuthenticateCounter  uint64
+	beforeAuthenticateCounter uint64
+	AuthenticateMock          mAPIKeyServiceMockAuthenticate
+
+	funcCreateAPIKey          func(ctx context.Context, key *apiKeyModel.APIKey) (i1 int64, s1 string, err error)
+	inspectFuncCreateAPIKey   func(ctx context.Context, key *apiKeyModel.APIKey)
+	afterCreateAPIKeyCounter  uint64
+	beforeCreateAPIKeyCounter uint64
+	CreateAPIKeyMock          mAPIKeyServiceMockCreateAPIKey
+
+	funcListAPIKeys          func(ctx context.Context, limit int64, offset int64) (apa1 []*apiKeyModel.APIKey, err error)
+	inspectFuncListAPIKeys   func(ctx context.Context, limit int64, offset int64)
+	afterListAPIKeysCounter  uint64
+	beforeListAPIKeysCounter uint64
+	ListAPIKeysMock          mAPIKeyServiceMockListAPIKeys
+
+	funcRevokeAPIKey          func(ctx context.Context, id int64) (err error)
+	inspectFuncRevokeAPIKey   func(ctx context.Context, id int64)
+	afterRevokeAPIKeyCounter  uint64
+	beforeRevokeAPIKeyCounter uint64
+	RevokeAPIKeyMock          mAPIKeyServiceMockRevokeAPIKey
+}
+
+// NewAPIKeyServiceMock returns a mock for service.APIKeyService
+func NewAPIKeyServiceMock(t minimock.Tester) *APIKeyServiceMock {
+	m := &APIKeyServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AuthenticateMock = mAPIKeyServiceMockAuthenticate{mock: m}
+	m.AuthenticateMock.callArgs = []*APIKeyServiceMockAuthenticateParams{}
+
+	m.CreateAPIKeyMock = mAPIKeyServiceMockCreateAPIKey{mock: m}
+	m.CreateAPIKeyMock.callArgs = []*APIKeyServiceMockCreateAPIKeyParams{}
+
+	m.ListAPIKeysMock = mAPIKeyServiceMockListAPIKeys{mock: m}
+	m.ListAPIKeysMock.callArgs = []*APIKeyServiceMockListAPIKeysParams{}
+
+	m.RevokeAPIKeyMock = mAPIKeyServiceMockRevokeAPIKey{mock: m}
+	m.RevokeAPIKeyMock.callArgs = []*APIKeyServiceMockRevokeAPIKeyParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAPIKeyServiceMockAuthenticate struct {
+	optional           bool
+	mock               *APIKeyServiceMock
+	defaultExpectation *APIKeyServiceMockAuthenticateExpectation
+	expectations       []*APIKeyServiceMockAuthenticateExpectation
+
+	callArgs []*APIKeyServiceMockAuthenticateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// APIKeyServiceMockAuthenticateExpectation specifies expectation struct of the APIKeyService.Authenticate
+type APIKeyServiceMockAuthenticateExpectation struct {
+	mock      *APIKeyServiceMock
+	params    *APIKeyServiceMockAuthenticateParams
+	paramPtrs *APIKeyServiceMockAuthenticateParamPtrs
+	results   *APIKeyServiceMockAuthenticateResults
+	Counter   uint64
+}
+
+// APIKeyServiceMockAuthenticateParams contains parameters of the APIKeyService.Authenticate
+type APIKeyServiceMockAuthenticateParams struct {
+	ctx    context.Context
+	secret string
+	method string
+	chatID int64
+}
+
+// APIKeyServiceMockAuthenticateParamPtrs contains pointers to parameters of the APIKeyService.Authenticate
+type APIKeyServiceMockAuthenticateParamPtrs struct {
+	ctx    *context.Context
+	secret *string
+	method *string
+	chatID *int64
+}
+
+// APIKeyServiceMockAuthenticateResults contains results of the APIKeyService.Authenticate
+type APIKeyServiceMockAuthenticateResults struct {
+	ap1 *apiKeyModel.APIKey
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAuthenticate *mAPIKeyServiceMockAuthenticate) Optional() *mAPIKeyServiceMockAuthenticate {
+	mmAuthenticate.optional = true
+	return mmAuthenticate
+}
+
+// Expect sets up expected params for APIKeyService.Authenticate
+func (mmAuthenticate *mAPIKeyServiceMockAuthenticate) Expect(ctx context.Context, secret string, method string, chatID int64) *mAPIKeyServiceMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("APIKeyServiceMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &APIKeyServiceMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs != nil {
+		mmAuthenticate.mock.t.Fatalf("APIKeyServiceMock.Authenticate mock is already set by ExpectParams functions")
+	}
+
+	mmAuthenticate.defaultExpectation.params = &APIKeyServiceMockAuthenticateParams{ctx, secret, method, chatID}
+	for _, e := range mmAuthenticate.expectations {
+		if minimock.Equal(e.params, mmAuthenticate.defaultExpectation.params) {
+			mmAuthenticate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAuthenticate.defaultExpectation.params)
+		}
+	}
+
+	return mmAuthenticate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for APIKeyService.Authenticate
+func (mmAuthenticate *mAPIKeyServiceMockAuthenticate) ExpectCtxParam1(ctx context.Context) *mAPIKeyServiceMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("APIKeyServiceMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &APIKeyServiceMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.params != nil {
+		mmAuthenticate.mock.t.Fatalf("APIKeyServiceMock.Authenticate mock is already set by Expect")
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs == nil {
+		mmAuthenticate.defaultExpectation.paramPtrs = &APIKeyServiceMockAuthenticateParamPtrs{}
+	}
+	mmAuthenticate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmAuthenticate
+}
+
+// ExpectSecretParam2 sets up expected param secret for APIKeyService.Authenticate
+func (mmAuthenticate *mAPIKeyServiceMockAuthenticate) ExpectSecretParam2(secret string) *mAPIKeyServiceMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("APIKeyServiceMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &APIKeyServiceMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.params != nil {
+		mmAuthenticate.mock.t.Fatalf("APIKeyServiceMock.Authenticate mock is already set by Expect")
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs == nil {
+		mmAuthenticate.defaultExpectation.paramPtrs = &APIKeyServiceMockAuthenticateParamPtrs{}
+	}
+	mmAuthenticate.defaultExpectation.paramPtrs.secret = &secret
+
+	return mmAuthenticate
+}
+
+// ExpectMethodParam3 sets up expected param method for APIKeyService.Authenticate
+func (mmAuthenticate *mAPIKeyServiceMockAuthenticate) ExpectMethodParam3(method string) *mAPIKeyServiceMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("APIKeyServiceMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &APIKeyServiceMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.params != nil {
+		mmAuthenticate.mock.t.Fatalf("APIKeyServiceMock.Authenticate mock is already set by Expect")
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs == nil {
+		mmAuthenticate.defaultExpectation.paramPtrs = &APIKeyServiceMockAuthenticateParamPtrs{}
+	}
+	mmAuthenticate.defaultExpectation.paramPtrs.method = &method
+
+	return mmAuthenticate
+}
+
+// ExpectChatIDParam4 sets up expected param chatID for APIKeyService.Authenticate
+func (mmAuthenticate *mAPIKeyServiceMockAuthenticate) ExpectChatIDParam4(chatID int64) *mAPIKeyServiceMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("APIKeyServiceMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &APIKeyServiceMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.params != nil {
+		mmAuthenticate.mock.t.Fatalf("APIKeyServiceMock.Authenticate mock is already set by Expect")
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs == nil {
+		mmAuthenticate.defaultExpectation.paramPtrs = &APIKeyServiceMockAuthenticateParamPtrs{}
+	}
+	mmAuthenticate.defaultExpectation.paramPtrs.chatID = &chatID
+
+	return mmAuthenticate
+}
+
+// Inspect accepts an inspector function that has same arguments as the APIKeyService.Authenticate
+func (mmAuthenticate *mAPIKeyServiceMockAuthenticate) Inspect(f func(ctx context.Context, secret string, method string, chatID int64)) *mAPIKeyServiceMockAuthenticate {
+	if mmAuthenticate.mock.inspectFuncAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("Inspect function is already set for APIKeyServiceMock.Authenticate")
+	}
+
+	mmAuthenticate.mock.inspectFuncAuthenticate = f
+
+	return mmAuthenticate
+}
+
+// Return sets up results that will be returned by APIKeyService.Authenticate
+func (mmAuthenticate *mAPIKeyServiceMockAuthenticate) Return(ap1 *apiKeyModel.APIKey, err error) *APIKeyServiceMock {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("APIKeyServiceMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &APIKeyServiceMockAuthenticateExpectation{mock: mmAuthenticate.mock}
+	}
+	mmAuthenticate.defaultExpectation.results = &APIKeyServiceMockAuthenticateResults{ap1, err}
+	return mmAuthenticate.mock
+}
+
+// Set uses given function f to mock the APIKeyService.Authenticate method
+func (mmAuthenticate *mAPIKeyServiceMockAuthenticate) Set(f func(ctx context.Context, secret string, method string, chatID int64) (ap1 *apiKeyModel.APIKey, err error)) *APIKeyServiceMock {
+	if mmAuthenticate.defaultExpectation != nil {
+		mmAuthenticate.mock.t.Fatalf("Default expectation is already set for the APIKeyService.Authenticate method")
+	}
+
+	if len(mmAuthenticate.expectations) > 0 {
+		mmAuthenticate.mock.t.Fatalf("Some expectations are already set for the APIKeyService.Authenticate method")
+	}
+
+	mmAuthenticate.mock.funcAuthenticate = f
+	return mmAuthenticate.mock
+}
+
+// When sets expectation for the APIKeyService.Authenticate which will trigger the result defined by the following
+// Then helper
+func (mmAuthenticate *mAPIKeyServiceMockAuthenticate) When(ctx context.Context, secret string, method string, chatID int64) *APIKeyServiceMockAuthenticateExpectation {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("APIKeyServiceMock.Authenticate mock is already set by Set")
+	}
+
+	expectation := &APIKeyServiceMockAuthenticateExpectation{
+		mock:   mmAuthenticate.mock,
+		params: &APIKeyServiceMockAuthenticateParams{ctx, secret, method, chatID},
+	}
+	mmAuthenticate.expectations = append(mmAuthenticate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up APIKeyService.Authenticate return parameters for the expectation previously defined by the When method
+func (e *APIKeyServiceMockAuthenticateExpectation) Then(ap1 *apiKeyModel.APIKey, err error) *APIKeyServiceMock {
+	e.results = &APIKeyServiceMockAuthenticateResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times APIKeyService.Authenticate should be invoked
+func (mmAuthenticate *mAPIKeyServiceMockAuthenticate) Times(n uint64) *mAPIKeyServiceMockAuthenticate {
+	if n == 0 {
+		mmAuthenticate.mock.t.Fatalf("Times of APIKeyServiceMock.Authenticate mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAuthenticate.expectedInvocations, n)
+	return mmAuthenticate
+}
+
+func (mmAuthenticate *mAPIKeyServiceMockAuthenticate) invocationsDone() bool {
+	if len(mmAuthenticate.expectations) == 0 && mmAuthenticate.defaultExpectation == nil && mmAuthenticate.mock.funcAuthenticate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAuthenticate.mock.afterAuthenticateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAuthenticate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Authenticate implements service.APIKeyService
+func (mmAuthenticate *APIKeyServiceMock) Authenticate(ctx context.Context, secret string, method string, chatID int64) (ap1 *apiKeyModel.APIKey, err error) {
+	mm_atomic.AddUint64(&mmAuthenticate.beforeAuthenticateCounter, 1)
+	defer mm_atomic.AddUint64(&mmAuthenticate.afterAuthenticateCounter, 1)
+
+	if mmAuthenticate.inspectFuncAuthenticate != nil {
+		mmAuthenticate.inspectFuncAuthenticate(ctx, secret, method, chatID)
+	}
+
+	mm_params := APIKeyServiceMockAuthenticateParams{ctx, secret, method, chatID}
+
+	// Record call args
+	mmAuthenticate.AuthenticateMock.mutex.Lock()
+	mmAuthenticate.AuthenticateMock.callArgs = append(mmAuthenticate.AuthenticateMock.callArgs, &mm_params)
+	mmAuthenticate.AuthenticateMock.mutex.Unlock()
+
+	for _, e := range mmAuthenticate.AuthenticateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmAuthenticate.AuthenticateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAuthenticate.AuthenticateMock.defaultExpectation.Counter, 1)
+		mm_want := mmAuthenticate.AuthenticateMock.defaultExpectation.params
+		mm_want_ptrs := mmAuthenticate.AuthenticateMock.defaultExpectation.paramPtrs
+
+		mm_got := APIKeyServiceMockAuthenticateParams{ctx, secret, method, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAuthenticate.t.Errorf("APIKeyServiceMock.Authenticate got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.secret != nil && !minimock.Equal(*mm_want_ptrs.secret, mm_got.secret) {
+				mmAuthenticate.t.Errorf("APIKeyServiceMock.Authenticate got unexpected parameter secret, want: %#v, got: %#v%s\n", *mm_want_ptrs.secret, mm_got.secret, minimock.Diff(*mm_want_ptrs.secret, mm_got.secret))
+			}
+
+			if mm_want_ptrs.method != nil && !minimock.Equal(*mm_want_ptrs.method, mm_got.method) {
+				mmAuthenticate.t.Errorf("APIKeyServiceMock.Authenticate got unexpected parameter method, want: %#v, got: %#v%s\n", *mm_want_ptrs.method, mm_got.method, minimock.Diff(*mm_want_ptrs.method, mm_got.method))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmAuthenticate.t.Errorf("APIKeyServiceMock.Authenticate got unexpected parameter chatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAuthenticate.t.Errorf("APIKeyServiceMock.Authenticate got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAuthenticate.AuthenticateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAuthenticate.t.Fatal("No results are set for the APIKeyServiceMock.Authenticate")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmAuthenticate.funcAuthenticate != nil {
+		return mmAuthenticate.funcAuthenticate(ctx, secret, method, chatID)
+	}
+	mmAuthenticate.t.Fatalf("Unexpected call to APIKeyServiceMock.Authenticate. %v %v %v %v", ctx, secret, method, chatID)
+	return
+}
+
+// AuthenticateAfterCounter returns a count of finished APIKeyServiceMock.Authenticate invocations
+func (mmAuthenticate *APIKeyServiceMock) AuthenticateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthenticate.afterAuthenticateCounter)
+}
+
+// AuthenticateBeforeCounter returns a count of APIKeyServiceMock.Authenticate invocations
+func (mmAuthenticate *APIKeyServiceMock) AuthenticateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthenticate.beforeAuthenticateCounter)
+}
+
+// Calls returns a list of arguments used in each call to APIKeyServiceMock.Authenticate.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAuthenticate *mAPIKeyServiceMockAuthenticate) Calls() []*APIKeyServiceMockAuthenticateParams {
+	mmAuthenticate.mutex.RLock()
+
+	argCopy := make([]*APIKeyServiceMockAuthenticateParams, len(mmAuthenticate.callArgs))
+	copy(argCopy, mmAuthenticate.callArgs)
+
+	mmAuthenticate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAuthenticateDone returns true if the count of the Authenticate invocations corresponds
+// the number of defined expectations
+func (m *APIKeyServiceMock) MinimockAuthenticateDone() bool {
+	if m.AuthenticateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AuthenticateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AuthenticateMock.invocationsDone()
+}
+
+// MinimockAuthenticateInspect logs each unmet expectation
+func (m *APIKeyServiceMock) MinimockAuthenticateInspect() {
+	for _, e := range m.AuthenticateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to APIKeyServiceMock.Authenticate with params: %#v", *e.params)
+		}
+	}
+
+	afterAuthenticateCounter := mm_atomic.LoadUint64(&m.afterAuthenticateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AuthenticateMock.defaultExpectation != nil && afterAuthenticateCounter < 1 {
+		if m.AuthenticateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to APIKeyServiceMock.Authenticate")
+		} else {
+			m.t.Errorf("Expected call to APIKeyServiceMock.Authenticate with params: %#v", *m.AuthenticateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAuthenticate != nil && afterAuthenticateCounter < 1 {
+		m.t.Error("Expected call to APIKeyServiceMock.Authenticate")
+	}
+
+	if !m.AuthenticateMock.invocationsDone() && afterAuthenticateCounter > 0 {
+		m.t.Errorf("Expected %d calls to APIKeyServiceMock.Authenticate but found %d calls",
+			mm_atomic.LoadUint64(&m.AuthenticateMock.expectedInvocations), afterAuthenticateCounter)
+	}
+}
+
+type mAPIKeyServiceMockCreateAPIKey struct {
+	optional           bool
+	mock               *APIKeyServiceMock
+	defaultExpectation *APIKeyServiceMockCreateAPIKeyExpectation
+	expectations       []*APIKeyServiceMockCreateAPIKeyExpectation
+
+	callArgs []*APIKeyServiceMockCreateAPIKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// APIKeyServiceMockCreateAPIKeyExpectation specifies expectation struct of the APIKeyService.CreateAPIKey
+type APIKeyServiceMockCreateAPIKeyExpectation struct {
+	mock      *APIKeyServiceMock
+	params    *APIKeyServiceMockCreateAPIKeyParams
+	paramPtrs *APIKeyServiceMockCreateAPIKeyParamPtrs
+	results   *APIKeyServiceMockCreateAPIKeyResults
+	Counter   uint64
+}
+
+// APIKeyServiceMockCreateAPIKeyParams contains parameters of the APIKeyService.CreateAPIKey
+type APIKeyServiceMockCreateAPIKeyParams struct {
+	ctx context.Context
+	key *apiKeyModel.APIKey
+}
+
+// APIKeyServiceMockCreateAPIKeyParamPtrs contains pointers to parameters of the APIKeyService.CreateAPIKey
+type APIKeyServiceMockCreateAPIKeyParamPtrs struct {
+	ctx *context.Context
+	key **apiKeyModel.APIKey
+}
+
+// APIKeyServiceMockCreateAPIKeyResults contains results of the APIKeyService.CreateAPIKey
+type APIKeyServiceMockCreateAPIKeyResults struct {
+	i1  int64
+	s1  string
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateAPIKey *mAPIKeyServiceMockCreateAPIKey) Optional() *mAPIKeyServiceMockCreateAPIKey {
+	mmCreateAPIKey.optional = true
+	return mmCreateAPIKey
+}
+
+// Expect sets up expected params for APIKeyService.CreateAPIKey
+func (mmCreateAPIKey *mAPIKeyServiceMockCreateAPIKey) Expect(ctx context.Context, key *apiKeyModel.APIKey) *mAPIKeyServiceMockCreateAPIKey {
+	if mmCreateAPIKey.mock.funcCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("APIKeyServiceMock.CreateAPIKey mock is already set by Set")
+	}
+
+	if mmCreateAPIKey.defaultExpectation == nil {
+		mmCreateAPIKey.defaultExpectation = &APIKeyServiceMockCreateAPIKeyExpectation{}
+	}
+
+	if mmCreateAPIKey.defaultExpectation.paramPtrs != nil {
+		mmCreateAPIKey.mock.t.Fatalf("APIKeyServiceMock.CreateAPIKey mock is already set by ExpectParams functions")
+	}
+
+	mmCreateAPIKey.defaultExpectation.params = &APIKeyServiceMockCreateAPIKeyParams{ctx, key}
+	for _, e := range mmCreateAPIKey.expectations {
+		if minimock.Equal(e.params, mmCreateAPIKey.defaultExpectation.params) {
+			mmCreateAPIKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateAPIKey.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateAPIKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for APIKeyService.CreateAPIKey
+func (mmCreateAPIKey *mAPIKeyServiceMockCreateAPIKey) ExpectCtxParam1(ctx context.Context) *mAPIKeyServiceMockCreateAPIKey {
+	if mmCreateAPIKey.mock.funcCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("APIKeyServiceMock.CreateAPIKey mock is already set by Set")
+	}
+
+	if mmCreateAPIKey.defaultExpectation == nil {
+		mmCreateAPIKey.defaultExpectation = &APIKeyServiceMockCreateAPIKeyExpectation{}
+	}
+
+	if mmCreateAPIKey.defaultExpectation.params != nil {
+		mmCreateAPIKey.mock.t.Fatalf("APIKeyServiceMock.CreateAPIKey mock is already set by Expect")
+	}
+
+	if mmCreateAPIKey.defaultExpectation.paramPtrs == nil {
+		mmCreateAPIKey.defaultExpectation.paramPtrs = &APIKeyServiceMockCreateAPIKeyParamPtrs{}
+	}
+	mmCreateAPIKey.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateAPIKey
+}
+
+// ExpectKeyParam2 sets up expected param key for APIKeyService.CreateAPIKey
+func (mmCreateAPIKey *mAPIKeyServiceMockCreateAPIKey) ExpectKeyParam2(key *apiKeyModel.APIKey) *mAPIKeyServiceMockCreateAPIKey {
+	if mmCreateAPIKey.mock.funcCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("APIKeyServiceMock.CreateAPIKey mock is already set by Set")
+	}
+
+	if mmCreateAPIKey.defaultExpectation == nil {
+		mmCreateAPIKey.defaultExpectation = &APIKeyServiceMockCreateAPIKeyExpectation{}
+	}
+
+	if mmCreateAPIKey.defaultExpectation.params != nil {
+		mmCreateAPIKey.mock.t.Fatalf("APIKeyServiceMock.CreateAPIKey mock is already set by Expect")
+	}
+
+	if mmCreateAPIKey.defaultExpectation.paramPtrs == nil {
+		mmCreateAPIKey.defaultExpectation.paramPtrs = &APIKeyServiceMockCreateAPIKeyParamPtrs{}
+	}
+	mmCreateAPIKey.defaultExpectation.paramPtrs.key = &key
+
+	return mmCreateAPIKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the APIKeyService.CreateAPIKey
+func (mmCreateAPIKey *mAPIKeyServiceMockCreateAPIKey) Inspect(f func(ctx context.Context, key *apiKeyModel.APIKey)) *mAPIKeyServiceMockCreateAPIKey {
+	if mmCreateAPIKey.mock.inspectFuncCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("Inspect function is already set for APIKeyServiceMock.CreateAPIKey")
+	}
+
+	mmCreateAPIKey.mock.inspectFuncCreateAPIKey = f
+
+	return mmCreateAPIKey
+}
+
+// Return sets up results that will be returned by APIKeyService.CreateAPIKey
+func (mmCreateAPIKey *mAPIKeyServiceMockCreateAPIKey) Return(i1 int64, s1 string, err error) *APIKeyServiceMock {
+	if mmCreateAPIKey.mock.funcCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("APIKeyServiceMock.CreateAPIKey mock is already set by Set")
+	}
+
+	if mmCreateAPIKey.defaultExpectation == nil {
+		mmCreateAPIKey.defaultExpectation = &APIKeyServiceMockCreateAPIKeyExpectation{mock: mmCreateAPIKey.mock}
+	}
+	mmCreateAPIKey.defaultExpectation.results = &APIKeyServiceMockCreateAPIKeyResults{i1, s1, err}
+	return mmCreateAPIKey.mock
+}
+
+// Set uses given function f to mock the APIKeyService.CreateAPIKey method
+func (mmCreateAPIKey *mAPIKeyServiceMockCreateAPIKey) Set(f func(ctx context.Context, key *apiKeyModel.APIKey) (i1 int64, s1 string, err error)) *APIKeyServiceMock {
+	if mmCreateAPIKey.defaultExpectation != nil {
+		mmCreateAPIKey.mock.t.Fatalf("Default expectation is already set for the APIKeyService.CreateAPIKey method")
+	}
+
+	if len(mmCreateAPIKey.expectations) > 0 {
+		mmCreateAPIKey.mock.t.Fatalf("Some expectations are already set for the APIKeyService.CreateAPIKey method")
+	}
+
+	mmCreateAPIKey.mock.funcCreateAPIKey = f
+	return mmCreateAPIKey.mock
+}
+
+// When sets expectation for the APIKeyService.CreateAPIKey which will trigger the result defined by the following
+// Then helper
+func (mmCreateAPIKey *mAPIKeyServiceMockCreateAPIKey) When(ctx context.Context, key *apiKeyModel.APIKey) *APIKeyServiceMockCreateAPIKeyExpectation {
+	if mmCreateAPIKey.mock.funcCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("APIKeyServiceMock.CreateAPIKey mock is already set by Set")
+	}
+
+	expectation := &APIKeyServiceMockCreateAPIKeyExpectation{
+		mock:   mmCreateAPIKey.mock,
+		params: &APIKeyServiceMockCreateAPIKeyParams{ctx, key},
+	}
+	mmCreateAPIKey.expectations = append(mmCreateAPIKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up APIKeyService.CreateAPIKey return parameters for the expectation previously defined by the When method
+func (e *APIKeyServiceMockCreateAPIKeyExpectation) Then(i1 int64, s1 string, err error) *APIKeyServiceMock {
+	e.results = &APIKeyServiceMockCreateAPIKeyResults{i1, s1, err}
+	return e.mock
+}
+
+// Times sets number of times APIKeyService.CreateAPIKey should be invoked
+func (mmCreateAPIKey *mAPIKeyServiceMockCreateAPIKey) Times(n uint64) *mAPIKeyServiceMockCreateAPIKey {
+	if n == 0 {
+		mmCreateAPIKey.mock.t.Fatalf("Times of APIKeyServiceMock.CreateAPIKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateAPIKey.expectedInvocations, n)
+	return mmCreateAPIKey
+}
+
+func (mmCreateAPIKey *mAPIKeyServiceMockCreateAPIKey) invocationsDone() bool {
+	if len(mmCreateAPIKey.expectations) == 0 && mmCreateAPIKey.defaultExpectation == nil && mmCreateAPIKey.mock.funcCreateAPIKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateAPIKey.mock.afterCreateAPIKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateAPIKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateAPIKey implements service.APIKeyService
+func (mmCreateAPIKey *APIKeyServiceMock) CreateAPIKey(ctx context.Context, key *apiKeyModel.APIKey) (i1 int64, s1 string, err error) {
+	mm_atomic.AddUint64(&mmCreateAPIKey.beforeCreateAPIKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateAPIKey.afterCreateAPIKeyCounter, 1)
+
+	if mmCreateAPIKey.inspectFuncCreateAPIKey != nil {
+		mmCreateAPIKey.inspectFuncCreateAPIKey(ctx, key)
+	}
+
+	mm_params := APIKeyServiceMockCreateAPIKeyParams{ctx, key}
+
+	// Record call args
+	mmCreateAPIKey.CreateAPIKeyMock.mutex.Lock()
+	mmCreateAPIKey.CreateAPIKeyMock.callArgs = append(mmCreateAPIKey.CreateAPIKeyMock.callArgs, &mm_params)
+	mmCreateAPIKey.CreateAPIKeyMock.mutex.Unlock()
+
+	for _, e := range mmCreateAPIKey.CreateAPIKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.s1, e.results.err
+		}
+	}
+
+	if mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := APIKeyServiceMockCreateAPIKeyParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateAPIKey.t.Errorf("APIKeyServiceMock.CreateAPIKey got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmCreateAPIKey.t.Errorf("APIKeyServiceMock.CreateAPIKey got unexpected parameter key, want: %#v, got: %#v%s\n", *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateAPIKey.t.Errorf("APIKeyServiceMock.CreateAPIKey got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateAPIKey.t.Fatal("No results are set for the APIKeyServiceMock.CreateAPIKey")
+		}
+		return (*mm_results).i1, (*mm_results).s1, (*mm_results).err
+	}
+	if mmCreateAPIKey.funcCreateAPIKey != nil {
+		return mmCreateAPIKey.funcCreateAPIKey(ctx, key)
+	}
+	mmCreateAPIKey.t.Fatalf("Unexpected call to APIKeyServiceMock.CreateAPIKey. %v %v", ctx, key)
+	return
+}
+
+// CreateAPIKeyAfterCounter returns a count of finished APIKeyServiceMock.CreateAPIKey invocations
+func (mmCreateAPIKey *APIKeyServiceMock) CreateAPIKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAPIKey.afterCreateAPIKeyCounter)
+}
+
+// CreateAPIKeyBeforeCounter returns a count of APIKeyServiceMock.CreateAPIKey invocations
+func (mmCreateAPIKey *APIKeyServiceMock) CreateAPIKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAPIKey.beforeCreateAPIKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to APIKeyServiceMock.CreateAPIKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateAPIKey *mAPIKeyServiceMockCreateAPIKey) Calls() []*APIKeyServiceMockCreateAPIKeyParams {
+	mmCreateAPIKey.mutex.RLock()
+
+	argCopy := make([]*APIKeyServiceMockCreateAPIKeyParams, len(mmCreateAPIKey.callArgs))
+	copy(argCopy, mmCreateAPIKey.callArgs)
+
+	mmCreateAPIKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateAPIKeyDone returns true if the count of the CreateAPIKey invocations corresponds
+// the number of defined expectations
+func (m *APIKeyServiceMock) MinimockCreateAPIKeyDone() bool {
+	if m.CreateAPIKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateAPIKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateAPIKeyMock.invocationsDone()
+}
+
+// MinimockCreateAPIKeyInspect logs each unmet expectation
+func (m *APIKeyServiceMock) MinimockCreateAPIKeyInspect() {
+	for _, e := range m.CreateAPIKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to APIKeyServiceMock.CreateAPIKey with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateAPIKeyCounter := mm_atomic.LoadUint64(&m.afterCreateAPIKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateAPIKeyMock.defaultExpectation != nil && afterCreateAPIKeyCounter < 1 {
+		if m.CreateAPIKeyMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to APIKeyServiceMock.CreateAPIKey")
+		} else {
+			m.t.Errorf("Expected call to APIKeyServiceMock.CreateAPIKey with params: %#v", *m.CreateAPIKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateAPIKey != nil && afterCreateAPIKeyCounter < 1 {
+		m.t.Error("Expected call to APIKeyServiceMock.CreateAPIKey")
+	}
+
+	if !m.CreateAPIKeyMock.invocationsDone() && afterCreateAPIKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to APIKeyServiceMock.CreateAPIKey but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateAPIKeyMock.expectedInvocations), afterCreateAPIKeyCounter)
+	}
+}
+
+type mAPIKeyServiceMockListAPIKeys struct {
+	optional           bool
+	mock               *APIKeyServiceMock
+	defaultExpectation *APIKeyServiceMockListAPIKeysExpectation
+	expectations       []*APIKeyServiceMockListAPIKeysExpectation
+
+	callArgs []*APIKeyServiceMockListAPIKeysParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// APIKeyServiceMockListAPIKeysExpectation specifies expectation struct of the APIKeyService.ListAPIKeys
+type APIKeyServiceMockListAPIKeysExpectation struct {
+	mock      *APIKeyServiceMock
+	params    *APIKeyServiceMockListAPIKeysParams
+	paramPtrs *APIKeyServiceMockListAPIKeysParamPtrs
+	results   *APIKeyServiceMockListAPIKeysResults
+	Counter   uint64
+}
+
+// APIKeyServiceMockListAPIKeysParams contains parameters of the APIKeyService.ListAPIKeys
+type APIKeyServiceMockListAPIKeysParams struct {
+	ctx    context.Context
+	limit  int64
+	offset int64
+}
+
+// APIKeyServiceMockListAPIKeysParamPtrs contains pointers to parameters of the APIKeyService.ListAPIKeys
+type APIKeyServiceMockListAPIKeysParamPtrs struct {
+	ctx    *context.Context
+	limit  *int64
+	offset *int64
+}
+
+// APIKeyServiceMockListAPIKeysResults contains results of the APIKeyService.ListAPIKeys
+type APIKeyServiceMockListAPIKeysResults struct {
+	apa1 []*apiKeyModel.APIKey
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListAPIKeys *mAPIKeyServiceMockListAPIKeys) Optional() *mAPIKeyServiceMockListAPIKeys {
+	mmListAPIKeys.optional = true
+	return mmListAPIKeys
+}
+
+// Expect sets up expected params for APIKeyService.ListAPIKeys
+func (mmListAPIKeys *mAPIKeyServiceMockListAPIKeys) Expect(ctx context.Context, limit int64, offset int64) *mAPIKeyServiceMockListAPIKeys {
+	if mmListAPIKeys.mock.funcListAPIKeys != nil {
+		mmListAPIKeys.mock.t.Fatalf("APIKeyServiceMock.ListAPIKeys mock is already set by Set")
+	}
+
+	if mmListAPIKeys.defaultExpectation == nil {
+		mmListAPIKeys.defaultExpectation = &APIKeyServiceMockListAPIKeysExpectation{}
+	}
+
+	if mmListAPIKeys.defaultExpectation.paramPtrs != nil {
+		mmListAPIKeys.mock.t.Fatalf("APIKeyServiceMock.ListAPIKeys mock is already set by ExpectParams functions")
+	}
+
+	mmListAPIKeys.defaultExpectation.params = &APIKeyServiceMockListAPIKeysParams{ctx, limit, offset}
+	for _, e := range mmListAPIKeys.expectations {
+		if minimock.Equal(e.params, mmListAPIKeys.defaultExpectation.params) {
+			mmListAPIKeys.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAPIKeys.defaultExpectation.params)
+		}
+	}
+
+	return mmListAPIKeys
+}
+
+// ExpectCtxParam1 sets up expected param ctx for APIKeyService.ListAPIKeys
+func (mmListAPIKeys *mAPIKeyServiceMockListAPIKeys) ExpectCtxParam1(ctx context.Context) *mAPIKeyServiceMockListAPIKeys {
+	if mmListAPIKeys.mock.funcListAPIKeys != nil {
+		mmListAPIKeys.mock.t.Fatalf("APIKeyServiceMock.ListAPIKeys mock is already set by Set")
+	}
+
+	if mmListAPIKeys.defaultExpectation == nil {
+		mmListAPIKeys.defaultExpectation = &APIKeyServiceMockListAPIKeysExpectation{}
+	}
+
+	if mmListAPIKeys.defaultExpectation.params != nil {
+		mmListAPIKeys.mock.t.Fatalf("APIKeyServiceMock.ListAPIKeys mock is already set by Expect")
+	}
+
+	if mmListAPIKeys.defaultExpectation.paramPtrs == nil {
+		mmListAPIKeys.defaultExpectation.paramPtrs = &APIKeyServiceMockListAPIKeysParamPtrs{}
+	}
+	mmListAPIKeys.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListAPIKeys
+}
+
+// ExpectLimitParam2 sets up expected param limit for APIKeyService.ListAPIKeys
+func (mmListAPIKeys *mAPIKeyServiceMockListAPIKeys) ExpectLimitParam2(limit int64) *mAPIKeyServiceMockListAPIKeys {
+	if mmListAPIKeys.mock.funcListAPIKeys != nil {
+		mmListAPIKeys.mock.t.Fatalf("APIKeyServiceMock.ListAPIKeys mock is already set by Set")
+	}
+
+	if mmListAPIKeys.defaultExpectation == nil {
+		mmListAPIKeys.defaultExpectation = &APIKeyServiceMockListAPIKeysExpectation{}
+	}
+
+	if mmListAPIKeys.defaultExpectation.params != nil {
+		mmListAPIKeys.mock.t.Fatalf("APIKeyServiceMock.ListAPIKeys mock is already set by Expect")
+	}
+
+	if mmListAPIKeys.defaultExpectation.paramPtrs == nil {
+		mmListAPIKeys.defaultExpectation.paramPtrs = &APIKeyServiceMockListAPIKeysParamPtrs{}
+	}
+	mmListAPIKeys.defaultExpectation.paramPtrs.limit = &limit
+
+	return mmListAPIKeys
+}
+
+// ExpectOffsetParam3 sets up expected param offset for APIKeyService.ListAPIKeys
+func (mmListAPIKeys *mAPIKeyServiceMockListAPIKeys) ExpectOffsetParam3(offset int64) *mAPIKeyServiceMockListAPIKeys {
+	if mmListAPIKeys.mock.funcListAPIKeys != nil {
+		mmListAPIKeys.mock.t.Fatalf("APIKeyServiceMock.ListAPIKeys mock is already set by Set")
+	}
+
+	if mmListAPIKeys.defaultExpectation == nil {
+		mmListAPIKeys.defaultExpectation = &APIKeyServiceMockListAPIKeysExpectation{}
+	}
+
+	if mmListAPIKeys.defaultExpectation.params != nil {
+		mmListAPIKeys.mock.t.Fatalf("APIKeyServiceMock.ListAPIKeys mock is already set by Expect")
+	}
+
+	if mmListAPIKeys.defaultExpectation.paramPtrs == nil {
+		mmListAPIKeys.defaultExpectation.paramPtrs = &APIKeyServiceMockListAPIKeysParamPtrs{}
+	}
+	mmListAPIKeys.defaultExpectation.paramPtrs.offset = &offset
+
+	return mmListAPIKeys
+}
+
+// Inspect accepts an inspector function that has same arguments as the APIKeyService.ListAPIKeys
+func (mmListAPIKeys *mAPIKeyServiceMockListAPIKeys) Inspect(f func(ctx context.Context, limit int64, offset int64)) *mAPIKeyServiceMockListAPIKeys {
+	if mmListAPIKeys.mock.inspectFuncListAPIKeys != nil {
+		mmListAPIKeys.mock.t.Fatalf("Inspect function is already set for APIKeyServiceMock.ListAPIKeys")
+	}
+
+	mmListAPIKeys.mock.inspectFuncListAPIKeys = f
+
+	return mmListAPIKeys
+}
+
+// Return sets up results that will be returned by APIKeyService.ListAPIKeys
+func (mmListAPIKeys *mAPIKeyServiceMockListAPIKeys) Return(apa1 []*apiKeyModel.APIKey, err error) *APIKeyServiceMock {
+	if mmListAPIKeys.mock.funcListAPIKeys != nil {
+		mmListAPIKeys.mock.t.Fatalf("APIKeyServiceMock.ListAPIKeys mock is already set by Set")
+	}
+
+	if mmListAPIKeys.defaultExpectation == nil {
+		mmListAPIKeys.defaultExpectation = &APIKeyServiceMockListAPIKeysExpectation{mock: mmListAPIKeys.mock}
+	}
+	mmListAPIKeys.defaultExpectation.results = &APIKeyServiceMockListAPIKeysResults{apa1, err}
+	return mmListAPIKeys.mock
+}
+
+// Set uses given function f to mock the APIKeyService.ListAPIKeys method
+func (mmListAPIKeys *mAPIKeyServiceMockListAPIKeys) Set(f func(ctx context.Context, limit int64, offset int64) (apa1 []*apiKeyModel.APIKey, err error)) *APIKeyServiceMock {
+	if mmListAPIKeys.defaultExpectation != nil {
+		mmListAPIKeys.mock.t.Fatalf("Default expectation is already set for the APIKeyService.ListAPIKeys method")
+	}
+
+	if len(mmListAPIKeys.expectations) > 0 {
+		mmListAPIKeys.mock.t.Fatalf("Some expectations are already set for the APIKeyService.ListAPIKeys method")
+	}
+
+	mmListAPIKeys.mock.funcListAPIKeys = f
+	return mmListAPIKeys.mock
+}
+
+// When sets expectation for the APIKeyService.ListAPIKeys which will trigger the result defined by the following
+// Then helper
+func (mmListAPIKeys *mAPIKeyServiceMockListAPIKeys) When(ctx context.Context, limit int64, offset int64) *APIKeyServiceMockListAPIKeysExpectation {
+	if mmListAPIKeys.mock.funcListAPIKeys != nil {
+		mmListAPIKeys.mock.t.Fatalf("APIKeyServiceMock.ListAPIKeys mock is already set by Set")
+	}
+
+	expectation := &APIKeyServiceMockListAPIKeysExpectation{
+		mock:   mmListAPIKeys.mock,
+		params: &APIKeyServiceMockListAPIKeysParams{ctx, limit, offset},
+	}
+	mmListAPIKeys.expectations = append(mmListAPIKeys.expectations, expectation)
+	return expectation
+}
+
+// Then sets up APIKeyService.ListAPIKeys return parameters for the expectation previously defined by the When method
+func (e *APIKeyServiceMockListAPIKeysExpectation) Then(apa1 []*apiKeyModel.APIKey, err error) *APIKeyServiceMock {
+	e.results = &APIKeyServiceMockListAPIKeysResults{apa1, err}
+	return e.mock
+}
+
+// Times sets number of times APIKeyService.ListAPIKeys should be invoked
+func (mmListAPIKeys *mAPIKeyServiceMockListAPIKeys) Times(n uint64) *mAPIKeyServiceMockListAPIKeys {
+	if n == 0 {
+		mmListAPIKeys.mock.t.Fatalf("Times of APIKeyServiceMock.ListAPIKeys mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListAPIKeys.expectedInvocations, n)
+	return mmListAPIKeys
+}
+
+func (mmListAPIKeys *mAPIKeyServiceMockListAPIKeys) invocationsDone() bool {
+	if len(mmListAPIKeys.expectations) == 0 && mmListAPIKeys.defaultExpectation == nil && mmListAPIKeys.mock.funcListAPIKeys == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListAPIKeys.mock.afterListAPIKeysCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListAPIKeys.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListAPIKeys implements service.APIKeyService
+func (mmListAPIKeys *APIKeyServiceMock) ListAPIKeys(ctx context.Context, limit int64, offset int64) (apa1 []*apiKeyModel.APIKey, err error) {
+	mm_atomic.AddUint64(&mmListAPIKeys.beforeListAPIKeysCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAPIKeys.afterListAPIKeysCounter, 1)
+
+	if mmListAPIKeys.inspectFuncListAPIKeys != nil {
+		mmListAPIKeys.inspectFuncListAPIKeys(ctx, limit, offset)
+	}
+
+	mm_params := APIKeyServiceMockListAPIKeysParams{ctx, limit, offset}
+
+	// Record call args
+	mmListAPIKeys.ListAPIKeysMock.mutex.Lock()
+	mmListAPIKeys.ListAPIKeysMock.callArgs = append(mmListAPIKeys.ListAPIKeysMock.callArgs, &mm_params)
+	mmListAPIKeys.ListAPIKeysMock.mutex.Unlock()
+
+	for _, e := range mmListAPIKeys.ListAPIKeysMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.apa1, e.results.err
+		}
+	}
+
+	if mmListAPIKeys.ListAPIKeysMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAPIKeys.ListAPIKeysMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAPIKeys.ListAPIKeysMock.defaultExpectation.params
+		mm_want_ptrs := mmListAPIKeys.ListAPIKeysMock.defaultExpectation.paramPtrs
+
+		mm_got := APIKeyServiceMockListAPIKeysParams{ctx, limit, offset}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAPIKeys.t.Errorf("APIKeyServiceMock.ListAPIKeys got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListAPIKeys.t.Errorf("APIKeyServiceMock.ListAPIKeys got unexpected parameter limit, want: %#v, got: %#v%s\n", *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+			if mm_want_ptrs.offset != nil && !minimock.Equal(*mm_want_ptrs.offset, mm_got.offset) {
+				mmListAPIKeys.t.Errorf("APIKeyServiceMock.ListAPIKeys got unexpected parameter offset, want: %#v, got: %#v%s\n", *mm_want_ptrs.offset, mm_got.offset, minimock.Diff(*mm_want_ptrs.offset, mm_got.offset))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAPIKeys.t.Errorf("APIKeyServiceMock.ListAPIKeys got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAPIKeys.ListAPIKeysMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAPIKeys.t.Fatal("No results are set for the APIKeyServiceMock.ListAPIKeys")
+		}
+		return (*mm_results).apa1, (*mm_results).err
+	}
+	if mmListAPIKeys.funcListAPIKeys != nil {
+		return mmListAPIKeys.funcListAPIKeys(ctx, limit, offset)
+	}
+	mmListAPIKeys.t.Fatalf("Unexpected call to APIKeyServiceMock.ListAPIKeys. %v %v %v", ctx, limit, offset)
+	return
+}
+
+// ListAPIKeysAfterCounter returns a count of finished APIKeyServiceMock.ListAPIKeys invocations
+func (mmListAPIKeys *APIKeyServiceMock) ListAPIKeysAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAPIKeys.afterListAPIKeysCounter)
+}
+
+// ListAPIKeysBeforeCounter returns a count of APIKeyServiceMock.ListAPIKeys invocations
+func (mmListAPIKeys *APIKeyServiceMock) ListAPIKeysBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAPIKeys.beforeListAPIKeysCounter)
+}
+
+// Calls returns a list of arguments used in each call to APIKeyServiceMock.ListAPIKeys.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAPIKeys *mAPIKeyServiceMockListAPIKeys) Calls() []*APIKeyServiceMockListAPIKeysParams {
+	mmListAPIKeys.mutex.RLock()
+
+	argCopy := make([]*APIKeyServiceMockListAPIKeysParams, len(mmListAPIKeys.callArgs))
+	copy(argCopy, mmListAPIKeys.callArgs)
+
+	mmListAPIKeys.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAPIKeysDone returns true if the count of the ListAPIKeys invocations corresponds
+// the number of defined expectations
+func (m *APIKeyServiceMock) MinimockListAPIKeysDone() bool {
+	if m.ListAPIKeysMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListAPIKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListAPIKeysMock.invocationsDone()
+}
+
+// MinimockListAPIKeysInspect logs each unmet expectation
+func (m *APIKeyServiceMock) MinimockListAPIKeysInspect() {
+	for _, e := range m.ListAPIKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to APIKeyServiceMock.ListAPIKeys with params: %#v", *e.params)
+		}
+	}
+
+	afterListAPIKeysCounter := mm_atomic.LoadUint64(&m.afterListAPIKeysCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAPIKeysMock.defaultExpectation != nil && afterListAPIKeysCounter < 1 {
+		if m.ListAPIKeysMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to APIKeyServiceMock.ListAPIKeys")
+		} else {
+			m.t.Errorf("Expected call to APIKeyServiceMock.ListAPIKeys with params: %#v", *m.ListAPIKeysMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAPIKeys != nil && afterListAPIKeysCounter < 1 {
+		m.t.Error("Expected call to APIKeyServiceMock.ListAPIKeys")
+	}
+
+	if !m.ListAPIKeysMock.invocationsDone() && afterListAPIKeysCounter > 0 {
+		m.t.Errorf("Expected %d calls to APIKeyServiceMock.ListAPIKeys but found %d calls",
+			mm_atomic.LoadUint64(&m.ListAPIKeysMock.expectedInvocations), afterListAPIKeysCounter)
+	}
+}
+
+type mAPIKeyServiceMockRevokeAPIKey struct {
+	optional           bool
+	mock               *APIKeyServiceMock
+	defaultExpectation *APIKeyServiceMockRevokeAPIKeyExpectation
+	expectations       []*APIKeyServiceMockRevokeAPIKeyExpectation
+
+	callArgs []*APIKeyServiceMockRevokeAPIKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// APIKeyServiceMockRevokeAPIKeyExpectation specifies expectation struct of the APIKeyService.RevokeAPIKey
+type APIKeyServiceMockRevokeAPIKeyExpectation struct {
+	mock      *APIKeyServiceMock
+	params    *APIKeyServiceMockRevokeAPIKeyParams
+	paramPtrs *APIKeyServiceMockRevokeAPIKeyParamPtrs
+	results   *APIKeyServiceMockRevokeAPIKeyResults
+	Counter   uint64
+}
+
+// APIKeyServiceMockRevokeAPIKeyParams contains parameters of the APIKeyService.RevokeAPIKey
+type APIKeyServiceMockRevokeAPIKeyParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// APIKeyServiceMockRevokeAPIKeyParamPtrs contains pointers to parameters of the APIKeyService.RevokeAPIKey
+type APIKeyServiceMockRevokeAPIKeyParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// APIKeyServiceMockRevokeAPIKeyResults contains results of the APIKeyService.RevokeAPIKey
+type APIKeyServiceMockRevokeAPIKeyResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeAPIKey *mAPIKeyServiceMockRevokeAPIKey) Optional() *mAPIKeyServiceMockRevokeAPIKey {
+	mmRevokeAPIKey.optional = true
+	return mmRevokeAPIKey
+}
+
+// Expect sets up expected params for APIKeyService.RevokeAPIKey
+func (mmRevokeAPIKey *mAPIKeyServiceMockRevokeAPIKey) Expect(ctx context.Context, id int64) *mAPIKeyServiceMockRevokeAPIKey {
+	if mmRevokeAPIKey.mock.funcRevokeAPIKey != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("APIKeyServiceMock.RevokeAPIKey mock is already set by Set")
+	}
+
+	if mmRevokeAPIKey.defaultExpectation == nil {
+		mmRevokeAPIKey.defaultExpectation = &APIKeyServiceMockRevokeAPIKeyExpectation{}
+	}
+
+	if mmRevokeAPIKey.defaultExpectation.paramPtrs != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("APIKeyServiceMock.RevokeAPIKey mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeAPIKey.defaultExpectation.params = &APIKeyServiceMockRevokeAPIKeyParams{ctx, id}
+	for _, e := range mmRevokeAPIKey.expectations {
+		if minimock.Equal(e.params, mmRevokeAPIKey.defaultExpectation.params) {
+			mmRevokeAPIKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeAPIKey.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeAPIKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for APIKeyService.RevokeAPIKey
+func (mmRevokeAPIKey *mAPIKeyServiceMockRevokeAPIKey) ExpectCtxParam1(ctx context.Context) *mAPIKeyServiceMockRevokeAPIKey {
+	if mmRevokeAPIKey.mock.funcRevokeAPIKey != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("APIKeyServiceMock.RevokeAPIKey mock is already set by Set")
+	}
+
+	if mmRevokeAPIKey.defaultExpectation == nil {
+		mmRevokeAPIKey.defaultExpectation = &APIKeyServiceMockRevokeAPIKeyExpectation{}
+	}
+
+	if mmRevokeAPIKey.defaultExpectation.params != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("APIKeyServiceMock.RevokeAPIKey mock is already set by Expect")
+	}
+
+	if mmRevokeAPIKey.defaultExpectation.paramPtrs == nil {
+		mmRevokeAPIKey.defaultExpectation.paramPtrs = &APIKeyServiceMockRevokeAPIKeyParamPtrs{}
+	}
+	mmRevokeAPIKey.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRevokeAPIKey
+}
+
+// ExpectIdParam2 sets up expected param id for APIKeyService.RevokeAPIKey
+func (mmRevokeAPIKey *mAPIKeyServiceMockRevokeAPIKey) ExpectIdParam2(id int64) *mAPIKeyServiceMockRevokeAPIKey {
+	if mmRevokeAPIKey.mock.funcRevokeAPIKey != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("APIKeyServiceMock.RevokeAPIKey mock is already set by Set")
+	}
+
+	if mmRevokeAPIKey.defaultExpectation == nil {
+		mmRevokeAPIKey.defaultExpectation = &APIKeyServiceMockRevokeAPIKeyExpectation{}
+	}
+
+	if mmRevokeAPIKey.defaultExpectation.params != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("APIKeyServiceMock.RevokeAPIKey mock is already set by Expect")
+	}
+
+	if mmRevokeAPIKey.defaultExpectation.paramPtrs == nil {
+		mmRevokeAPIKey.defaultExpectation.paramPtrs = &APIKeyServiceMockRevokeAPIKeyParamPtrs{}
+	}
+	mmRevokeAPIKey.defaultExpectation.paramPtrs.id = &id
+
+	return mmRevokeAPIKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the APIKeyService.RevokeAPIKey
+func (mmRevokeAPIKey *mAPIKeyServiceMockRevokeAPIKey) Inspect(f func(ctx context.Context, id int64)) *mAPIKeyServiceMockRevokeAPIKey {
+	if mmRevokeAPIKey.mock.inspectFuncRevokeAPIKey != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("Inspect function is already set for APIKeyServiceMock.RevokeAPIKey")
+	}
+
+	mmRevokeAPIKey.mock.inspectFuncRevokeAPIKey = f
+
+	return mmRevokeAPIKey
+}
+
+// Return sets up results that will be returned by APIKeyService.RevokeAPIKey
+func (mmRevokeAPIKey *mAPIKeyServiceMockRevokeAPIKey) Return(err error) *APIKeyServiceMock {
+	if mmRevokeAPIKey.mock.funcRevokeAPIKey != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("APIKeyServiceMock.RevokeAPIKey mock is already set by Set")
+	}
+
+	if mmRevokeAPIKey.defaultExpectation == nil {
+		mmRevokeAPIKey.defaultExpectation = &APIKeyServiceMockRevokeAPIKeyExpectation{mock: mmRevokeAPIKey.mock}
+	}
+	mmRevokeAPIKey.defaultExpectation.results = &APIKeyServiceMockRevokeAPIKeyResults{err}
+	return mmRevokeAPIKey.mock
+}
+
+// Set uses given function f to mock the APIKeyService.RevokeAPIKey method
+func (mmRevokeAPIKey *mAPIKeyServiceMockRevokeAPIKey) Set(f func(ctx context.Context, id int64) (err error)) *APIKeyServiceMock {
+	if mmRevokeAPIKey.defaultExpectation != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("Default expectation is already set for the APIKeyService.RevokeAPIKey method")
+	}
+
+	if len(mmRevokeAPIKey.expectations) > 0 {
+		mmRevokeAPIKey.mock.t.Fatalf("Some expectations are already set for the APIKeyService.RevokeAPIKey method")
+	}
+
+	mmRevokeAPIKey.mock.funcRevokeAPIKey = f
+	return mmRevokeAPIKey.mock
+}
+
+// When sets expectation for the APIKeyService.RevokeAPIKey which will trigger the result defined by the following
+// Then helper
+func (mmRevokeAPIKey *mAPIKeyServiceMockRevokeAPIKey) When(ctx context.Context, id int64) *APIKeyServiceMockRevokeAPIKeyExpectation {
+	if mmRevokeAPIKey.mock.funcRevokeAPIKey != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("APIKeyServiceMock.RevokeAPIKey mock is already set by Set")
+	}
+
+	expectation := &APIKeyServiceMockRevokeAPIKeyExpectation{
+		mock:   mmRevokeAPIKey.mock,
+		params: &APIKeyServiceMockRevokeAPIKeyParams{ctx, id},
+	}
+	mmRevokeAPIKey.expectations = append(mmRevokeAPIKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up APIKeyService.RevokeAPIKey return parameters for the expectation previously defined by the When method
+func (e *APIKeyServiceMockRevokeAPIKeyExpectation) Then(err error) *APIKeyServiceMock {
+	e.results = &APIKeyServiceMockRevokeAPIKeyResults{err}
+	return e.mock
+}
+
+// Times sets number of times APIKeyService.RevokeAPIKey should be invoked
+func (mmRevokeAPIKey *mAPIKeyServiceMockRevokeAPIKey) Times(n uint64) *mAPIKeyServiceMockRevokeAPIKey {
+	if n == 0 {
+		mmRevokeAPIKey.mock.t.Fatalf("Times of APIKeyServiceMock.RevokeAPIKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeAPIKey.expectedInvocations, n)
+	return mmRevokeAPIKey
+}
+
+func (mmRevokeAPIKey *mAPIKeyServiceMockRevokeAPIKey) invocationsDone() bool {
+	if len(mmRevokeAPIKey.expectations) == 0 && mmRevokeAPIKey.defaultExpectation == nil && mmRevokeAPIKey.mock.funcRevokeAPIKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeAPIKey.mock.afterRevokeAPIKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeAPIKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeAPIKey implements service.APIKeyService
+func (mmRevokeAPIKey *APIKeyServiceMock) RevokeAPIKey(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmRevokeAPIKey.beforeRevokeAPIKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeAPIKey.afterRevokeAPIKeyCounter, 1)
+
+	if mmRevokeAPIKey.inspectFuncRevokeAPIKey != nil {
+		mmRevokeAPIKey.inspectFuncRevokeAPIKey(ctx, id)
+	}
+
+	mm_params := APIKeyServiceMockRevokeAPIKeyParams{ctx, id}
+
+	// Record call args
+	mmRevokeAPIKey.RevokeAPIKeyMock.mutex.Lock()
+	mmRevokeAPIKey.RevokeAPIKeyMock.callArgs = append(mmRevokeAPIKey.RevokeAPIKeyMock.callArgs, &mm_params)
+	mmRevokeAPIKey.RevokeAPIKeyMock.mutex.Unlock()
+
+	for _, e := range mmRevokeAPIKey.RevokeAPIKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokeAPIKey.RevokeAPIKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeAPIKey.RevokeAPIKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeAPIKey.RevokeAPIKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeAPIKey.RevokeAPIKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := APIKeyServiceMockRevokeAPIKeyParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeAPIKey.t.Errorf("APIKeyServiceMock.RevokeAPIKey got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRevokeAPIKey.t.Errorf("APIKeyServiceMock.RevokeAPIKey got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeAPIKey.t.Errorf("APIKeyServiceMock.RevokeAPIKey got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeAPIKey.RevokeAPIKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeAPIKey.t.Fatal("No results are set for the APIKeyServiceMock.RevokeAPIKey")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokeAPIKey.funcRevokeAPIKey != nil {
+		return mmRevokeAPIKey.funcRevokeAPIKey(ctx, id)
+	}
+	mmRevokeAPIKey.t.Fatalf("Unexpected call to APIKeyServiceMock.RevokeAPIKey. %v %v", ctx, id)
+	return
+}
+
+// RevokeAPIKeyAfterCounter returns a count of finished APIKeyServiceMock.RevokeAPIKey invocations
+func (mmRevokeAPIKey *APIKeyServiceMock) RevokeAPIKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeAPIKey.afterRevokeAPIKeyCounter)
+}
+
+// RevokeAPIKeyBeforeCounter returns a count of APIKeyServiceMock.RevokeAPIKey invocations
+func (mmRevokeAPIKey *APIKeyServiceMock) RevokeAPIKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeAPIKey.beforeRevokeAPIKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to APIKeyServiceMock.RevokeAPIKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeAPIKey *mAPIKeyServiceMockRevokeAPIKey) Calls() []*APIKeyServiceMockRevokeAPIKeyParams {
+	mmRevokeAPIKey.mutex.RLock()
+
+	argCopy := make([]*APIKeyServiceMockRevokeAPIKeyParams, len(mmRevokeAPIKey.callArgs))
+	copy(argCopy, mmRevokeAPIKey.callArgs)
+
+	mmRevokeAPIKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeAPIKeyDone returns true if the count of the RevokeAPIKey invocations corresponds
+// the number of defined expectations
+func (m *APIKeyServiceMock) MinimockRevokeAPIKeyDone() bool {
+	if m.RevokeAPIKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeAPIKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeAPIKeyMock.invocationsDone()
+}
+
+// MinimockRevokeAPIKeyInspect logs each unmet expectation
+func (m *APIKeyServiceMock) MinimockRevokeAPIKeyInspect() {
+	for _, e := range m.RevokeAPIKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to APIKeyServiceMock.RevokeAPIKey with params: %#v", *e.params)
+		}
+	}
+
+	afterRevokeAPIKeyCounter := mm_atomic.LoadUint64(&m.afterRevokeAPIKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeAPIKeyMock.defaultExpectation != nil && afterRevokeAPIKeyCounter < 1 {
+		if m.RevokeAPIKeyMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to APIKeyServiceMock.RevokeAPIKey")
+		} else {
+			m.t.Errorf("Expected call to APIKeyServiceMock.RevokeAPIKey with params: %#v", *m.RevokeAPIKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeAPIKey != nil && afterRevokeAPIKeyCounter < 1 {
+		m.t.Error("Expected call to APIKeyServiceMock.RevokeAPIKey")
+	}
+
+	if !m.RevokeAPIKeyMock.invocationsDone() && afterRevokeAPIKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to APIKeyServiceMock.RevokeAPIKey but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeAPIKeyMock.expectedInvocations), afterRevokeAPIKeyCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *APIKeyServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAuthenticateInspect()
+
+			m.MinimockCreateAPIKeyInspect()
+
+			m.MinimockListAPIKeysInspect()
+
+			m.MinimockRevokeAPIKeyInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *APIKeyServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *APIKeyServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAuthenticateDone() &&
+		m.MinimockCreateAPIKeyDone() &&
+		m.MinimockListAPIKeysDone() &&
+		m.MinimockRevokeAPIKeyDone()
+}
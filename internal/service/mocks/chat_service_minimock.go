@@ -11,6 +11,8 @@ import (
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/chat-server/internal/broker"
+	chatModel "github.com/mikhailsoldatkin/chat-server/internal/service/chat/model"
 	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
 )
 
@@ -31,11 +33,23 @@ type ChatServiceMock struct {
 	beforeDeleteCounter uint64
 	DeleteMock          mChatServiceMockDelete
 
-	funcSendMessage          func(ctx context.Context, req *pb.SendMessageRequest) (err error)
+	funcPublishEvent          func(ctx context.Context, event *chatModel.Event) (err error)
+	inspectFuncPublishEvent   func(ctx context.Context, event *chatModel.Event)
+	afterPublishEventCounter  uint64
+	beforePublishEventCounter uint64
+	PublishEventMock          mChatServiceMockPublishEvent
+
+	funcSendMessage          func(ctx context.Context, req *pb.SendMessageRequest) (i1 int64, err error)
 	inspectFuncSendMessage   func(ctx context.Context, req *pb.SendMessageRequest)
 	afterSendMessageCounter  uint64
 	beforeSendMessageCounter uint64
 	SendMessageMock          mChatServiceMockSendMessage
+
+	funcSubscribe          func(ctx context.Context, sub broker.Subscription, userID int64, chatIDs []int64) (err error)
+	inspectFuncSubscribe   func(ctx context.Context, sub broker.Subscription, userID int64, chatIDs []int64)
+	afterSubscribeCounter  uint64
+	beforeSubscribeCounter uint64
+	SubscribeMock          mChatServiceMockSubscribe
 }
 
 // NewChatServiceMock returns a mock for service.ChatService
@@ -52,9 +66,15 @@ func NewChatServiceMock(t minimock.Tester) *ChatServiceMock {
 	m.DeleteMock = mChatServiceMockDelete{mock: m}
 	m.DeleteMock.callArgs = []*ChatServiceMockDeleteParams{}
 
+	m.PublishEventMock = mChatServiceMockPublishEvent{mock: m}
+	m.PublishEventMock.callArgs = []*ChatServiceMockPublishEventParams{}
+
 	m.SendMessageMock = mChatServiceMockSendMessage{mock: m}
 	m.SendMessageMock.callArgs = []*ChatServiceMockSendMessageParams{}
 
+	m.SubscribeMock = mChatServiceMockSubscribe{mock: m}
+	m.SubscribeMock.callArgs = []*ChatServiceMockSubscribeParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -701,6 +721,326 @@ func (m *ChatServiceMock) MinimockDeleteInspect() {
 	}
 }
 
+type mChatServiceMockPublishEvent struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockPublishEventExpectation
+	expectations       []*ChatServiceMockPublishEventExpectation
+
+	callArgs []*ChatServiceMockPublishEventParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockPublishEventExpectation specifies expectation struct of the ChatService.PublishEvent
+type ChatServiceMockPublishEventExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockPublishEventParams
+	paramPtrs *ChatServiceMockPublishEventParamPtrs
+	results   *ChatServiceMockPublishEventResults
+	Counter   uint64
+}
+
+// ChatServiceMockPublishEventParams contains parameters of the ChatService.PublishEvent
+type ChatServiceMockPublishEventParams struct {
+	ctx   context.Context
+	event *chatModel.Event
+}
+
+// ChatServiceMockPublishEventParamPtrs contains pointers to parameters of the ChatService.PublishEvent
+type ChatServiceMockPublishEventParamPtrs struct {
+	ctx   *context.Context
+	event **chatModel.Event
+}
+
+// ChatServiceMockPublishEventResults contains results of the ChatService.PublishEvent
+type ChatServiceMockPublishEventResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPublishEvent *mChatServiceMockPublishEvent) Optional() *mChatServiceMockPublishEvent {
+	mmPublishEvent.optional = true
+	return mmPublishEvent
+}
+
+// Expect sets up expected params for ChatService.PublishEvent
+func (mmPublishEvent *mChatServiceMockPublishEvent) Expect(ctx context.Context, event *chatModel.Event) *mChatServiceMockPublishEvent {
+	if mmPublishEvent.mock.funcPublishEvent != nil {
+		mmPublishEvent.mock.t.Fatalf("ChatServiceMock.PublishEvent mock is already set by Set")
+	}
+
+	if mmPublishEvent.defaultExpectation == nil {
+		mmPublishEvent.defaultExpectation = &ChatServiceMockPublishEventExpectation{}
+	}
+
+	if mmPublishEvent.defaultExpectation.paramPtrs != nil {
+		mmPublishEvent.mock.t.Fatalf("ChatServiceMock.PublishEvent mock is already set by ExpectParams functions")
+	}
+
+	mmPublishEvent.defaultExpectation.params = &ChatServiceMockPublishEventParams{ctx, event}
+	for _, e := range mmPublishEvent.expectations {
+		if minimock.Equal(e.params, mmPublishEvent.defaultExpectation.params) {
+			mmPublishEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPublishEvent.defaultExpectation.params)
+		}
+	}
+
+	return mmPublishEvent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.PublishEvent
+func (mmPublishEvent *mChatServiceMockPublishEvent) ExpectCtxParam1(ctx context.Context) *mChatServiceMockPublishEvent {
+	if mmPublishEvent.mock.funcPublishEvent != nil {
+		mmPublishEvent.mock.t.Fatalf("ChatServiceMock.PublishEvent mock is already set by Set")
+	}
+
+	if mmPublishEvent.defaultExpectation == nil {
+		mmPublishEvent.defaultExpectation = &ChatServiceMockPublishEventExpectation{}
+	}
+
+	if mmPublishEvent.defaultExpectation.params != nil {
+		mmPublishEvent.mock.t.Fatalf("ChatServiceMock.PublishEvent mock is already set by Expect")
+	}
+
+	if mmPublishEvent.defaultExpectation.paramPtrs == nil {
+		mmPublishEvent.defaultExpectation.paramPtrs = &ChatServiceMockPublishEventParamPtrs{}
+	}
+	mmPublishEvent.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmPublishEvent
+}
+
+// ExpectEventParam2 sets up expected param event for ChatService.PublishEvent
+func (mmPublishEvent *mChatServiceMockPublishEvent) ExpectEventParam2(event *chatModel.Event) *mChatServiceMockPublishEvent {
+	if mmPublishEvent.mock.funcPublishEvent != nil {
+		mmPublishEvent.mock.t.Fatalf("ChatServiceMock.PublishEvent mock is already set by Set")
+	}
+
+	if mmPublishEvent.defaultExpectation == nil {
+		mmPublishEvent.defaultExpectation = &ChatServiceMockPublishEventExpectation{}
+	}
+
+	if mmPublishEvent.defaultExpectation.params != nil {
+		mmPublishEvent.mock.t.Fatalf("ChatServiceMock.PublishEvent mock is already set by Expect")
+	}
+
+	if mmPublishEvent.defaultExpectation.paramPtrs == nil {
+		mmPublishEvent.defaultExpectation.paramPtrs = &ChatServiceMockPublishEventParamPtrs{}
+	}
+	mmPublishEvent.defaultExpectation.paramPtrs.event = &event
+
+	return mmPublishEvent
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.PublishEvent
+func (mmPublishEvent *mChatServiceMockPublishEvent) Inspect(f func(ctx context.Context, event *chatModel.Event)) *mChatServiceMockPublishEvent {
+	if mmPublishEvent.mock.inspectFuncPublishEvent != nil {
+		mmPublishEvent.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.PublishEvent")
+	}
+
+	mmPublishEvent.mock.inspectFuncPublishEvent = f
+
+	return mmPublishEvent
+}
+
+// Return sets up results that will be returned by ChatService.PublishEvent
+func (mmPublishEvent *mChatServiceMockPublishEvent) Return(err error) *ChatServiceMock {
+	if mmPublishEvent.mock.funcPublishEvent != nil {
+		mmPublishEvent.mock.t.Fatalf("ChatServiceMock.PublishEvent mock is already set by Set")
+	}
+
+	if mmPublishEvent.defaultExpectation == nil {
+		mmPublishEvent.defaultExpectation = &ChatServiceMockPublishEventExpectation{mock: mmPublishEvent.mock}
+	}
+	mmPublishEvent.defaultExpectation.results = &ChatServiceMockPublishEventResults{err}
+	return mmPublishEvent.mock
+}
+
+// Set uses given function f to mock the ChatService.PublishEvent method
+func (mmPublishEvent *mChatServiceMockPublishEvent) Set(f func(ctx context.Context, event *chatModel.Event) (err error)) *ChatServiceMock {
+	if mmPublishEvent.defaultExpectation != nil {
+		mmPublishEvent.mock.t.Fatalf("Default expectation is already set for the ChatService.PublishEvent method")
+	}
+
+	if len(mmPublishEvent.expectations) > 0 {
+		mmPublishEvent.mock.t.Fatalf("Some expectations are already set for the ChatService.PublishEvent method")
+	}
+
+	mmPublishEvent.mock.funcPublishEvent = f
+	return mmPublishEvent.mock
+}
+
+// When sets expectation for the ChatService.PublishEvent which will trigger the result defined by the following
+// Then helper
+func (mmPublishEvent *mChatServiceMockPublishEvent) When(ctx context.Context, event *chatModel.Event) *ChatServiceMockPublishEventExpectation {
+	if mmPublishEvent.mock.funcPublishEvent != nil {
+		mmPublishEvent.mock.t.Fatalf("ChatServiceMock.PublishEvent mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockPublishEventExpectation{
+		mock:   mmPublishEvent.mock,
+		params: &ChatServiceMockPublishEventParams{ctx, event},
+	}
+	mmPublishEvent.expectations = append(mmPublishEvent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.PublishEvent return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockPublishEventExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockPublishEventResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.PublishEvent should be invoked
+func (mmPublishEvent *mChatServiceMockPublishEvent) Times(n uint64) *mChatServiceMockPublishEvent {
+	if n == 0 {
+		mmPublishEvent.mock.t.Fatalf("Times of ChatServiceMock.PublishEvent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPublishEvent.expectedInvocations, n)
+	return mmPublishEvent
+}
+
+func (mmPublishEvent *mChatServiceMockPublishEvent) invocationsDone() bool {
+	if len(mmPublishEvent.expectations) == 0 && mmPublishEvent.defaultExpectation == nil && mmPublishEvent.mock.funcPublishEvent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPublishEvent.mock.afterPublishEventCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPublishEvent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PublishEvent implements service.ChatService
+func (mmPublishEvent *ChatServiceMock) PublishEvent(ctx context.Context, event *chatModel.Event) (err error) {
+	mm_atomic.AddUint64(&mmPublishEvent.beforePublishEventCounter, 1)
+	defer mm_atomic.AddUint64(&mmPublishEvent.afterPublishEventCounter, 1)
+
+	if mmPublishEvent.inspectFuncPublishEvent != nil {
+		mmPublishEvent.inspectFuncPublishEvent(ctx, event)
+	}
+
+	mm_params := ChatServiceMockPublishEventParams{ctx, event}
+
+	// Record call args
+	mmPublishEvent.PublishEventMock.mutex.Lock()
+	mmPublishEvent.PublishEventMock.callArgs = append(mmPublishEvent.PublishEventMock.callArgs, &mm_params)
+	mmPublishEvent.PublishEventMock.mutex.Unlock()
+
+	for _, e := range mmPublishEvent.PublishEventMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPublishEvent.PublishEventMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPublishEvent.PublishEventMock.defaultExpectation.Counter, 1)
+		mm_want := mmPublishEvent.PublishEventMock.defaultExpectation.params
+		mm_want_ptrs := mmPublishEvent.PublishEventMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockPublishEventParams{ctx, event}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPublishEvent.t.Errorf("ChatServiceMock.PublishEvent got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.event != nil && !minimock.Equal(*mm_want_ptrs.event, mm_got.event) {
+				mmPublishEvent.t.Errorf("ChatServiceMock.PublishEvent got unexpected parameter event, want: %#v, got: %#v%s\n", *mm_want_ptrs.event, mm_got.event, minimock.Diff(*mm_want_ptrs.event, mm_got.event))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPublishEvent.t.Errorf("ChatServiceMock.PublishEvent got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPublishEvent.PublishEventMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPublishEvent.t.Fatal("No results are set for the ChatServiceMock.PublishEvent")
+		}
+		return (*mm_results).err
+	}
+	if mmPublishEvent.funcPublishEvent != nil {
+		return mmPublishEvent.funcPublishEvent(ctx, event)
+	}
+	mmPublishEvent.t.Fatalf("Unexpected call to ChatServiceMock.PublishEvent. %v %v", ctx, event)
+	return
+}
+
+// PublishEventAfterCounter returns a count of finished ChatServiceMock.PublishEvent invocations
+func (mmPublishEvent *ChatServiceMock) PublishEventAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPublishEvent.afterPublishEventCounter)
+}
+
+// PublishEventBeforeCounter returns a count of ChatServiceMock.PublishEvent invocations
+func (mmPublishEvent *ChatServiceMock) PublishEventBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPublishEvent.beforePublishEventCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.PublishEvent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPublishEvent *mChatServiceMockPublishEvent) Calls() []*ChatServiceMockPublishEventParams {
+	mmPublishEvent.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockPublishEventParams, len(mmPublishEvent.callArgs))
+	copy(argCopy, mmPublishEvent.callArgs)
+
+	mmPublishEvent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPublishEventDone returns true if the count of the PublishEvent invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockPublishEventDone() bool {
+	if m.PublishEventMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PublishEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PublishEventMock.invocationsDone()
+}
+
+// MinimockPublishEventInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockPublishEventInspect() {
+	for _, e := range m.PublishEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.PublishEvent with params: %#v", *e.params)
+		}
+	}
+
+	afterPublishEventCounter := mm_atomic.LoadUint64(&m.afterPublishEventCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PublishEventMock.defaultExpectation != nil && afterPublishEventCounter < 1 {
+		if m.PublishEventMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.PublishEvent")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.PublishEvent with params: %#v", *m.PublishEventMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPublishEvent != nil && afterPublishEventCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.PublishEvent")
+	}
+
+	if !m.PublishEventMock.invocationsDone() && afterPublishEventCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.PublishEvent but found %d calls",
+			mm_atomic.LoadUint64(&m.PublishEventMock.expectedInvocations), afterPublishEventCounter)
+	}
+}
+
 type mChatServiceMockSendMessage struct {
 	optional           bool
 	mock               *ChatServiceMock
@@ -736,6 +1076,7 @@ type ChatServiceMockSendMessageParamPtrs struct {
 
 // ChatServiceMockSendMessageResults contains results of the ChatService.SendMessage
 type ChatServiceMockSendMessageResults struct {
+	i1  int64
 	err error
 }
 
@@ -829,7 +1170,7 @@ func (mmSendMessage *mChatServiceMockSendMessage) Inspect(f func(ctx context.Con
 }
 
 // Return sets up results that will be returned by ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) Return(err error) *ChatServiceMock {
+func (mmSendMessage *mChatServiceMockSendMessage) Return(i1 int64, err error) *ChatServiceMock {
 	if mmSendMessage.mock.funcSendMessage != nil {
 		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
 	}
@@ -837,12 +1178,12 @@ func (mmSendMessage *mChatServiceMockSendMessage) Return(err error) *ChatService
 	if mmSendMessage.defaultExpectation == nil {
 		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{mock: mmSendMessage.mock}
 	}
-	mmSendMessage.defaultExpectation.results = &ChatServiceMockSendMessageResults{err}
+	mmSendMessage.defaultExpectation.results = &ChatServiceMockSendMessageResults{i1, err}
 	return mmSendMessage.mock
 }
 
 // Set uses given function f to mock the ChatService.SendMessage method
-func (mmSendMessage *mChatServiceMockSendMessage) Set(f func(ctx context.Context, req *pb.SendMessageRequest) (err error)) *ChatServiceMock {
+func (mmSendMessage *mChatServiceMockSendMessage) Set(f func(ctx context.Context, req *pb.SendMessageRequest) (i1 int64, err error)) *ChatServiceMock {
 	if mmSendMessage.defaultExpectation != nil {
 		mmSendMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.SendMessage method")
 	}
@@ -871,8 +1212,8 @@ func (mmSendMessage *mChatServiceMockSendMessage) When(ctx context.Context, req
 }
 
 // Then sets up ChatService.SendMessage return parameters for the expectation previously defined by the When method
-func (e *ChatServiceMockSendMessageExpectation) Then(err error) *ChatServiceMock {
-	e.results = &ChatServiceMockSendMessageResults{err}
+func (e *ChatServiceMockSendMessageExpectation) Then(i1 int64, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockSendMessageResults{i1, err}
 	return e.mock
 }
 
@@ -897,7 +1238,7 @@ func (mmSendMessage *mChatServiceMockSendMessage) invocationsDone() bool {
 }
 
 // SendMessage implements service.ChatService
-func (mmSendMessage *ChatServiceMock) SendMessage(ctx context.Context, req *pb.SendMessageRequest) (err error) {
+func (mmSendMessage *ChatServiceMock) SendMessage(ctx context.Context, req *pb.SendMessageRequest) (i1 int64, err error) {
 	mm_atomic.AddUint64(&mmSendMessage.beforeSendMessageCounter, 1)
 	defer mm_atomic.AddUint64(&mmSendMessage.afterSendMessageCounter, 1)
 
@@ -915,7 +1256,7 @@ func (mmSendMessage *ChatServiceMock) SendMessage(ctx context.Context, req *pb.S
 	for _, e := range mmSendMessage.SendMessageMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.i1, e.results.err
 		}
 	}
 
@@ -944,7 +1285,7 @@ func (mmSendMessage *ChatServiceMock) SendMessage(ctx context.Context, req *pb.S
 		if mm_results == nil {
 			mmSendMessage.t.Fatal("No results are set for the ChatServiceMock.SendMessage")
 		}
-		return (*mm_results).err
+		return (*mm_results).i1, (*mm_results).err
 	}
 	if mmSendMessage.funcSendMessage != nil {
 		return mmSendMessage.funcSendMessage(ctx, req)
@@ -1021,6 +1362,382 @@ func (m *ChatServiceMock) MinimockSendMessageInspect() {
 	}
 }
 
+type mChatServiceMockSubscribe struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockSubscribeExpectation
+	expectations       []*ChatServiceMockSubscribeExpectation
+
+	callArgs []*ChatServiceMockSubscribeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockSubscribeExpectation specifies expectation struct of the ChatService.Subscribe
+type ChatServiceMockSubscribeExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockSubscribeParams
+	paramPtrs *ChatServiceMockSubscribeParamPtrs
+	results   *ChatServiceMockSubscribeResults
+	Counter   uint64
+}
+
+// ChatServiceMockSubscribeParams contains parameters of the ChatService.Subscribe
+type ChatServiceMockSubscribeParams struct {
+	ctx     context.Context
+	sub     broker.Subscription
+	userID  int64
+	chatIDs []int64
+}
+
+// ChatServiceMockSubscribeParamPtrs contains pointers to parameters of the ChatService.Subscribe
+type ChatServiceMockSubscribeParamPtrs struct {
+	ctx     *context.Context
+	sub     *broker.Subscription
+	userID  *int64
+	chatIDs *[]int64
+}
+
+// ChatServiceMockSubscribeResults contains results of the ChatService.Subscribe
+type ChatServiceMockSubscribeResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSubscribe *mChatServiceMockSubscribe) Optional() *mChatServiceMockSubscribe {
+	mmSubscribe.optional = true
+	return mmSubscribe
+}
+
+// Expect sets up expected params for ChatService.Subscribe
+func (mmSubscribe *mChatServiceMockSubscribe) Expect(ctx context.Context, sub broker.Subscription, userID int64, chatIDs []int64) *mChatServiceMockSubscribe {
+	if mmSubscribe.mock.funcSubscribe != nil {
+		mmSubscribe.mock.t.Fatalf("ChatServiceMock.Subscribe mock is already set by Set")
+	}
+
+	if mmSubscribe.defaultExpectation == nil {
+		mmSubscribe.defaultExpectation = &ChatServiceMockSubscribeExpectation{}
+	}
+
+	if mmSubscribe.defaultExpectation.paramPtrs != nil {
+		mmSubscribe.mock.t.Fatalf("ChatServiceMock.Subscribe mock is already set by ExpectParams functions")
+	}
+
+	mmSubscribe.defaultExpectation.params = &ChatServiceMockSubscribeParams{ctx, sub, userID, chatIDs}
+	for _, e := range mmSubscribe.expectations {
+		if minimock.Equal(e.params, mmSubscribe.defaultExpectation.params) {
+			mmSubscribe.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSubscribe.defaultExpectation.params)
+		}
+	}
+
+	return mmSubscribe
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.Subscribe
+func (mmSubscribe *mChatServiceMockSubscribe) ExpectCtxParam1(ctx context.Context) *mChatServiceMockSubscribe {
+	if mmSubscribe.mock.funcSubscribe != nil {
+		mmSubscribe.mock.t.Fatalf("ChatServiceMock.Subscribe mock is already set by Set")
+	}
+
+	if mmSubscribe.defaultExpectation == nil {
+		mmSubscribe.defaultExpectation = &ChatServiceMockSubscribeExpectation{}
+	}
+
+	if mmSubscribe.defaultExpectation.params != nil {
+		mmSubscribe.mock.t.Fatalf("ChatServiceMock.Subscribe mock is already set by Expect")
+	}
+
+	if mmSubscribe.defaultExpectation.paramPtrs == nil {
+		mmSubscribe.defaultExpectation.paramPtrs = &ChatServiceMockSubscribeParamPtrs{}
+	}
+	mmSubscribe.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSubscribe
+}
+
+// ExpectSubParam2 sets up expected param sub for ChatService.Subscribe
+func (mmSubscribe *mChatServiceMockSubscribe) ExpectSubParam2(sub broker.Subscription) *mChatServiceMockSubscribe {
+	if mmSubscribe.mock.funcSubscribe != nil {
+		mmSubscribe.mock.t.Fatalf("ChatServiceMock.Subscribe mock is already set by Set")
+	}
+
+	if mmSubscribe.defaultExpectation == nil {
+		mmSubscribe.defaultExpectation = &ChatServiceMockSubscribeExpectation{}
+	}
+
+	if mmSubscribe.defaultExpectation.params != nil {
+		mmSubscribe.mock.t.Fatalf("ChatServiceMock.Subscribe mock is already set by Expect")
+	}
+
+	if mmSubscribe.defaultExpectation.paramPtrs == nil {
+		mmSubscribe.defaultExpectation.paramPtrs = &ChatServiceMockSubscribeParamPtrs{}
+	}
+	mmSubscribe.defaultExpectation.paramPtrs.sub = &sub
+
+	return mmSubscribe
+}
+
+// ExpectUserIDParam3 sets up expected param userID for ChatService.Subscribe
+func (mmSubscribe *mChatServiceMockSubscribe) ExpectUserIDParam3(userID int64) *mChatServiceMockSubscribe {
+	if mmSubscribe.mock.funcSubscribe != nil {
+		mmSubscribe.mock.t.Fatalf("ChatServiceMock.Subscribe mock is already set by Set")
+	}
+
+	if mmSubscribe.defaultExpectation == nil {
+		mmSubscribe.defaultExpectation = &ChatServiceMockSubscribeExpectation{}
+	}
+
+	if mmSubscribe.defaultExpectation.params != nil {
+		mmSubscribe.mock.t.Fatalf("ChatServiceMock.Subscribe mock is already set by Expect")
+	}
+
+	if mmSubscribe.defaultExpectation.paramPtrs == nil {
+		mmSubscribe.defaultExpectation.paramPtrs = &ChatServiceMockSubscribeParamPtrs{}
+	}
+	mmSubscribe.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmSubscribe
+}
+
+// ExpectChatIDsParam4 sets up expected param chatIDs for ChatService.Subscribe
+func (mmSubscribe *mChatServiceMockSubscribe) ExpectChatIDsParam4(chatIDs []int64) *mChatServiceMockSubscribe {
+	if mmSubscribe.mock.funcSubscribe != nil {
+		mmSubscribe.mock.t.Fatalf("ChatServiceMock.Subscribe mock is already set by Set")
+	}
+
+	if mmSubscribe.defaultExpectation == nil {
+		mmSubscribe.defaultExpectation = &ChatServiceMockSubscribeExpectation{}
+	}
+
+	if mmSubscribe.defaultExpectation.params != nil {
+		mmSubscribe.mock.t.Fatalf("ChatServiceMock.Subscribe mock is already set by Expect")
+	}
+
+	if mmSubscribe.defaultExpectation.paramPtrs == nil {
+		mmSubscribe.defaultExpectation.paramPtrs = &ChatServiceMockSubscribeParamPtrs{}
+	}
+	mmSubscribe.defaultExpectation.paramPtrs.chatIDs = &chatIDs
+
+	return mmSubscribe
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.Subscribe
+func (mmSubscribe *mChatServiceMockSubscribe) Inspect(f func(ctx context.Context, sub broker.Subscription, userID int64, chatIDs []int64)) *mChatServiceMockSubscribe {
+	if mmSubscribe.mock.inspectFuncSubscribe != nil {
+		mmSubscribe.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.Subscribe")
+	}
+
+	mmSubscribe.mock.inspectFuncSubscribe = f
+
+	return mmSubscribe
+}
+
+// Return sets up results that will be returned by ChatService.Subscribe
+func (mmSubscribe *mChatServiceMockSubscribe) Return(err error) *ChatServiceMock {
+	if mmSubscribe.mock.funcSubscribe != nil {
+		mmSubscribe.mock.t.Fatalf("ChatServiceMock.Subscribe mock is already set by Set")
+	}
+
+	if mmSubscribe.defaultExpectation == nil {
+		mmSubscribe.defaultExpectation = &ChatServiceMockSubscribeExpectation{mock: mmSubscribe.mock}
+	}
+	mmSubscribe.defaultExpectation.results = &ChatServiceMockSubscribeResults{err}
+	return mmSubscribe.mock
+}
+
+// Set uses given function f to mock the ChatService.Subscribe method
+func (mmSubscribe *mChatServiceMockSubscribe) Set(f func(ctx context.Context, sub broker.Subscription, userID int64, chatIDs []int64) (err error)) *ChatServiceMock {
+	if mmSubscribe.defaultExpectation != nil {
+		mmSubscribe.mock.t.Fatalf("Default expectation is already set for the ChatService.Subscribe method")
+	}
+
+	if len(mmSubscribe.expectations) > 0 {
+		mmSubscribe.mock.t.Fatalf("Some expectations are already set for the ChatService.Subscribe method")
+	}
+
+	mmSubscribe.mock.funcSubscribe = f
+	return mmSubscribe.mock
+}
+
+// When sets expectation for the ChatService.Subscribe which will trigger the result defined by the following
+// Then helper
+func (mmSubscribe *mChatServiceMockSubscribe) When(ctx context.Context, sub broker.Subscription, userID int64, chatIDs []int64) *ChatServiceMockSubscribeExpectation {
+	if mmSubscribe.mock.funcSubscribe != nil {
+		mmSubscribe.mock.t.Fatalf("ChatServiceMock.Subscribe mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockSubscribeExpectation{
+		mock:   mmSubscribe.mock,
+		params: &ChatServiceMockSubscribeParams{ctx, sub, userID, chatIDs},
+	}
+	mmSubscribe.expectations = append(mmSubscribe.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.Subscribe return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockSubscribeExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockSubscribeResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.Subscribe should be invoked
+func (mmSubscribe *mChatServiceMockSubscribe) Times(n uint64) *mChatServiceMockSubscribe {
+	if n == 0 {
+		mmSubscribe.mock.t.Fatalf("Times of ChatServiceMock.Subscribe mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSubscribe.expectedInvocations, n)
+	return mmSubscribe
+}
+
+func (mmSubscribe *mChatServiceMockSubscribe) invocationsDone() bool {
+	if len(mmSubscribe.expectations) == 0 && mmSubscribe.defaultExpectation == nil && mmSubscribe.mock.funcSubscribe == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSubscribe.mock.afterSubscribeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSubscribe.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Subscribe implements service.ChatService
+func (mmSubscribe *ChatServiceMock) Subscribe(ctx context.Context, sub broker.Subscription, userID int64, chatIDs []int64) (err error) {
+	mm_atomic.AddUint64(&mmSubscribe.beforeSubscribeCounter, 1)
+	defer mm_atomic.AddUint64(&mmSubscribe.afterSubscribeCounter, 1)
+
+	if mmSubscribe.inspectFuncSubscribe != nil {
+		mmSubscribe.inspectFuncSubscribe(ctx, sub, userID, chatIDs)
+	}
+
+	mm_params := ChatServiceMockSubscribeParams{ctx, sub, userID, chatIDs}
+
+	// Record call args
+	mmSubscribe.SubscribeMock.mutex.Lock()
+	mmSubscribe.SubscribeMock.callArgs = append(mmSubscribe.SubscribeMock.callArgs, &mm_params)
+	mmSubscribe.SubscribeMock.mutex.Unlock()
+
+	for _, e := range mmSubscribe.SubscribeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSubscribe.SubscribeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSubscribe.SubscribeMock.defaultExpectation.Counter, 1)
+		mm_want := mmSubscribe.SubscribeMock.defaultExpectation.params
+		mm_want_ptrs := mmSubscribe.SubscribeMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockSubscribeParams{ctx, sub, userID, chatIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSubscribe.t.Errorf("ChatServiceMock.Subscribe got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.sub != nil && !minimock.Equal(*mm_want_ptrs.sub, mm_got.sub) {
+				mmSubscribe.t.Errorf("ChatServiceMock.Subscribe got unexpected parameter sub, want: %#v, got: %#v%s\n", *mm_want_ptrs.sub, mm_got.sub, minimock.Diff(*mm_want_ptrs.sub, mm_got.sub))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmSubscribe.t.Errorf("ChatServiceMock.Subscribe got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.chatIDs != nil && !minimock.Equal(*mm_want_ptrs.chatIDs, mm_got.chatIDs) {
+				mmSubscribe.t.Errorf("ChatServiceMock.Subscribe got unexpected parameter chatIDs, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatIDs, mm_got.chatIDs, minimock.Diff(*mm_want_ptrs.chatIDs, mm_got.chatIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSubscribe.t.Errorf("ChatServiceMock.Subscribe got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSubscribe.SubscribeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSubscribe.t.Fatal("No results are set for the ChatServiceMock.Subscribe")
+		}
+		return (*mm_results).err
+	}
+	if mmSubscribe.funcSubscribe != nil {
+		return mmSubscribe.funcSubscribe(ctx, sub, userID, chatIDs)
+	}
+	mmSubscribe.t.Fatalf("Unexpected call to ChatServiceMock.Subscribe. %v %v %v %v", ctx, sub, userID, chatIDs)
+	return
+}
+
+// SubscribeAfterCounter returns a count of finished ChatServiceMock.Subscribe invocations
+func (mmSubscribe *ChatServiceMock) SubscribeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSubscribe.afterSubscribeCounter)
+}
+
+// SubscribeBeforeCounter returns a count of ChatServiceMock.Subscribe invocations
+func (mmSubscribe *ChatServiceMock) SubscribeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSubscribe.beforeSubscribeCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.Subscribe.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSubscribe *mChatServiceMockSubscribe) Calls() []*ChatServiceMockSubscribeParams {
+	mmSubscribe.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockSubscribeParams, len(mmSubscribe.callArgs))
+	copy(argCopy, mmSubscribe.callArgs)
+
+	mmSubscribe.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSubscribeDone returns true if the count of the Subscribe invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockSubscribeDone() bool {
+	if m.SubscribeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SubscribeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SubscribeMock.invocationsDone()
+}
+
+// MinimockSubscribeInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockSubscribeInspect() {
+	for _, e := range m.SubscribeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.Subscribe with params: %#v", *e.params)
+		}
+	}
+
+	afterSubscribeCounter := mm_atomic.LoadUint64(&m.afterSubscribeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SubscribeMock.defaultExpectation != nil && afterSubscribeCounter < 1 {
+		if m.SubscribeMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.Subscribe")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.Subscribe with params: %#v", *m.SubscribeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSubscribe != nil && afterSubscribeCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.Subscribe")
+	}
+
+	if !m.SubscribeMock.invocationsDone() && afterSubscribeCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.Subscribe but found %d calls",
+			mm_atomic.LoadUint64(&m.SubscribeMock.expectedInvocations), afterSubscribeCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *ChatServiceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
@@ -1029,7 +1746,11 @@ func (m *ChatServiceMock) MinimockFinish() {
 
 			m.MinimockDeleteInspect()
 
+			m.MinimockPublishEventInspect()
+
 			m.MinimockSendMessageInspect()
+
+			m.MinimockSubscribeInspect()
 		}
 	})
 }
@@ -1055,5 +1776,7 @@ func (m *ChatServiceMock) minimockDone() bool {
 	return done &&
 		m.MinimockCreateDone() &&
 		m.MinimockDeleteDone() &&
-		m.MinimockSendMessageDone()
+		m.MinimockPublishEventDone() &&
+		m.MinimockSendMessageDone() &&
+		m.MinimockSubscribeDone()
 }
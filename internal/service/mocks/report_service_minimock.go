@@ -0,0 +1,1228 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/mikhailsoldatkin/chat-server/internal/service.ReportService -o report_service_minimock.go -n ReportServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/report/model"
+)
+
+// ReportServiceMock implements service.ReportService
+type ReportServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcListReports          func(ctx context.Context, status string, limit int64, offset int64) (rpa1 []*model.Report, err error)
+	inspectFuncListReports   func(ctx context.Context, status string, limit int64, offset int64)
+	afterListReportsCounter  uint64
+	beforeListReportsCounter uint64
+	ListReportsMock          mReportServiceMockListReports
+
+	funcReportMessage          func(ctx context.Context, messageID int64, reporterID int64, reason string) (i1 int64, err error)
+	inspectFuncReportMessage   func(ctx context.Context, messageID int64, reporterID int64, reason string)
+	afterReportMessageCounter  uint64
+	beforeReportMessageCounter uint64
+	ReportMessageMock          mReportServiceMockReportMessage
+
+	funcResolveReport          func(ctx context.Context, id int64, action string, moderator string) (err error)
+	inspectFuncResolveReport   func(ctx context.Context, id int64, action string, moderator string)
+	afterResolveReportCounter  uint64
+	beforeResolveReportCounter uint64
+	ResolveReportMock          mReportServiceMockResolveReport
+}
+
+// NewReportServiceMock returns a mock for service.ReportService
+func NewReportServiceMock(t minimock.Tester) *ReportServiceMock {
+	m := &ReportServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ListReportsMock = mReportServiceMockListReports{mock: m}
+	m.ListReportsMock.callArgs = []*ReportServiceMockListReportsParams{}
+
+	m.ReportMessageMock = mReportServiceMockReportMessage{mock: m}
+	m.ReportMessageMock.callArgs = []*ReportServiceMockReportMessageParams{}
+
+	m.ResolveReportMock = mReportServiceMockResolveReport{mock: m}
+	m.ResolveReportMock.callArgs = []*ReportServiceMockResolveReportParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mReportServiceMockListReports struct {
+	optional           bool
+	mock               *ReportServiceMock
+	defaultExpectation *ReportServiceMockListReportsExpectation
+	expectations       []*ReportServiceMockListReportsExpectation
+
+	callArgs []*ReportServiceMockListReportsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ReportServiceMockListReportsExpectation specifies expectation struct of the ReportService.ListReports
+type ReportServiceMockListReportsExpectation struct {
+	mock      *ReportServiceMock
+	params    *ReportServiceMockListReportsParams
+	paramPtrs *ReportServiceMockListReportsParamPtrs
+	results   *ReportServiceMockListReportsResults
+	Counter   uint64
+}
+
+// ReportServiceMockListReportsParams contains parameters of the ReportService.ListReports
+type ReportServiceMockListReportsParams struct {
+	ctx    context.Context
+	status string
+	limit  int64
+	offset int64
+}
+
+// ReportServiceMockListReportsParamPtrs contains pointers to parameters of the ReportService.ListReports
+type ReportServiceMockListReportsParamPtrs struct {
+	ctx    *context.Context
+	status *string
+	limit  *int64
+	offset *int64
+}
+
+// ReportServiceMockListReportsResults contains results of the ReportService.ListReports
+type ReportServiceMockListReportsResults struct {
+	rpa1 []*model.Report
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListReports *mReportServiceMockListReports) Optional() *mReportServiceMockListReports {
+	mmListReports.optional = true
+	return mmListReports
+}
+
+// Expect sets up expected params for ReportService.ListReports
+func (mmListReports *mReportServiceMockListReports) Expect(ctx context.Context, status string, limit int64, offset int64) *mReportServiceMockListReports {
+	if mmListReports.mock.funcListReports != nil {
+		mmListReports.mock.t.Fatalf("ReportServiceMock.ListReports mock is already set by Set")
+	}
+
+	if mmListReports.defaultExpectation == nil {
+		mmListReports.defaultExpectation = &ReportServiceMockListReportsExpectation{}
+	}
+
+	if mmListReports.defaultExpectation.paramPtrs != nil {
+		mmListReports.mock.t.Fatalf("ReportServiceMock.ListReports mock is already set by ExpectParams functions")
+	}
+
+	mmListReports.defaultExpectation.params = &ReportServiceMockListReportsParams{ctx, status, limit, offset}
+	for _, e := range mmListReports.expectations {
+		if minimock.Equal(e.params, mmListReports.defaultExpectation.params) {
+			mmListReports.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListReports.defaultExpectation.params)
+		}
+	}
+
+	return mmListReports
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReportService.ListReports
+func (mmListReports *mReportServiceMockListReports) ExpectCtxParam1(ctx context.Context) *mReportServiceMockListReports {
+	if mmListReports.mock.funcListReports != nil {
+		mmListReports.mock.t.Fatalf("ReportServiceMock.ListReports mock is already set by Set")
+	}
+
+	if mmListReports.defaultExpectation == nil {
+		mmListReports.defaultExpectation = &ReportServiceMockListReportsExpectation{}
+	}
+
+	if mmListReports.defaultExpectation.params != nil {
+		mmListReports.mock.t.Fatalf("ReportServiceMock.ListReports mock is already set by Expect")
+	}
+
+	if mmListReports.defaultExpectation.paramPtrs == nil {
+		mmListReports.defaultExpectation.paramPtrs = &ReportServiceMockListReportsParamPtrs{}
+	}
+	mmListReports.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListReports
+}
+
+// ExpectStatusParam2 sets up expected param status for ReportService.ListReports
+func (mmListReports *mReportServiceMockListReports) ExpectStatusParam2(status string) *mReportServiceMockListReports {
+	if mmListReports.mock.funcListReports != nil {
+		mmListReports.mock.t.Fatalf("ReportServiceMock.ListReports mock is already set by Set")
+	}
+
+	if mmListReports.defaultExpectation == nil {
+		mmListReports.defaultExpectation = &ReportServiceMockListReportsExpectation{}
+	}
+
+	if mmListReports.defaultExpectation.params != nil {
+		mmListReports.mock.t.Fatalf("ReportServiceMock.ListReports mock is already set by Expect")
+	}
+
+	if mmListReports.defaultExpectation.paramPtrs == nil {
+		mmListReports.defaultExpectation.paramPtrs = &ReportServiceMockListReportsParamPtrs{}
+	}
+	mmListReports.defaultExpectation.paramPtrs.status = &status
+
+	return mmListReports
+}
+
+// ExpectLimitParam3 sets up expected param limit for ReportService.ListReports
+func (mmListReports *mReportServiceMockListReports) ExpectLimitParam3(limit int64) *mReportServiceMockListReports {
+	if mmListReports.mock.funcListReports != nil {
+		mmListReports.mock.t.Fatalf("ReportServiceMock.ListReports mock is already set by Set")
+	}
+
+	if mmListReports.defaultExpectation == nil {
+		mmListReports.defaultExpectation = &ReportServiceMockListReportsExpectation{}
+	}
+
+	if mmListReports.defaultExpectation.params != nil {
+		mmListReports.mock.t.Fatalf("ReportServiceMock.ListReports mock is already set by Expect")
+	}
+
+	if mmListReports.defaultExpectation.paramPtrs == nil {
+		mmListReports.defaultExpectation.paramPtrs = &ReportServiceMockListReportsParamPtrs{}
+	}
+	mmListReports.defaultExpectation.paramPtrs.limit = &limit
+
+	return mmListReports
+}
+
+// ExpectOffsetParam4 sets up expected param offset for ReportService.ListReports
+func (mmListReports *mReportServiceMockListReports) ExpectOffsetParam4(offset int64) *mReportServiceMockListReports {
+	if mmListReports.mock.funcListReports != nil {
+		mmListReports.mock.t.Fatalf("ReportServiceMock.ListReports mock is already set by Set")
+	}
+
+	if mmListReports.defaultExpectation == nil {
+		mmListReports.defaultExpectation = &ReportServiceMockListReportsExpectation{}
+	}
+
+	if mmListReports.defaultExpectation.params != nil {
+		mmListReports.mock.t.Fatalf("ReportServiceMock.ListReports mock is already set by Expect")
+	}
+
+	if mmListReports.defaultExpectation.paramPtrs == nil {
+		mmListReports.defaultExpectation.paramPtrs = &ReportServiceMockListReportsParamPtrs{}
+	}
+	mmListReports.defaultExpectation.paramPtrs.offset = &offset
+
+	return mmListReports
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReportService.ListReports
+func (mmListReports *mReportServiceMockListReports) Inspect(f func(ctx context.Context, status string, limit int64, offset int64)) *mReportServiceMockListReports {
+	if mmListReports.mock.inspectFuncListReports != nil {
+		mmListReports.mock.t.Fatalf("Inspect function is already set for ReportServiceMock.ListReports")
+	}
+
+	mmListReports.mock.inspectFuncListReports = f
+
+	return mmListReports
+}
+
+// Return sets up results that will be returned by ReportService.ListReports
+func (mmListReports *mReportServiceMockListReports) Return(rpa1 []*model.Report, err error) *ReportServiceMock {
+	if mmListReports.mock.funcListReports != nil {
+		mmListReports.mock.t.Fatalf("ReportServiceMock.ListReports mock is already set by Set")
+	}
+
+	if mmListReports.defaultExpectation == nil {
+		mmListReports.defaultExpectation = &ReportServiceMockListReportsExpectation{mock: mmListReports.mock}
+	}
+	mmListReports.defaultExpectation.results = &ReportServiceMockListReportsResults{rpa1, err}
+	return mmListReports.mock
+}
+
+// Set uses given function f to mock the ReportService.ListReports method
+func (mmListReports *mReportServiceMockListReports) Set(f func(ctx context.Context, status string, limit int64, offset int64) (rpa1 []*model.Report, err error)) *ReportServiceMock {
+	if mmListReports.defaultExpectation != nil {
+		mmListReports.mock.t.Fatalf("Default expectation is already set for the ReportService.ListReports method")
+	}
+
+	if len(mmListReports.expectations) > 0 {
+		mmListReports.mock.t.Fatalf("Some expectations are already set for the ReportService.ListReports method")
+	}
+
+	mmListReports.mock.funcListReports = f
+	return mmListReports.mock
+}
+
+// When sets expectation for the ReportService.ListReports which will trigger the result defined by the following
+// Then helper
+func (mmListReports *mReportServiceMockListReports) When(ctx context.Context, status string, limit int64, offset int64) *ReportServiceMockListReportsExpectation {
+	if mmListReports.mock.funcListReports != nil {
+		mmListReports.mock.t.Fatalf("ReportServiceMock.ListReports mock is already set by Set")
+	}
+
+	expectation := &ReportServiceMockListReportsExpectation{
+		mock:   mmListReports.mock,
+		params: &ReportServiceMockListReportsParams{ctx, status, limit, offset},
+	}
+	mmListReports.expectations = append(mmListReports.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReportService.ListReports return parameters for the expectation previously defined by the When method
+func (e *ReportServiceMockListReportsExpectation) Then(rpa1 []*model.Report, err error) *ReportServiceMock {
+	e.results = &ReportServiceMockListReportsResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ReportService.ListReports should be invoked
+func (mmListReports *mReportServiceMockListReports) Times(n uint64) *mReportServiceMockListReports {
+	if n == 0 {
+		mmListReports.mock.t.Fatalf("Times of ReportServiceMock.ListReports mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListReports.expectedInvocations, n)
+	return mmListReports
+}
+
+func (mmListReports *mReportServiceMockListReports) invocationsDone() bool {
+	if len(mmListReports.expectations) == 0 && mmListReports.defaultExpectation == nil && mmListReports.mock.funcListReports == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListReports.mock.afterListReportsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListReports.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListReports implements service.ReportService
+func (mmListReports *ReportServiceMock) ListReports(ctx context.Context, status string, limit int64, offset int64) (rpa1 []*model.Report, err error) {
+	mm_atomic.AddUint64(&mmListReports.beforeListReportsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListReports.afterListReportsCounter, 1)
+
+	if mmListReports.inspectFuncListReports != nil {
+		mmListReports.inspectFuncListReports(ctx, status, limit, offset)
+	}
+
+	mm_params := ReportServiceMockListReportsParams{ctx, status, limit, offset}
+
+	// Record call args
+	mmListReports.ListReportsMock.mutex.Lock()
+	mmListReports.ListReportsMock.callArgs = append(mmListReports.ListReportsMock.callArgs, &mm_params)
+	mmListReports.ListReportsMock.mutex.Unlock()
+
+	for _, e := range mmListReports.ListReportsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmListReports.ListReportsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListReports.ListReportsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListReports.ListReportsMock.defaultExpectation.params
+		mm_want_ptrs := mmListReports.ListReportsMock.defaultExpectation.paramPtrs
+
+		mm_got := ReportServiceMockListReportsParams{ctx, status, limit, offset}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListReports.t.Errorf("ReportServiceMock.ListReports got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.status != nil && !minimock.Equal(*mm_want_ptrs.status, mm_got.status) {
+				mmListReports.t.Errorf("ReportServiceMock.ListReports got unexpected parameter status, want: %#v, got: %#v%s\n", *mm_want_ptrs.status, mm_got.status, minimock.Diff(*mm_want_ptrs.status, mm_got.status))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListReports.t.Errorf("ReportServiceMock.ListReports got unexpected parameter limit, want: %#v, got: %#v%s\n", *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+			if mm_want_ptrs.offset != nil && !minimock.Equal(*mm_want_ptrs.offset, mm_got.offset) {
+				mmListReports.t.Errorf("ReportServiceMock.ListReports got unexpected parameter offset, want: %#v, got: %#v%s\n", *mm_want_ptrs.offset, mm_got.offset, minimock.Diff(*mm_want_ptrs.offset, mm_got.offset))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListReports.t.Errorf("ReportServiceMock.ListReports got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListReports.ListReportsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListReports.t.Fatal("No results are set for the ReportServiceMock.ListReports")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmListReports.funcListReports != nil {
+		return mmListReports.funcListReports(ctx, status, limit, offset)
+	}
+	mmListReports.t.Fatalf("Unexpected call to ReportServiceMock.ListReports. %v %v %v %v", ctx, status, limit, offset)
+	return
+}
+
+// ListReportsAfterCounter returns a count of finished ReportServiceMock.ListReports invocations
+func (mmListReports *ReportServiceMock) ListReportsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListReports.afterListReportsCounter)
+}
+
+// ListReportsBeforeCounter returns a count of ReportServiceMock.ListReports invocations
+func (mmListReports *ReportServiceMock) ListReportsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListReports.beforeListReportsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReportServiceMock.ListReports.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListReports *mReportServiceMockListReports) Calls() []*ReportServiceMockListReportsParams {
+	mmListReports.mutex.RLock()
+
+	argCopy := make([]*ReportServiceMockListReportsParams, len(mmListReports.callArgs))
+	copy(argCopy, mmListReports.callArgs)
+
+	mmListReports.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListReportsDone returns true if the count of the ListReports invocations corresponds
+// the number of defined expectations
+func (m *ReportServiceMock) MinimockListReportsDone() bool {
+	if m.ListReportsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListReportsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListReportsMock.invocationsDone()
+}
+
+// MinimockListReportsInspect logs each unmet expectation
+func (m *ReportServiceMock) MinimockListReportsInspect() {
+	for _, e := range m.ListReportsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReportServiceMock.ListReports with params: %#v", *e.params)
+		}
+	}
+
+	afterListReportsCounter := mm_atomic.LoadUint64(&m.afterListReportsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListReportsMock.defaultExpectation != nil && afterListReportsCounter < 1 {
+		if m.ListReportsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ReportServiceMock.ListReports")
+		} else {
+			m.t.Errorf("Expected call to ReportServiceMock.ListReports with params: %#v", *m.ListReportsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListReports != nil && afterListReportsCounter < 1 {
+		m.t.Error("Expected call to ReportServiceMock.ListReports")
+	}
+
+	if !m.ListReportsMock.invocationsDone() && afterListReportsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReportServiceMock.ListReports but found %d calls",
+			mm_atomic.LoadUint64(&m.ListReportsMock.expectedInvocations), afterListReportsCounter)
+	}
+}
+
+type mReportServiceMockReportMessage struct {
+	optional           bool
+	mock               *ReportServiceMock
+	defaultExpectation *ReportServiceMockReportMessageExpectation
+	expectations       []*ReportServiceMockReportMessageExpectation
+
+	callArgs []*ReportServiceMockReportMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ReportServiceMockReportMessageExpectation specifies expectation struct of the ReportService.ReportMessage
+type ReportServiceMockReportMessageExpectation struct {
+	mock      *ReportServiceMock
+	params    *ReportServiceMockReportMessageParams
+	paramPtrs *ReportServiceMockReportMessageParamPtrs
+	results   *ReportServiceMockReportMessageResults
+	Counter   uint64
+}
+
+// ReportServiceMockReportMessageParams contains parameters of the ReportService.ReportMessage
+type ReportServiceMockReportMessageParams struct {
+	ctx        context.Context
+	messageID  int64
+	reporterID int64
+	reason     string
+}
+
+// ReportServiceMockReportMessageParamPtrs contains pointers to parameters of the ReportService.ReportMessage
+type ReportServiceMockReportMessageParamPtrs struct {
+	ctx        *context.Context
+	messageID  *int64
+	reporterID *int64
+	reason     *string
+}
+
+// ReportServiceMockReportMessageResults contains results of the ReportService.ReportMessage
+type ReportServiceMockReportMessageResults struct {
+	i1  int64
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReportMessage *mReportServiceMockReportMessage) Optional() *mReportServiceMockReportMessage {
+	mmReportMessage.optional = true
+	return mmReportMessage
+}
+
+// Expect sets up expected params for ReportService.ReportMessage
+func (mmReportMessage *mReportServiceMockReportMessage) Expect(ctx context.Context, messageID int64, reporterID int64, reason string) *mReportServiceMockReportMessage {
+	if mmReportMessage.mock.funcReportMessage != nil {
+		mmReportMessage.mock.t.Fatalf("ReportServiceMock.ReportMessage mock is already set by Set")
+	}
+
+	if mmReportMessage.defaultExpectation == nil {
+		mmReportMessage.defaultExpectation = &ReportServiceMockReportMessageExpectation{}
+	}
+
+	if mmReportMessage.defaultExpectation.paramPtrs != nil {
+		mmReportMessage.mock.t.Fatalf("ReportServiceMock.ReportMessage mock is already set by ExpectParams functions")
+	}
+
+	mmReportMessage.defaultExpectation.params = &ReportServiceMockReportMessageParams{ctx, messageID, reporterID, reason}
+	for _, e := range mmReportMessage.expectations {
+		if minimock.Equal(e.params, mmReportMessage.defaultExpectation.params) {
+			mmReportMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReportMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmReportMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReportService.ReportMessage
+func (mmReportMessage *mReportServiceMockReportMessage) ExpectCtxParam1(ctx context.Context) *mReportServiceMockReportMessage {
+	if mmReportMessage.mock.funcReportMessage != nil {
+		mmReportMessage.mock.t.Fatalf("ReportServiceMock.ReportMessage mock is already set by Set")
+	}
+
+	if mmReportMessage.defaultExpectation == nil {
+		mmReportMessage.defaultExpectation = &ReportServiceMockReportMessageExpectation{}
+	}
+
+	if mmReportMessage.defaultExpectation.params != nil {
+		mmReportMessage.mock.t.Fatalf("ReportServiceMock.ReportMessage mock is already set by Expect")
+	}
+
+	if mmReportMessage.defaultExpectation.paramPtrs == nil {
+		mmReportMessage.defaultExpectation.paramPtrs = &ReportServiceMockReportMessageParamPtrs{}
+	}
+	mmReportMessage.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmReportMessage
+}
+
+// ExpectMessageIDParam2 sets up expected param messageID for ReportService.ReportMessage
+func (mmReportMessage *mReportServiceMockReportMessage) ExpectMessageIDParam2(messageID int64) *mReportServiceMockReportMessage {
+	if mmReportMessage.mock.funcReportMessage != nil {
+		mmReportMessage.mock.t.Fatalf("ReportServiceMock.ReportMessage mock is already set by Set")
+	}
+
+	if mmReportMessage.defaultExpectation == nil {
+		mmReportMessage.defaultExpectation = &ReportServiceMockReportMessageExpectation{}
+	}
+
+	if mmReportMessage.defaultExpectation.params != nil {
+		mmReportMessage.mock.t.Fatalf("ReportServiceMock.ReportMessage mock is already set by Expect")
+	}
+
+	if mmReportMessage.defaultExpectation.paramPtrs == nil {
+		mmReportMessage.defaultExpectation.paramPtrs = &ReportServiceMockReportMessageParamPtrs{}
+	}
+	mmReportMessage.defaultExpectation.paramPtrs.messageID = &messageID
+
+	return mmReportMessage
+}
+
+// ExpectReporterIDParam3 sets up expected param reporterID for ReportService.ReportMessage
+func (mmReportMessage *mReportServiceMockReportMessage) ExpectReporterIDParam3(reporterID int64) *mReportServiceMockReportMessage {
+	if mmReportMessage.mock.funcReportMessage != nil {
+		mmReportMessage.mock.t.Fatalf("ReportServiceMock.ReportMessage mock is already set by Set")
+	}
+
+	if mmReportMessage.defaultExpectation == nil {
+		mmReportMessage.defaultExpectation = &ReportServiceMockReportMessageExpectation{}
+	}
+
+	if mmReportMessage.defaultExpectation.params != nil {
+		mmReportMessage.mock.t.Fatalf("ReportServiceMock.ReportMessage mock is already set by Expect")
+	}
+
+	if mmReportMessage.defaultExpectation.paramPtrs == nil {
+		mmReportMessage.defaultExpectation.paramPtrs = &ReportServiceMockReportMessageParamPtrs{}
+	}
+	mmReportMessage.defaultExpectation.paramPtrs.reporterID = &reporterID
+
+	return mmReportMessage
+}
+
+// ExpectReasonParam4 sets up expected param reason for ReportService.ReportMessage
+func (mmReportMessage *mReportServiceMockReportMessage) ExpectReasonParam4(reason string) *mReportServiceMockReportMessage {
+	if mmReportMessage.mock.funcReportMessage != nil {
+		mmReportMessage.mock.t.Fatalf("ReportServiceMock.ReportMessage mock is already set by Set")
+	}
+
+	if mmReportMessage.defaultExpectation == nil {
+		mmReportMessage.defaultExpectation = &ReportServiceMockReportMessageExpectation{}
+	}
+
+	if mmReportMessage.defaultExpectation.params != nil {
+		mmReportMessage.mock.t.Fatalf("ReportServiceMock.ReportMessage mock is already set by Expect")
+	}
+
+	if mmReportMessage.defaultExpectation.paramPtrs == nil {
+		mmReportMessage.defaultExpectation.paramPtrs = &ReportServiceMockReportMessageParamPtrs{}
+	}
+	mmReportMessage.defaultExpectation.paramPtrs.reason = &reason
+
+	return mmReportMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReportService.ReportMessage
+func (mmReportMessage *mReportServiceMockReportMessage) Inspect(f func(ctx context.Context, messageID int64, reporterID int64, reason string)) *mReportServiceMockReportMessage {
+	if mmReportMessage.mock.inspectFuncReportMessage != nil {
+		mmReportMessage.mock.t.Fatalf("Inspect function is already set for ReportServiceMock.ReportMessage")
+	}
+
+	mmReportMessage.mock.inspectFuncReportMessage = f
+
+	return mmReportMessage
+}
+
+// Return sets up results that will be returned by ReportService.ReportMessage
+func (mmReportMessage *mReportServiceMockReportMessage) Return(i1 int64, err error) *ReportServiceMock {
+	if mmReportMessage.mock.funcReportMessage != nil {
+		mmReportMessage.mock.t.Fatalf("ReportServiceMock.ReportMessage mock is already set by Set")
+	}
+
+	if mmReportMessage.defaultExpectation == nil {
+		mmReportMessage.defaultExpectation = &ReportServiceMockReportMessageExpectation{mock: mmReportMessage.mock}
+	}
+	mmReportMessage.defaultExpectation.results = &ReportServiceMockReportMessageResults{i1, err}
+	return mmReportMessage.mock
+}
+
+// Set uses given function f to mock the ReportService.ReportMessage method
+func (mmReportMessage *mReportServiceMockReportMessage) Set(f func(ctx context.Context, messageID int64, reporterID int64, reason string) (i1 int64, err error)) *ReportServiceMock {
+	if mmReportMessage.defaultExpectation != nil {
+		mmReportMessage.mock.t.Fatalf("Default expectation is already set for the ReportService.ReportMessage method")
+	}
+
+	if len(mmReportMessage.expectations) > 0 {
+		mmReportMessage.mock.t.Fatalf("Some expectations are already set for the ReportService.ReportMessage method")
+	}
+
+	mmReportMessage.mock.funcReportMessage = f
+	return mmReportMessage.mock
+}
+
+// When sets expectation for the ReportService.ReportMessage which will trigger the result defined by the following
+// Then helper
+func (mmReportMessage *mReportServiceMockReportMessage) When(ctx context.Context, messageID int64, reporterID int64, reason string) *ReportServiceMockReportMessageExpectation {
+	if mmReportMessage.mock.funcReportMessage != nil {
+		mmReportMessage.mock.t.Fatalf("ReportServiceMock.ReportMessage mock is already set by Set")
+	}
+
+	expectation := &ReportServiceMockReportMessageExpectation{
+		mock:   mmReportMessage.mock,
+		params: &ReportServiceMockReportMessageParams{ctx, messageID, reporterID, reason},
+	}
+	mmReportMessage.expectations = append(mmReportMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReportService.ReportMessage return parameters for the expectation previously defined by the When method
+func (e *ReportServiceMockReportMessageExpectation) Then(i1 int64, err error) *ReportServiceMock {
+	e.results = &ReportServiceMockReportMessageResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ReportService.ReportMessage should be invoked
+func (mmReportMessage *mReportServiceMockReportMessage) Times(n uint64) *mReportServiceMockReportMessage {
+	if n == 0 {
+		mmReportMessage.mock.t.Fatalf("Times of ReportServiceMock.ReportMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReportMessage.expectedInvocations, n)
+	return mmReportMessage
+}
+
+func (mmReportMessage *mReportServiceMockReportMessage) invocationsDone() bool {
+	if len(mmReportMessage.expectations) == 0 && mmReportMessage.defaultExpectation == nil && mmReportMessage.mock.funcReportMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReportMessage.mock.afterReportMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReportMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReportMessage implements service.ReportService
+func (mmReportMessage *ReportServiceMock) ReportMessage(ctx context.Context, messageID int64, reporterID int64, reason string) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmReportMessage.beforeReportMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmReportMessage.afterReportMessageCounter, 1)
+
+	if mmReportMessage.inspectFuncReportMessage != nil {
+		mmReportMessage.inspectFuncReportMessage(ctx, messageID, reporterID, reason)
+	}
+
+	mm_params := ReportServiceMockReportMessageParams{ctx, messageID, reporterID, reason}
+
+	// Record call args
+	mmReportMessage.ReportMessageMock.mutex.Lock()
+	mmReportMessage.ReportMessageMock.callArgs = append(mmReportMessage.ReportMessageMock.callArgs, &mm_params)
+	mmReportMessage.ReportMessageMock.mutex.Unlock()
+
+	for _, e := range mmReportMessage.ReportMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmReportMessage.ReportMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReportMessage.ReportMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmReportMessage.ReportMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmReportMessage.ReportMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ReportServiceMockReportMessageParams{ctx, messageID, reporterID, reason}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReportMessage.t.Errorf("ReportServiceMock.ReportMessage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmReportMessage.t.Errorf("ReportServiceMock.ReportMessage got unexpected parameter messageID, want: %#v, got: %#v%s\n", *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+			if mm_want_ptrs.reporterID != nil && !minimock.Equal(*mm_want_ptrs.reporterID, mm_got.reporterID) {
+				mmReportMessage.t.Errorf("ReportServiceMock.ReportMessage got unexpected parameter reporterID, want: %#v, got: %#v%s\n", *mm_want_ptrs.reporterID, mm_got.reporterID, minimock.Diff(*mm_want_ptrs.reporterID, mm_got.reporterID))
+			}
+
+			if mm_want_ptrs.reason != nil && !minimock.Equal(*mm_want_ptrs.reason, mm_got.reason) {
+				mmReportMessage.t.Errorf("ReportServiceMock.ReportMessage got unexpected parameter reason, want: %#v, got: %#v%s\n", *mm_want_ptrs.reason, mm_got.reason, minimock.Diff(*mm_want_ptrs.reason, mm_got.reason))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReportMessage.t.Errorf("ReportServiceMock.ReportMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReportMessage.ReportMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReportMessage.t.Fatal("No results are set for the ReportServiceMock.ReportMessage")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmReportMessage.funcReportMessage != nil {
+		return mmReportMessage.funcReportMessage(ctx, messageID, reporterID, reason)
+	}
+	mmReportMessage.t.Fatalf("Unexpected call to ReportServiceMock.ReportMessage. %v %v %v %v", ctx, messageID, reporterID, reason)
+	return
+}
+
+// ReportMessageAfterCounter returns a count of finished ReportServiceMock.ReportMessage invocations
+func (mmReportMessage *ReportServiceMock) ReportMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReportMessage.afterReportMessageCounter)
+}
+
+// ReportMessageBeforeCounter returns a count of ReportServiceMock.ReportMessage invocations
+func (mmReportMessage *ReportServiceMock) ReportMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReportMessage.beforeReportMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReportServiceMock.ReportMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReportMessage *mReportServiceMockReportMessage) Calls() []*ReportServiceMockReportMessageParams {
+	mmReportMessage.mutex.RLock()
+
+	argCopy := make([]*ReportServiceMockReportMessageParams, len(mmReportMessage.callArgs))
+	copy(argCopy, mmReportMessage.callArgs)
+
+	mmReportMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReportMessageDone returns true if the count of the ReportMessage invocations corresponds
+// the number of defined expectations
+func (m *ReportServiceMock) MinimockReportMessageDone() bool {
+	if m.ReportMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReportMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReportMessageMock.invocationsDone()
+}
+
+// MinimockReportMessageInspect logs each unmet expectation
+func (m *ReportServiceMock) MinimockReportMessageInspect() {
+	for _, e := range m.ReportMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReportServiceMock.ReportMessage with params: %#v", *e.params)
+		}
+	}
+
+	afterReportMessageCounter := mm_atomic.LoadUint64(&m.afterReportMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReportMessageMock.defaultExpectation != nil && afterReportMessageCounter < 1 {
+		if m.ReportMessageMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ReportServiceMock.ReportMessage")
+		} else {
+			m.t.Errorf("Expected call to ReportServiceMock.ReportMessage with params: %#v", *m.ReportMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReportMessage != nil && afterReportMessageCounter < 1 {
+		m.t.Error("Expected call to ReportServiceMock.ReportMessage")
+	}
+
+	if !m.ReportMessageMock.invocationsDone() && afterReportMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReportServiceMock.ReportMessage but found %d calls",
+			mm_atomic.LoadUint64(&m.ReportMessageMock.expectedInvocations), afterReportMessageCounter)
+	}
+}
+
+type mReportServiceMockResolveReport struct {
+	optional           bool
+	mock               *ReportServiceMock
+	defaultExpectation *ReportServiceMockResolveReportExpectation
+	expectations       []*ReportServiceMockResolveReportExpectation
+
+	callArgs []*ReportServiceMockResolveReportParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ReportServiceMockResolveReportExpectation specifies expectation struct of the ReportService.ResolveReport
+type ReportServiceMockResolveReportExpectation struct {
+	mock      *ReportServiceMock
+	params    *ReportServiceMockResolveReportParams
+	paramPtrs *ReportServiceMockResolveReportParamPtrs
+	results   *ReportServiceMockResolveReportResults
+	Counter   uint64
+}
+
+// ReportServiceMockResolveReportParams contains parameters of the ReportService.ResolveReport
+type ReportServiceMockResolveReportParams struct {
+	ctx       context.Context
+	id        int64
+	action    string
+	moderator string
+}
+
+// ReportServiceMockResolveReportParamPtrs contains pointers to parameters of the ReportService.ResolveReport
+type ReportServiceMockResolveReportParamPtrs struct {
+	ctx       *context.Context
+	id        *int64
+	action    *string
+	moderator *string
+}
+
+// ReportServiceMockResolveReportResults contains results of the ReportService.ResolveReport
+type ReportServiceMockResolveReportResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmResolveReport *mReportServiceMockResolveReport) Optional() *mReportServiceMockResolveReport {
+	mmResolveReport.optional = true
+	return mmResolveReport
+}
+
+// Expect sets up expected params for ReportService.ResolveReport
+func (mmResolveReport *mReportServiceMockResolveReport) Expect(ctx context.Context, id int64, action string, moderator string) *mReportServiceMockResolveReport {
+	if mmResolveReport.mock.funcResolveReport != nil {
+		mmResolveReport.mock.t.Fatalf("ReportServiceMock.ResolveReport mock is already set by Set")
+	}
+
+	if mmResolveReport.defaultExpectation == nil {
+		mmResolveReport.defaultExpectation = &ReportServiceMockResolveReportExpectation{}
+	}
+
+	if mmResolveReport.defaultExpectation.paramPtrs != nil {
+		mmResolveReport.mock.t.Fatalf("ReportServiceMock.ResolveReport mock is already set by ExpectParams functions")
+	}
+
+	mmResolveReport.defaultExpectation.params = &ReportServiceMockResolveReportParams{ctx, id, action, moderator}
+	for _, e := range mmResolveReport.expectations {
+		if minimock.Equal(e.params, mmResolveReport.defaultExpectation.params) {
+			mmResolveReport.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmResolveReport.defaultExpectation.params)
+		}
+	}
+
+	return mmResolveReport
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReportService.ResolveReport
+func (mmResolveReport *mReportServiceMockResolveReport) ExpectCtxParam1(ctx context.Context) *mReportServiceMockResolveReport {
+	if mmResolveReport.mock.funcResolveReport != nil {
+		mmResolveReport.mock.t.Fatalf("ReportServiceMock.ResolveReport mock is already set by Set")
+	}
+
+	if mmResolveReport.defaultExpectation == nil {
+		mmResolveReport.defaultExpectation = &ReportServiceMockResolveReportExpectation{}
+	}
+
+	if mmResolveReport.defaultExpectation.params != nil {
+		mmResolveReport.mock.t.Fatalf("ReportServiceMock.ResolveReport mock is already set by Expect")
+	}
+
+	if mmResolveReport.defaultExpectation.paramPtrs == nil {
+		mmResolveReport.defaultExpectation.paramPtrs = &ReportServiceMockResolveReportParamPtrs{}
+	}
+	mmResolveReport.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmResolveReport
+}
+
+// ExpectIdParam2 sets up expected param id for ReportService.ResolveReport
+func (mmResolveReport *mReportServiceMockResolveReport) ExpectIdParam2(id int64) *mReportServiceMockResolveReport {
+	if mmResolveReport.mock.funcResolveReport != nil {
+		mmResolveReport.mock.t.Fatalf("ReportServiceMock.ResolveReport mock is already set by Set")
+	}
+
+	if mmResolveReport.defaultExpectation == nil {
+		mmResolveReport.defaultExpectation = &ReportServiceMockResolveReportExpectation{}
+	}
+
+	if mmResolveReport.defaultExpectation.params != nil {
+		mmResolveReport.mock.t.Fatalf("ReportServiceMock.ResolveReport mock is already set by Expect")
+	}
+
+	if mmResolveReport.defaultExpectation.paramPtrs == nil {
+		mmResolveReport.defaultExpectation.paramPtrs = &ReportServiceMockResolveReportParamPtrs{}
+	}
+	mmResolveReport.defaultExpectation.paramPtrs.id = &id
+
+	return mmResolveReport
+}
+
+// ExpectActionParam3 sets up expected param action for ReportService.ResolveReport
+func (mmResolveReport *mReportServiceMockResolveReport) ExpectActionParam3(action string) *mReportServiceMockResolveReport {
+	if mmResolveReport.mock.funcResolveReport != nil {
+		mmResolveReport.mock.t.Fatalf("ReportServiceMock.ResolveReport mock is already set by Set")
+	}
+
+	if mmResolveReport.defaultExpectation == nil {
+		mmResolveReport.defaultExpectation = &ReportServiceMockResolveReportExpectation{}
+	}
+
+	if mmResolveReport.defaultExpectation.params != nil {
+		mmResolveReport.mock.t.Fatalf("ReportServiceMock.ResolveReport mock is already set by Expect")
+	}
+
+	if mmResolveReport.defaultExpectation.paramPtrs == nil {
+		mmResolveReport.defaultExpectation.paramPtrs = &ReportServiceMockResolveReportParamPtrs{}
+	}
+	mmResolveReport.defaultExpectation.paramPtrs.action = &action
+
+	return mmResolveReport
+}
+
+// ExpectModeratorParam4 sets up expected param moderator for ReportService.ResolveReport
+func (mmResolveReport *mReportServiceMockResolveReport) ExpectModeratorParam4(moderator string) *mReportServiceMockResolveReport {
+	if mmResolveReport.mock.funcResolveReport != nil {
+		mmResolveReport.mock.t.Fatalf("ReportServiceMock.ResolveReport mock is already set by Set")
+	}
+
+	if mmResolveReport.defaultExpectation == nil {
+		mmResolveReport.defaultExpectation = &ReportServiceMockResolveReportExpectation{}
+	}
+
+	if mmResolveReport.defaultExpectation.params != nil {
+		mmResolveReport.mock.t.Fatalf("ReportServiceMock.ResolveReport mock is already set by Expect")
+	}
+
+	if mmResolveReport.defaultExpectation.paramPtrs == nil {
+		mmResolveReport.defaultExpectation.paramPtrs = &ReportServiceMockResolveReportParamPtrs{}
+	}
+	mmResolveReport.defaultExpectation.paramPtrs.moderator = &moderator
+
+	return mmResolveReport
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReportService.ResolveReport
+func (mmResolveReport *mReportServiceMockResolveReport) Inspect(f func(ctx context.Context, id int64, action string, moderator string)) *mReportServiceMockResolveReport {
+	if mmResolveReport.mock.inspectFuncResolveReport != nil {
+		mmResolveReport.mock.t.Fatalf("Inspect function is already set for ReportServiceMock.ResolveReport")
+	}
+
+	mmResolveReport.mock.inspectFuncResolveReport = f
+
+	return mmResolveReport
+}
+
+// Return sets up results that will be returned by ReportService.ResolveReport
+func (mmResolveReport *mReportServiceMockResolveReport) Return(err error) *ReportServiceMock {
+	if mmResolveReport.mock.funcResolveReport != nil {
+		mmResolveReport.mock.t.Fatalf("ReportServiceMock.ResolveReport mock is already set by Set")
+	}
+
+	if mmResolveReport.defaultExpectation == nil {
+		mmResolveReport.defaultExpectation = &ReportServiceMockResolveReportExpectation{mock: mmResolveReport.mock}
+	}
+	mmResolveReport.defaultExpectation.results = &ReportServiceMockResolveReportResults{err}
+	return mmResolveReport.mock
+}
+
+// Set uses given function f to mock the ReportService.ResolveReport method
+func (mmResolveReport *mReportServiceMockResolveReport) Set(f func(ctx context.Context, id int64, action string, moderator string) (err error)) *ReportServiceMock {
+	if mmResolveReport.defaultExpectation != nil {
+		mmResolveReport.mock.t.Fatalf("Default expectation is already set for the ReportService.ResolveReport method")
+	}
+
+	if len(mmResolveReport.expectations) > 0 {
+		mmResolveReport.mock.t.Fatalf("Some expectations are already set for the ReportService.ResolveReport method")
+	}
+
+	mmResolveReport.mock.funcResolveReport = f
+	return mmResolveReport.mock
+}
+
+// When sets expectation for the ReportService.ResolveReport which will trigger the result defined by the following
+// Then helper
+func (mmResolveReport *mReportServiceMockResolveReport) When(ctx context.Context, id int64, action string, moderator string) *ReportServiceMockResolveReportExpectation {
+	if mmResolveReport.mock.funcResolveReport != nil {
+		mmResolveReport.mock.t.Fatalf("ReportServiceMock.ResolveReport mock is already set by Set")
+	}
+
+	expectation := &ReportServiceMockResolveReportExpectation{
+		mock:   mmResolveReport.mock,
+		params: &ReportServiceMockResolveReportParams{ctx, id, action, moderator},
+	}
+	mmResolveReport.expectations = append(mmResolveReport.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReportService.ResolveReport return parameters for the expectation previously defined by the When method
+func (e *ReportServiceMockResolveReportExpectation) Then(err error) *ReportServiceMock {
+	e.results = &ReportServiceMockResolveReportResults{err}
+	return e.mock
+}
+
+// Times sets number of times ReportService.ResolveReport should be invoked
+func (mmResolveReport *mReportServiceMockResolveReport) Times(n uint64) *mReportServiceMockResolveReport {
+	if n == 0 {
+		mmResolveReport.mock.t.Fatalf("Times of ReportServiceMock.ResolveReport mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmResolveReport.expectedInvocations, n)
+	return mmResolveReport
+}
+
+func (mmResolveReport *mReportServiceMockResolveReport) invocationsDone() bool {
+	if len(mmResolveReport.expectations) == 0 && mmResolveReport.defaultExpectation == nil && mmResolveReport.mock.funcResolveReport == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmResolveReport.mock.afterResolveReportCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmResolveReport.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ResolveReport implements service.ReportService
+func (mmResolveReport *ReportServiceMock) ResolveReport(ctx context.Context, id int64, action string, moderator string) (err error) {
+	mm_atomic.AddUint64(&mmResolveReport.beforeResolveReportCounter, 1)
+	defer mm_atomic.AddUint64(&mmResolveReport.afterResolveReportCounter, 1)
+
+	if mmResolveReport.inspectFuncResolveReport != nil {
+		mmResolveReport.inspectFuncResolveReport(ctx, id, action, moderator)
+	}
+
+	mm_params := ReportServiceMockResolveReportParams{ctx, id, action, moderator}
+
+	// Record call args
+	mmResolveReport.ResolveReportMock.mutex.Lock()
+	mmResolveReport.ResolveReportMock.callArgs = append(mmResolveReport.ResolveReportMock.callArgs, &mm_params)
+	mmResolveReport.ResolveReportMock.mutex.Unlock()
+
+	for _, e := range mmResolveReport.ResolveReportMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmResolveReport.ResolveReportMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmResolveReport.ResolveReportMock.defaultExpectation.Counter, 1)
+		mm_want := mmResolveReport.ResolveReportMock.defaultExpectation.params
+		mm_want_ptrs := mmResolveReport.ResolveReportMock.defaultExpectation.paramPtrs
+
+		mm_got := ReportServiceMockResolveReportParams{ctx, id, action, moderator}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmResolveReport.t.Errorf("ReportServiceMock.ResolveReport got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmResolveReport.t.Errorf("ReportServiceMock.ResolveReport got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.action != nil && !minimock.Equal(*mm_want_ptrs.action, mm_got.action) {
+				mmResolveReport.t.Errorf("ReportServiceMock.ResolveReport got unexpected parameter action, want: %#v, got: %#v%s\n", *mm_want_ptrs.action, mm_got.action, minimock.Diff(*mm_want_ptrs.action, mm_got.action))
+			}
+
+			if mm_want_ptrs.moderator != nil && !minimock.Equal(*mm_want_ptrs.moderator, mm_got.moderator) {
+				mmResolveReport.t.Errorf("ReportServiceMock.ResolveReport got unexpected parameter moderator, want: %#v, got: %#v%s\n", *mm_want_ptrs.moderator, mm_got.moderator, minimock.Diff(*mm_want_ptrs.moderator, mm_got.moderator))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmResolveReport.t.Errorf("ReportServiceMock.ResolveReport got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmResolveReport.ResolveReportMock.defaultExpectation.results
+		if mm_results == nil {
+			mmResolveReport.t.Fatal("No results are set for the ReportServiceMock.ResolveReport")
+		}
+		return (*mm_results).err
+	}
+	if mmResolveReport.funcResolveReport != nil {
+		return mmResolveReport.funcResolveReport(ctx, id, action, moderator)
+	}
+	mmResolveReport.t.Fatalf("Unexpected call to ReportServiceMock.ResolveReport. %v %v %v %v", ctx, id, action, moderator)
+	return
+}
+
+// ResolveReportAfterCounter returns a count of finished ReportServiceMock.ResolveReport invocations
+func (mmResolveReport *ReportServiceMock) ResolveReportAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResolveReport.afterResolveReportCounter)
+}
+
+// ResolveReportBeforeCounter returns a count of ReportServiceMock.ResolveReport invocations
+func (mmResolveReport *ReportServiceMock) ResolveReportBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResolveReport.beforeResolveReportCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReportServiceMock.ResolveReport.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmResolveReport *mReportServiceMockResolveReport) Calls() []*ReportServiceMockResolveReportParams {
+	mmResolveReport.mutex.RLock()
+
+	argCopy := make([]*ReportServiceMockResolveReportParams, len(mmResolveReport.callArgs))
+	copy(argCopy, mmResolveReport.callArgs)
+
+	mmResolveReport.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockResolveReportDone returns true if the count of the ResolveReport invocations corresponds
+// the number of defined expectations
+func (m *ReportServiceMock) MinimockResolveReportDone() bool {
+	if m.ResolveReportMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ResolveReportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ResolveReportMock.invocationsDone()
+}
+
+// MinimockResolveReportInspect logs each unmet expectation
+func (m *ReportServiceMock) MinimockResolveReportInspect() {
+	for _, e := range m.ResolveReportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReportServiceMock.ResolveReport with params: %#v", *e.params)
+		}
+	}
+
+	afterResolveReportCounter := mm_atomic.LoadUint64(&m.afterResolveReportCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ResolveReportMock.defaultExpectation != nil && afterResolveReportCounter < 1 {
+		if m.ResolveReportMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ReportServiceMock.ResolveReport")
+		} else {
+			m.t.Errorf("Expected call to ReportServiceMock.ResolveReport with params: %#v", *m.ResolveReportMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcResolveReport != nil && afterResolveReportCounter < 1 {
+		m.t.Error("Expected call to ReportServiceMock.ResolveReport")
+	}
+
+	if !m.ResolveReportMock.invocationsDone() && afterResolveReportCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReportServiceMock.ResolveReport but found %d calls",
+			mm_atomic.LoadUint64(&m.ResolveReportMock.expectedInvocations), afterResolveReportCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ReportServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockListReportsInspect()
+
+			m.MinimockReportMessageInspect()
+
+			m.MinimockResolveReportInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ReportServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ReportServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockListReportsDone() &&
+		m.MinimockReportMessageDone() &&
+		m.MinimockResolveReportDone()
+}
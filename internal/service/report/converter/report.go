@@ -0,0 +1,66 @@
+package converter
+
+import (
+	chatModel "github.com/mikhailsoldatkin/chat-server/internal/service/chat/model"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/report/model"
+	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FromMessageToReport converter from service Message model to the service Report model of a report on it.
+func FromMessageToReport(message *chatModel.Message) *model.Report {
+	return &model.Report{
+		MessageID:  message.ID,
+		ChatID:     message.ChatID,
+		OffenderID: message.FromUser,
+		Text:       message.Text,
+	}
+}
+
+// FromServiceToProtobuf converter from service Report model to protobuf Report model.
+func FromServiceToProtobuf(report *model.Report) *pb.Report {
+	res := &pb.Report{
+		Id:           report.ID,
+		MessageId:    report.MessageID,
+		ChatId:       report.ChatID,
+		OffenderId:   report.OffenderID,
+		Text:         report.Text,
+		Status:       pb.ReportStatus(pb.ReportStatus_value[report.Status]),
+		ReportsCount: report.ReportsCount,
+		Reasons:      make([]*pb.ReportReason, len(report.Reasons)),
+		Action:       pb.ReportAction(pb.ReportAction_value[report.Action]),
+		ResolvedBy:   report.ResolvedBy,
+		CreatedAt:    timestamppb.New(report.CreatedAt),
+	}
+
+	if report.ResolvedAt != nil {
+		res.ResolvedAt = timestamppb.New(*report.ResolvedAt)
+	}
+
+	for i, reason := range report.Reasons {
+		res.Reasons[i] = &pb.ReportReason{
+			ReporterId: reason.ReporterID,
+			Reason:     reason.Reason,
+			CreatedAt:  timestamppb.New(reason.CreatedAt),
+		}
+	}
+
+	return res
+}
+
+// FromServiceToProtobufList converts a list of service Report models to a list of protobuf Report models.
+func FromServiceToProtobufList(reports []*model.Report) []*pb.Report {
+	res := make([]*pb.Report, len(reports))
+	for i, report := range reports {
+		res[i] = FromServiceToProtobuf(report)
+	}
+	return res
+}
+
+// FromProtobufToServiceStatus converts a protobuf report status to a service one, empty for unknown status.
+func FromProtobufToServiceStatus(status pb.ReportStatus) string {
+	if status == pb.ReportStatus_STATUS_UNKNOWN {
+		return ""
+	}
+	return status.String()
+}
@@ -0,0 +1,17 @@
+package report
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/service/report/model"
+)
+
+// ListReports retrieves reports with the given status based on the provided limit and offset.
+func (s *serv) ListReports(ctx context.Context, status string, limit, offset int64) ([]*model.Report, error) {
+	reports, err := s.reportRepository.List(ctx, status, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+}
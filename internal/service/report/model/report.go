@@ -0,0 +1,39 @@
+package model
+
+import "time"
+
+// Report statuses.
+const (
+	StatusOpen     = "OPEN"
+	StatusResolved = "RESOLVED"
+)
+
+// Report resolution actions.
+const (
+	ActionDismiss       = "DISMISS"
+	ActionDeleteMessage = "DELETE_MESSAGE"
+	ActionRemoveUser    = "REMOVE_USER"
+)
+
+// Report represents a business logic model of aggregated abuse reports on a single message.
+type Report struct {
+	ID           int64
+	MessageID    int64
+	ChatID       int64
+	OffenderID   int64
+	Text         string
+	Status       string
+	ReportsCount int64
+	Reasons      []*Reason
+	Action       string
+	ResolvedBy   string
+	CreatedAt    time.Time
+	ResolvedAt   *time.Time
+}
+
+// Reason represents a single member's complaint about a reported message.
+type Reason struct {
+	ReporterID int64
+	Reason     string
+	CreatedAt  time.Time
+}
@@ -0,0 +1,37 @@
+package report
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/service/report/converter"
+)
+
+// ReportMessage files a member's complaint about a message. Repeated reports on the same message
+// are aggregated into a single open report.
+func (s *serv) ReportMessage(ctx context.Context, messageID, reporterID int64, reason string) (int64, error) {
+	var id int64
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		message, errTx := s.chatRepository.GetMessage(ctx, messageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.chatRepository.IsUserInChat(ctx, reporterID, message.ChatID)
+		if errTx != nil {
+			return errTx
+		}
+
+		id, errTx = s.reportRepository.Report(ctx, converter.FromMessageToReport(message), reporterID, reason)
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
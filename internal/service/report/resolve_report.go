@@ -0,0 +1,52 @@
+package report
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/report/model"
+)
+
+// ResolveReport resolves an open report with the given action on behalf of the moderator.
+// Depending on the action the reported message is deleted or its author is removed from the chat,
+// cancelling the author's subscriptions to it.
+func (s *serv) ResolveReport(ctx context.Context, id int64, action string, moderator string) error {
+	var report *model.Report
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		report, errTx = s.reportRepository.Get(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+
+		if report.Status != model.StatusOpen {
+			return customerrors.NewReportResolvedError(id)
+		}
+
+		switch action {
+		case model.ActionDismiss:
+		case model.ActionDeleteMessage:
+			errTx = s.chatRepository.DeleteMessage(ctx, report.MessageID)
+		case model.ActionRemoveUser:
+			errTx = s.chatRepository.RemoveUser(ctx, report.ChatID, report.OffenderID)
+		default:
+			errTx = customerrors.NewInvalidArgumentError(fmt.Sprintf("unsupported report action %q", action))
+		}
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.reportRepository.Resolve(ctx, id, action, moderator)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if action == model.ActionRemoveUser {
+		s.broker.Unsubscribe(report.ChatID, report.OffenderID)
+	}
+
+	return nil
+}
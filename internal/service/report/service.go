@@ -0,0 +1,62 @@
+package report
+
+import (
+	"context"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/broker"
+	"github.com/mikhailsoldatkin/chat-server/internal/repository"
+	"github.com/mikhailsoldatkin/chat-server/internal/service"
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+)
+
+var _ service.ReportService = (*serv)(nil)
+
+type serv struct {
+	chatRepository   repository.ChatRepository
+	reportRepository repository.ReportRepository
+	txManager        db.TxManager
+	broker           broker.Broker
+}
+
+// NewService creates a new instance of the report service.
+func NewService(
+	chatRepository repository.ChatRepository,
+	reportRepository repository.ReportRepository,
+	txManager db.TxManager,
+	broker broker.Broker,
+) service.ReportService {
+	return &serv{
+		chatRepository:   chatRepository,
+		reportRepository: reportRepository,
+		txManager:        txManager,
+		broker:           broker,
+	}
+}
+
+// No-op implementation for TxManager
+type noOpTxManager struct{}
+
+func (noOpTxManager) ReadCommitted(ctx context.Context, f db.Handler) error {
+	return f(ctx)
+}
+
+// NewMockService creates a new mock instance of the report service.
+func NewMockService(deps ...any) service.ReportService {
+	srv := serv{
+		txManager: noOpTxManager{},
+		broker:    broker.NewBroker(),
+	}
+
+	for _, v := range deps {
+		switch s := v.(type) {
+		case repository.ChatRepository:
+			srv.chatRepository = s
+		case repository.ReportRepository:
+			srv.reportRepository = s
+		case broker.Broker:
+			srv.broker = s
+		}
+	}
+
+	return &srv
+}
@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/chat-server/internal/repository"
+	repoMocks "github.com/mikhailsoldatkin/chat-server/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/report"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/report/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListReports(t *testing.T) {
+	t.Parallel()
+	type reportRepoMockFunc func(mc *minimock.Controller) repository.ReportRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		limit  = int64(10)
+		offset = int64(0)
+
+		reports = []*model.Report{
+			{ID: gofakeit.Int64(), Status: model.StatusOpen, ReportsCount: 2},
+			{ID: gofakeit.Int64(), Status: model.StatusOpen, ReportsCount: 1},
+		}
+
+		wantErr = fmt.Errorf("repository error")
+	)
+
+	tests := []struct {
+		name           string
+		want           []*model.Report
+		err            error
+		reportRepoMock reportRepoMockFunc
+	}{
+		{
+			name: "success case",
+			want: reports,
+			err:  nil,
+			reportRepoMock: func(mc *minimock.Controller) repository.ReportRepository {
+				mock := repoMocks.NewReportRepositoryMock(mc)
+				mock.ListMock.Expect(ctx, model.StatusOpen, limit, offset).Return(reports, nil)
+				return mock
+			},
+		},
+		{
+			name: "error case",
+			want: nil,
+			err:  wantErr,
+			reportRepoMock: func(mc *minimock.Controller) repository.ReportRepository {
+				mock := repoMocks.NewReportRepositoryMock(mc)
+				mock.ListMock.Expect(ctx, model.StatusOpen, limit, offset).Return(nil, wantErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := report.NewMockService(tt.reportRepoMock(mc))
+
+			resp, err := service.ListReports(ctx, model.StatusOpen, limit, offset)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, resp)
+		})
+	}
+}
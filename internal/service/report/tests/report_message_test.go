@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/repository"
+	repoMocks "github.com/mikhailsoldatkin/chat-server/internal/repository/mocks"
+	chatModel "github.com/mikhailsoldatkin/chat-server/internal/service/chat/model"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/report"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/report/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportMessage(t *testing.T) {
+	t.Parallel()
+	type chatRepoMockFunc func(mc *minimock.Controller) repository.ChatRepository
+	type reportRepoMockFunc func(mc *minimock.Controller) repository.ReportRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		reportID   = gofakeit.Int64()
+		messageID  = gofakeit.Int64()
+		reporterID = gofakeit.Int64()
+		reason     = gofakeit.Sentence(5)
+
+		message = &chatModel.Message{
+			ID:       messageID,
+			ChatID:   gofakeit.Int64(),
+			FromUser: gofakeit.Int64(),
+			Text:     gofakeit.Sentence(10),
+		}
+
+		notFoundErr  = customerrors.NewNotFoundError("message", messageID)
+		notMemberErr = customerrors.NewUserNotInChatError(reporterID, message.ChatID)
+	)
+
+	tests := []struct {
+		name           string
+		want           int64
+		err            error
+		chatRepoMock   chatRepoMockFunc
+		reportRepoMock reportRepoMockFunc
+	}{
+		{
+			name: "success case",
+			want: reportID,
+			err:  nil,
+			chatRepoMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(message, nil)
+				mock.IsUserInChatMock.Expect(ctx, reporterID, message.ChatID).Return(nil)
+				return mock
+			},
+			reportRepoMock: func(mc *minimock.Controller) repository.ReportRepository {
+				mock := repoMocks.NewReportRepositoryMock(mc)
+				mock.ReportMock.Expect(ctx, &model.Report{
+					MessageID:  messageID,
+					ChatID:     message.ChatID,
+					OffenderID: message.FromUser,
+					Text:       message.Text,
+				}, reporterID, reason).Return(reportID, nil)
+				return mock
+			},
+		},
+		{
+			name: "message not found",
+			want: 0,
+			err:  notFoundErr,
+			chatRepoMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(nil, notFoundErr)
+				return mock
+			},
+			reportRepoMock: func(mc *minimock.Controller) repository.ReportRepository {
+				return repoMocks.NewReportRepositoryMock(mc)
+			},
+		},
+		{
+			name: "reporter is not a chat member",
+			want: 0,
+			err:  notMemberErr,
+			chatRepoMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(message, nil)
+				mock.IsUserInChatMock.Expect(ctx, reporterID, message.ChatID).Return(notMemberErr)
+				return mock
+			},
+			reportRepoMock: func(mc *minimock.Controller) repository.ReportRepository {
+				return repoMocks.NewReportRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := report.NewMockService(tt.chatRepoMock(mc), tt.reportRepoMock(mc))
+
+			resp, err := service.ReportMessage(ctx, messageID, reporterID, reason)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, resp)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/mikhailsoldatkin/chat-server/internal/broker"
+	"github.com/mikhailsoldatkin/chat-server/internal/customerrors"
+	"github.com/mikhailsoldatkin/chat-server/internal/repository"
+	repoMocks "github.com/mikhailsoldatkin/chat-server/internal/repository/mocks"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/report"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/report/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveReport(t *testing.T) {
+	t.Parallel()
+	type chatRepoMockFunc func(mc *minimock.Controller) repository.ChatRepository
+	type reportRepoMockFunc func(mc *minimock.Controller) repository.ReportRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		reportID  = gofakeit.Int64()
+		moderator = gofakeit.Username()
+
+		openReport = &model.Report{
+			ID:         reportID,
+			MessageID:  gofakeit.Int64(),
+			ChatID:     gofakeit.Int64(),
+			OffenderID: gofakeit.Int64(),
+			Status:     model.StatusOpen,
+		}
+		resolvedReport = &model.Report{
+			ID:     reportID,
+			Status: model.StatusResolved,
+		}
+	)
+
+	tests := []struct {
+		name           string
+		action         string
+		err            error
+		chatRepoMock   chatRepoMockFunc
+		reportRepoMock reportRepoMockFunc
+	}{
+		{
+			name:   "dismiss",
+			action: model.ActionDismiss,
+			err:    nil,
+			chatRepoMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return repoMocks.NewChatRepositoryMock(mc)
+			},
+			reportRepoMock: func(mc *minimock.Controller) repository.ReportRepository {
+				mock := repoMocks.NewReportRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, reportID).Return(openReport, nil)
+				mock.ResolveMock.Expect(ctx, reportID, model.ActionDismiss, moderator).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:   "delete message",
+			action: model.ActionDeleteMessage,
+			err:    nil,
+			chatRepoMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.DeleteMessageMock.Expect(ctx, openReport.MessageID).Return(nil)
+				return mock
+			},
+			reportRepoMock: func(mc *minimock.Controller) repository.ReportRepository {
+				mock := repoMocks.NewReportRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, reportID).Return(openReport, nil)
+				mock.ResolveMock.Expect(ctx, reportID, model.ActionDeleteMessage, moderator).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:   "remove user",
+			action: model.ActionRemoveUser,
+			err:    nil,
+			chatRepoMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.RemoveUserMock.Expect(ctx, openReport.ChatID, openReport.OffenderID).Return(nil)
+				return mock
+			},
+			reportRepoMock: func(mc *minimock.Controller) repository.ReportRepository {
+				mock := repoMocks.NewReportRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, reportID).Return(openReport, nil)
+				mock.ResolveMock.Expect(ctx, reportID, model.ActionRemoveUser, moderator).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:   "already resolved",
+			action: model.ActionDismiss,
+			err:    customerrors.NewReportResolvedError(reportID),
+			chatRepoMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return repoMocks.NewChatRepositoryMock(mc)
+			},
+			reportRepoMock: func(mc *minimock.Controller) repository.ReportRepository {
+				mock := repoMocks.NewReportRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, reportID).Return(resolvedReport, nil)
+				return mock
+			},
+		},
+		{
+			name:   "unsupported action",
+			action: "BAN",
+			err:    customerrors.NewInvalidArgumentError(`unsupported report action "BAN"`),
+			chatRepoMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return repoMocks.NewChatRepositoryMock(mc)
+			},
+			reportRepoMock: func(mc *minimock.Controller) repository.ReportRepository {
+				mock := repoMocks.NewReportRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, reportID).Return(openReport, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := broker.NewBroker()
+			sub := b.NewSubscription(openReport.OffenderID, 1)
+			defer sub.Close()
+			sub.Subscribe(openReport.ChatID)
+
+			service := report.NewMockService(tt.chatRepoMock(mc), tt.reportRepoMock(mc), b)
+
+			err := service.ResolveReport(ctx, reportID, tt.action, moderator)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.action == model.ActionRemoveUser, len(sub.Events()) == 1,
+				"the subscriptions of the removed user are cancelled")
+		})
+	}
+}
@@ -3,6 +3,10 @@ package service
 import (
 	"context"
 
+	"github.com/mikhailsoldatkin/chat-server/internal/broker"
+	apiKeyModel "github.com/mikhailsoldatkin/chat-server/internal/service/apikey/model"
+	chatModel "github.com/mikhailsoldatkin/chat-server/internal/service/chat/model"
+	"github.com/mikhailsoldatkin/chat-server/internal/service/report/model"
 	pb "github.com/mikhailsoldatkin/chat-server/pkg/chat_v1"
 )
 
@@ -10,5 +14,22 @@ import (
 type ChatService interface {
 	Create(ctx context.Context, users []int64) (int64, error)
 	Delete(ctx context.Context, id int64) error
-	SendMessage(ctx context.Context, req *pb.SendMessageRequest) error
+	SendMessage(ctx context.Context, req *pb.SendMessageRequest) (int64, error)
+	Subscribe(ctx context.Context, sub broker.Subscription, userID int64, chatIDs []int64) error
+	PublishEvent(ctx context.Context, event *chatModel.Event) error
+}
+
+// ReportService defines the interface for abuse reports business logic operations.
+type ReportService interface {
+	ReportMessage(ctx context.Context, messageID, reporterID int64, reason string) (int64, error)
+	ListReports(ctx context.Context, status string, limit, offset int64) ([]*model.Report, error)
+	ResolveReport(ctx context.Context, id int64, action string, moderator string) error
+}
+
+// APIKeyService defines the interface for API keys business logic operations.
+type APIKeyService interface {
+	CreateAPIKey(ctx context.Context, key *apiKeyModel.APIKey) (int64, string, error)
+	ListAPIKeys(ctx context.Context, limit, offset int64) ([]*apiKeyModel.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id int64) error
+	Authenticate(ctx context.Context, secret, method string, chatID int64) (*apiKeyModel.APIKey, error)
 }
@@ -0,0 +1,184 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/logger"
+	"go.uber.org/zap"
+)
+
+// Reloader keeps the certificate of the server and the CA bundle verifying peers loaded from files,
+// reloading them when the files change. Connections established before a reload keep the previous ones.
+type Reloader struct {
+	certFile string
+	keyFile  string
+	caFile   string
+
+	mu    sync.RWMutex
+	cert  *tls.Certificate
+	pool  *x509.CertPool
+	stamp string
+}
+
+// NewReloader loads the certificate and its key and the CA bundle from the files.
+func NewReloader(certFile, keyFile, caFile string) (*Reloader, error) {
+	r := &Reloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		caFile:   caFile,
+	}
+
+	if _, err := r.Reload(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Reload reloads the files if any of them changed since the last load and reports whether they did.
+// The loaded certificate and CA bundle are kept if the new ones can't be loaded.
+func (r *Reloader) Reload() (bool, error) {
+	stamp, err := r.fileStamp()
+	if err != nil {
+		return false, err
+	}
+
+	r.mu.RLock()
+	changed := stamp != r.stamp
+	r.mu.RUnlock()
+	if !changed {
+		return false, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return false, fmt.Errorf("loading TLS certificate %s: %w", r.certFile, err)
+	}
+
+	pool, err := LoadCertPool(r.caFile)
+	if err != nil {
+		return false, err
+	}
+
+	r.mu.Lock()
+	r.cert, r.pool, r.stamp = &cert, pool, stamp
+	r.mu.Unlock()
+
+	return true, nil
+}
+
+// Run checks the files for changes every interval until the context is done.
+func (r *Reloader) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reloaded, err := r.Reload()
+			if err != nil {
+				logger.Warn("failed to reload TLS certificates", zap.Error(err))
+				continue
+			}
+			if reloaded {
+				logger.Info("TLS certificates reloaded", zap.String("cert_file", r.certFile))
+			}
+		}
+	}
+}
+
+// Certificate returns the loaded certificate.
+func (r *Reloader) Certificate() *tls.Certificate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.cert
+}
+
+// CertPool returns the loaded CA bundle.
+func (r *Reloader) CertPool() *x509.CertPool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.pool
+}
+
+// Leaf returns the parsed loaded certificate.
+func (r *Reloader) Leaf() (*x509.Certificate, error) {
+	cert := r.Certificate()
+	if cert.Leaf != nil {
+		return cert.Leaf, nil
+	}
+
+	return x509.ParseCertificate(cert.Certificate[0])
+}
+
+// ServerConfig returns the TLS configuration of a server presenting the loaded certificate. With clientAuth
+// clients must present a certificate signed by a CA from the loaded bundle.
+func (r *Reloader) ServerConfig(clientAuth bool) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// the configuration is built per connection to pick up reloaded files
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cfg := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.Certificate()},
+				NextProtos:   []string{"h2", "http/1.1"},
+			}
+			if clientAuth {
+				cfg.ClientAuth = tls.RequireAndVerifyClientCert
+				cfg.ClientCAs = r.CertPool()
+			}
+			return cfg, nil
+		},
+	}
+}
+
+// ClientConfig returns the TLS configuration of a client verifying the server with the loaded CA bundle
+// and, if asked by the server, presenting the loaded certificate.
+func (r *Reloader) ClientConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		RootCAs:    r.CertPool(),
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return r.Certificate(), nil
+		},
+	}
+}
+
+// fileStamp returns a value changing whenever any of the files is modified or replaced.
+func (r *Reloader) fileStamp() (string, error) {
+	var stamp string
+	for _, file := range []string{r.certFile, r.keyFile, r.caFile} {
+		info, err := os.Stat(file)
+		if err != nil {
+			return "", err
+		}
+		stamp += fmt.Sprintf("%s:%d:%d;", file, info.ModTime().UnixNano(), info.Size())
+	}
+
+	return stamp, nil
+}
+
+// LoadCertPool loads a PEM encoded CA bundle from the file.
+func LoadCertPool(file string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("loading CA bundle: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificates found in CA bundle %s", file)
+	}
+
+	return pool, nil
+}
@@ -0,0 +1,222 @@
+package tests
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/mikhailsoldatkin/chat-server/internal/tlsconfig"
+	"github.com/stretchr/testify/require"
+)
+
+// authority is a CA issuing certificates for the tests.
+type authority struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newAuthority(t *testing.T) *authority {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(int64(gofakeit.Uint32()) + 1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &authority{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns a PEM encoded certificate for the DNS name and its key.
+func (a *authority) issue(t *testing.T, dnsName string) (certPEM, keyPEM []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(int64(gofakeit.Uint32()) + 1),
+		Subject:      pkix.Name{CommonName: dnsName},
+		DNSNames:     []string{dnsName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, a.cert, &key.PublicKey, a.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+// files are the certificate, key and CA bundle files of a reloader.
+type files struct {
+	cert, key, ca string
+}
+
+func writeFiles(t *testing.T, dir string, ca *authority, dnsName string, modTime time.Time) files {
+	f := files{
+		cert: filepath.Join(dir, "service.pem"),
+		key:  filepath.Join(dir, "service.key"),
+		ca:   filepath.Join(dir, "ca.cert"),
+	}
+
+	certPEM, keyPEM := ca.issue(t, dnsName)
+	for path, data := range map[string][]byte{f.cert: certPEM, f.key: keyPEM, f.ca: ca.pem} {
+		require.NoError(t, os.WriteFile(path, data, 0o600))
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+
+	return f
+}
+
+func leafName(t *testing.T, r *tlsconfig.Reloader) string {
+	leaf, err := r.Leaf()
+	require.NoError(t, err)
+	return leaf.DNSNames[0]
+}
+
+func TestReloader(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	ca := newAuthority(t)
+	start := time.Now().Add(-time.Minute)
+
+	f := writeFiles(t, dir, ca, "first.test", start)
+	r, err := tlsconfig.NewReloader(f.cert, f.key, f.ca)
+	require.NoError(t, err)
+	require.Equal(t, "first.test", leafName(t, r))
+
+	reloaded, err := r.Reload()
+	require.NoError(t, err)
+	require.False(t, reloaded, "unchanged files must not be reloaded")
+
+	writeFiles(t, dir, ca, "second.test", start.Add(time.Second))
+	reloaded, err = r.Reload()
+	require.NoError(t, err)
+	require.True(t, reloaded)
+	require.Equal(t, "second.test", leafName(t, r))
+
+	require.NoError(t, os.WriteFile(f.cert, []byte("broken"), 0o600))
+	_, err = r.Reload()
+	require.Error(t, err)
+	require.Equal(t, "second.test", leafName(t, r), "the loaded certificate must be kept if the new one is broken")
+}
+
+func TestNewReloaderErrors(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	f := writeFiles(t, dir, newAuthority(t), "service.test", time.Now())
+	notCA := filepath.Join(dir, "not_ca.cert")
+	require.NoError(t, os.WriteFile(notCA, []byte("not a certificate"), 0o600))
+
+	tests := []struct {
+		name  string
+		files files
+	}{
+		{name: "missing certificate", files: files{cert: filepath.Join(dir, "missing.pem"), key: f.key, ca: f.ca}},
+		{name: "key not matching", files: files{cert: f.cert, key: f.cert, ca: f.ca}},
+		{name: "invalid CA bundle", files: files{cert: f.cert, key: f.key, ca: notCA}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := tlsconfig.NewReloader(tt.files.cert, tt.files.key, tt.files.ca)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestMutualTLS(t *testing.T) {
+	t.Parallel()
+
+	ca := newAuthority(t)
+	serverFiles := writeFiles(t, t.TempDir(), ca, "localhost", time.Now())
+	clientFiles := writeFiles(t, t.TempDir(), ca, "client.test", time.Now())
+	strangerFiles := writeFiles(t, t.TempDir(), newAuthority(t), "stranger.test", time.Now())
+
+	server, err := tlsconfig.NewReloader(serverFiles.cert, serverFiles.key, serverFiles.ca)
+	require.NoError(t, err)
+
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", server.ServerConfig(true))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = lis.Close() })
+
+	go func() {
+		for {
+			conn, errAccept := lis.Accept()
+			if errAccept != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_ = conn.(*tls.Conn).Handshake()
+				_, _ = conn.Write([]byte("ok"))
+			}()
+		}
+	}()
+
+	tests := []struct {
+		name   string
+		client files
+		ok     bool
+	}{
+		{name: "client certificate signed by the CA", client: clientFiles, ok: true},
+		{name: "client certificate signed by another CA", client: strangerFiles, ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := tlsconfig.NewReloader(tt.client.cert, tt.client.key, serverFiles.ca)
+			require.NoError(t, err)
+
+			cfg := client.ClientConfig()
+			cfg.ServerName = "localhost"
+			conn, err := tls.Dial("tcp", lis.Addr().String(), cfg)
+			require.NoError(t, err, "the client verifies the server")
+			defer conn.Close()
+
+			// TLS 1.3 clients learn about a rejected certificate when reading
+			require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+			buf := make([]byte, 2)
+			_, err = conn.Read(buf)
+			if tt.ok {
+				require.NoError(t, err)
+				require.Equal(t, "ok", string(buf))
+			} else {
+				require.Error(t, err)
+				var netErr net.Error
+				require.False(t, errors.As(err, &netErr) && netErr.Timeout(), "the connection must be rejected, not time out")
+			}
+		})
+	}
+}
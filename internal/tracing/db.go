@@ -0,0 +1,119 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	_ db.Client = (*dbClient)(nil)
+	_ db.DB     = (*tracedDB)(nil)
+)
+
+type dbClient struct {
+	client db.Client
+	db     db.DB
+}
+
+// NewDBClient wraps the database client so that every query is recorded as a client span named after the query.
+func NewDBClient(client db.Client) db.Client {
+	return &dbClient{
+		client: client,
+		db:     &tracedDB{DB: client.DB()},
+	}
+}
+
+func (c *dbClient) DB() db.DB {
+	return c.db
+}
+
+func (c *dbClient) Close() error {
+	return c.client.Close()
+}
+
+// tracedDB records query spans, transactions, pings and closing are passed through as is.
+type tracedDB struct {
+	db.DB
+}
+
+func (d *tracedDB) ScanOneContext(ctx context.Context, dest interface{}, q db.Query, args ...interface{}) error {
+	ctx, span := startQuerySpan(ctx, q)
+	err := d.DB.ScanOneContext(ctx, dest, q, args...)
+	endQuerySpan(span, err)
+
+	return err
+}
+
+func (d *tracedDB) ScanAllContext(ctx context.Context, dest interface{}, q db.Query, args ...interface{}) error {
+	ctx, span := startQuerySpan(ctx, q)
+	err := d.DB.ScanAllContext(ctx, dest, q, args...)
+	endQuerySpan(span, err)
+
+	return err
+}
+
+func (d *tracedDB) ExecContext(ctx context.Context, q db.Query, args ...interface{}) (pgconn.CommandTag, error) {
+	ctx, span := startQuerySpan(ctx, q)
+	tag, err := d.DB.ExecContext(ctx, q, args...)
+	endQuerySpan(span, err)
+
+	return tag, err
+}
+
+func (d *tracedDB) QueryContext(ctx context.Context, q db.Query, args ...interface{}) (pgx.Rows, error) {
+	ctx, span := startQuerySpan(ctx, q)
+	rows, err := d.DB.QueryContext(ctx, q, args...)
+	endQuerySpan(span, err)
+
+	return rows, err
+}
+
+// QueryRowContext defers the query until the row is scanned, so the span is ended on Scan.
+func (d *tracedDB) QueryRowContext(ctx context.Context, q db.Query, args ...interface{}) pgx.Row {
+	ctx, span := startQuerySpan(ctx, q)
+
+	return &tracedRow{
+		row:  d.DB.QueryRowContext(ctx, q, args...),
+		span: span,
+	}
+}
+
+type tracedRow struct {
+	row  pgx.Row
+	span trace.Span
+}
+
+func (r *tracedRow) Scan(dest ...interface{}) error {
+	err := r.row.Scan(dest...)
+	endQuerySpan(r.span, err)
+
+	return err
+}
+
+// startQuerySpan starts a span of the query.
+func startQuerySpan(ctx context.Context, q db.Query) (context.Context, trace.Span) {
+	return StartSpan(
+		ctx,
+		q.Name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBOperationName(q.Name),
+			semconv.DBQueryText(q.QueryRaw),
+		),
+	)
+}
+
+// endQuerySpan ends the span of the query, no rows is an expected result rather than a failed query.
+func endQuerySpan(span trace.Span, err error) {
+	if errors.Is(err, pgx.ErrNoRows) {
+		err = nil
+	}
+	End(span, err)
+}
@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/mikhailsoldatkin/chat-server/internal/tracing"
+	"github.com/mikhailsoldatkin/platform_common/pkg/db"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// fakeClient is a database client returning err from every query.
+type fakeClient struct {
+	db fakeDB
+}
+
+func (c *fakeClient) DB() db.DB {
+	return c.db
+}
+
+func (c *fakeClient) Close() error {
+	return nil
+}
+
+type fakeDB struct {
+	db.DB
+	err error
+}
+
+func (d fakeDB) ExecContext(_ context.Context, _ db.Query, _ ...interface{}) (pgconn.CommandTag, error) {
+	return nil, d.err
+}
+
+func (d fakeDB) QueryRowContext(_ context.Context, _ db.Query, _ ...interface{}) pgx.Row {
+	return row{err: d.err}
+}
+
+type row struct {
+	err error
+}
+
+func (r row) Scan(_ ...interface{}) error {
+	return r.err
+}
+
+func TestDBClient(t *testing.T) {
+	t.Parallel()
+
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+	defer parent.End()
+
+	tests := []struct {
+		name   string
+		err    error
+		status codes.Code
+		query  func(client db.Client, q db.Query) error
+	}{
+		{
+			name:   "exec",
+			status: codes.Unset,
+			query: func(client db.Client, q db.Query) error {
+				_, err := client.DB().ExecContext(ctx, q)
+				return err
+			},
+		},
+		{
+			name:   "exec error",
+			err:    errors.New(gofakeit.Sentence(3)),
+			status: codes.Error,
+			query: func(client db.Client, q db.Query) error {
+				_, err := client.DB().ExecContext(ctx, q)
+				return err
+			},
+		},
+		{
+			name:   "query row without rows",
+			err:    pgx.ErrNoRows,
+			status: codes.Unset,
+			query: func(client db.Client, q db.Query) error {
+				return client.DB().QueryRowContext(ctx, q).Scan()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			q := db.Query{
+				Name:     "test_repository." + gofakeit.UUID(),
+				QueryRaw: "SELECT 1",
+			}
+			client := tracing.NewDBClient(&fakeClient{db: fakeDB{err: tt.err}})
+
+			err := tt.query(client, q)
+			require.Equal(t, tt.err, err)
+
+			span := findSpan(t, recorder, q.Name)
+			require.Equal(t, trace.SpanKindClient, span.SpanKind())
+			require.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+			require.Equal(t, tt.status, span.Status().Code)
+			require.Contains(t, span.Attributes(), semconv.DBQueryText(q.QueryRaw))
+		})
+	}
+}
+
+// findSpan returns the ended span with the name.
+func findSpan(t *testing.T, recorder *tracetest.SpanRecorder, name string) sdktrace.ReadOnlySpan {
+	for _, span := range recorder.Ended() {
+		if span.Name() == name {
+			return span
+		}
+	}
+
+	require.Failf(t, "span not found", "%s", name)
+
+	return nil
+}
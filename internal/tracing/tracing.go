@@ -1,35 +1,118 @@
 package tracing
 
 import (
-	"github.com/mikhailsoldatkin/platform_common/pkg/closer"
-	"github.com/uber/jaeger-client-go/config"
-	"go.uber.org/zap"
+	"context"
+	"fmt"
+
+	"github.com/mikhailsoldatkin/chat-server/internal/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName identifies the spans started by the chat server code.
+const instrumentationName = "github.com/mikhailsoldatkin/chat-server"
+
+// OTLP exporter protocols.
+const (
+	ProtocolGRPC = "grpc"
+	ProtocolHTTP = "http"
 )
 
-// Init initializes the Jaeger tracer for distributed tracing.
-func Init(logger *zap.Logger, serviceName string, jaegerAddress string) {
-	cfg := config.Configuration{
-		Sampler: &config.SamplerConfig{
-			Type:  "const",
-			Param: 1,
-		},
-		Reporter: &config.ReporterConfig{
-			LocalAgentHostPort: jaegerAddress,
-		},
+// Init initializes the global OpenTelemetry tracer provider exporting spans to the OTLP collector and sets up
+// W3C trace context and baggage propagation. The returned provider must be shut down to flush the spans.
+func Init(ctx context.Context, cfg config.Tracing) (*sdktrace.TracerProvider, error) {
+	if cfg.SampleRatio < 0 || cfg.SampleRatio > 1 {
+		return nil, fmt.Errorf("tracing sample ratio must be between 0 and 1, got %v", cfg.SampleRatio)
+	}
+
+	exporter, err := newExporter(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+
+	res, err := newResource(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tracing resource: %w", err)
 	}
 
-	c, err := cfg.InitGlobalTracer(serviceName)
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+
+	otel.SetTracerProvider(provider)
+	InitPropagation()
+
+	return provider, nil
+}
+
+// InitPropagation sets up the global W3C trace context and baggage propagation.
+func InitPropagation() {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+}
 
-	closerFunc := func() error {
-		errClose := c.Close()
-		if errClose != nil {
-			return errClose
+// newExporter creates the OTLP exporter for the configured protocol.
+func newExporter(ctx context.Context, cfg config.Tracing) (sdktrace.SpanExporter, error) {
+	switch cfg.Protocol {
+	case ProtocolGRPC:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
 		}
-		return nil
+		return otlptracegrpc.New(ctx, opts...)
+	case ProtocolHTTP:
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		return otlptracehttp.New(ctx, opts...)
+	default:
+		return nil, fmt.Errorf("unknown OTLP protocol %q", cfg.Protocol)
+	}
+}
+
+// newResource describes the service producing the spans.
+func newResource(ctx context.Context, cfg config.Tracing) (*resource.Resource, error) {
+	attrs := []attribute.KeyValue{
+		semconv.ServiceName(cfg.ServiceName),
+		semconv.DeploymentEnvironment(cfg.Environment),
+	}
+	for key, value := range cfg.ResourceAttributes {
+		attrs = append(attrs, attribute.String(key, value))
 	}
-	closer.Add(closerFunc)
 
+	return resource.New(
+		ctx,
+		resource.WithSchemaURL(semconv.SchemaURL),
+		resource.WithAttributes(attrs...),
+		resource.WithTelemetrySDK(),
+		resource.WithHost(),
+		resource.WithProcessPID(),
+	)
+}
+
+// StartSpan starts a span of the chat server code as a child of the span in the context.
+func StartSpan(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, opts...)
+}
+
+// End records the error on the span, if any, and ends it.
+func End(span trace.Span, err error) {
 	if err != nil {
-		logger.Fatal("failed to init tracing", zap.Error(err))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
 	}
+	span.End()
 }
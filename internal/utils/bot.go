@@ -0,0 +1,30 @@
+package utils
+
+import "context"
+
+type botKey struct{}
+
+// Bot represents a bot or an integration calling the API with an API key on behalf of a user.
+type Bot struct {
+	KeyID  int64
+	UserID int64
+	Name   string
+}
+
+// ContextWithBot returns a copy of the context carrying the bot the request is made by, the bot is recorded
+// in the Identity of the request too.
+func ContextWithBot(ctx context.Context, bot *Bot) context.Context {
+	if identity, ok := identityFromContext(ctx); ok {
+		identity.mu.Lock()
+		identity.bot = bot
+		identity.mu.Unlock()
+	}
+
+	return context.WithValue(ctx, botKey{}, bot)
+}
+
+// BotFromContext returns the bot the request is made by, if the request is authenticated with an API key.
+func BotFromContext(ctx context.Context) (*Bot, bool) {
+	bot, ok := ctx.Value(botKey{}).(*Bot)
+	return bot, ok && bot != nil
+}
@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"context"
+	"sync"
+)
+
+type identityKey struct{}
+
+// Identity records the user or the bot a request is verified to be made by, as soon as it is put in the context.
+// It lets the code running before the authentication, e.g. the access log, see the identity once the request
+// has been handled.
+type Identity struct {
+	mu   sync.Mutex
+	user *User
+	bot  *Bot
+}
+
+// ContextWithIdentity returns a copy of the context with an empty Identity, filled by ContextWithUser
+// and ContextWithBot called with the context or the ones derived from it.
+func ContextWithIdentity(ctx context.Context) (context.Context, *Identity) {
+	identity := &Identity{}
+	return context.WithValue(ctx, identityKey{}, identity), identity
+}
+
+// User returns the verified user the request is made by, if any.
+func (i *Identity) User() (*User, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.user, i.user != nil
+}
+
+// Bot returns the verified bot the request is made by, if any.
+func (i *Identity) Bot() (*Bot, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.bot, i.bot != nil
+}
+
+// identityFromContext returns the Identity of the request, if the context carries one.
+func identityFromContext(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(*Identity)
+	return identity, ok
+}
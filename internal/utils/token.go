@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	headerAuth = "authorization"
+	prefixAuth = "Bearer "
+)
+
+// UserClaims represents the claims of an access token issued by the authentication service.
+type UserClaims struct {
+	Username  string `json:"username"`
+	Role      string `json:"role"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// AccessTokenFromContext extracts the bearer access token from the incoming gRPC metadata.
+func AccessTokenFromContext(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", errors.New("metadata is not provided")
+	}
+
+	authHeader := md.Get(headerAuth)
+	if len(authHeader) == 0 {
+		return "", errors.New("authorization header is not provided")
+	}
+
+	if !strings.HasPrefix(authHeader[0], prefixAuth) {
+		return "", errors.New("invalid authorization header format")
+	}
+
+	return strings.TrimPrefix(authHeader[0], prefixAuth), nil
+}
+
+// ParseUnverifiedClaims decodes the claims of an access token without checking its signature.
+// It must only be used for tokens that have already been verified by the authentication service.
+func ParseUnverifiedClaims(token string) (*UserClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("invalid token format")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, errors.WithMessage(err, "decoding token payload")
+	}
+
+	var claims UserClaims
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return nil, errors.WithMessage(err, "parsing token claims")
+	}
+
+	return &claims, nil
+}
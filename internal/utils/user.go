@@ -0,0 +1,30 @@
+package utils
+
+import "context"
+
+type userKey struct{}
+
+// User represents the user the request is made by, as identified by an access token verified
+// by the authentication service.
+type User struct {
+	Username string
+	Role     string
+}
+
+// ContextWithUser returns a copy of the context carrying the user the request is made by, the user is recorded
+// in the Identity of the request too. It must only be called once the access token of the user has been verified.
+func ContextWithUser(ctx context.Context, user *User) context.Context {
+	if identity, ok := identityFromContext(ctx); ok {
+		identity.mu.Lock()
+		identity.user = user
+		identity.mu.Unlock()
+	}
+
+	return context.WithValue(ctx, userKey{}, user)
+}
+
+// UserFromContext returns the user the request is made by, if the request is authenticated with an access token.
+func UserFromContext(ctx context.Context) (*User, bool) {
+	user, ok := ctx.Value(userKey{}).(*User)
+	return user, ok && user != nil
+}
@@ -0,0 +1,32 @@
+-- +goose Up
+CREATE TABLE reports
+(
+    id          BIGSERIAL PRIMARY KEY,
+    message_id  BIGINT                   NOT NULL,
+    chat_id     BIGINT                   NOT NULL,
+    offender_id BIGINT                   NOT NULL,
+    text        TEXT                     NOT NULL,
+    status      TEXT                     NOT NULL DEFAULT 'OPEN',
+    action      TEXT,
+    resolved_by TEXT,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMP WITH TIME ZONE
+);
+
+-- only one open report per message, repeated reports are aggregated into it
+CREATE UNIQUE INDEX reports_open_message_id_idx ON reports (message_id) WHERE status = 'OPEN';
+CREATE INDEX reports_status_created_at_idx ON reports (status, created_at);
+
+CREATE TABLE report_reasons
+(
+    report_id   BIGINT                   NOT NULL REFERENCES reports (id) ON DELETE CASCADE,
+    reporter_id BIGINT                   NOT NULL,
+    reason      TEXT                     NOT NULL,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (report_id, reporter_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS report_reasons;
+DROP TABLE IF EXISTS reports;
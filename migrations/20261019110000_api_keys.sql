@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE api_keys
+(
+    id           BIGSERIAL PRIMARY KEY,
+    name         TEXT                     NOT NULL,
+    prefix       TEXT                     NOT NULL,
+    key_hash     TEXT                     NOT NULL,
+    user_id      BIGINT                   NOT NULL,
+    chat_ids     BIGINT[]                 NOT NULL DEFAULT '{}',
+    methods      TEXT[]                   NOT NULL,
+    created_by   TEXT                     NOT NULL,
+    created_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    expires_at   TIMESTAMP WITH TIME ZONE,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    revoked_at   TIMESTAMP WITH TIME ZONE
+);
+
+-- keys are looked up by the hash of the secret presented by bots
+CREATE UNIQUE INDEX api_keys_key_hash_idx ON api_keys (key_hash);
+
+-- messages sent by bots with API keys are tagged as such
+ALTER TABLE messages ADD COLUMN sender_type TEXT NOT NULL DEFAULT 'user';
+
+-- +goose Down
+ALTER TABLE messages DROP COLUMN IF EXISTS sender_type;
+DROP TABLE IF EXISTS api_keys;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- buckets full again are equal to new ones and are deleted, otherwise the table keeps a row per caller forever
+ALTER TABLE rate_limits ADD COLUMN full_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW();
+
+CREATE INDEX rate_limits_full_at_idx ON rate_limits (full_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS rate_limits_full_at_idx;
+ALTER TABLE rate_limits DROP COLUMN IF EXISTS full_at;
@@ -0,0 +1,9 @@
+// Package swagger provides the OpenAPI specification of the chat server HTTP API.
+package swagger
+
+import _ "embed" // embeds the generated specification
+
+// Spec is the OpenAPI v2 specification generated from the chat API proto file.
+//
+//go:embed api.swagger.json
+var Spec []byte